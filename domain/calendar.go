@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Типы событий календаря.
+const (
+	CalendarReminder = "reminder"
+	CalendarDeadline = "deadline"
+	CalendarFinished = "finished"
+	CalendarSms      = "sms"
+)
+
+var CalendarTypes = []string{CalendarReminder, CalendarDeadline, CalendarFinished, CalendarSms}
+
+const icsTimeLayout = "20060102T150405Z"
+
+// CalendarEvent - событие календаря: дело, срок или завершение задачи, отложенная sms.
+type CalendarEvent struct {
+	UUID        uuid.UUID // uuid дела или задачи, UID события не меняется между обновлениями ленты
+	Type        string
+	Title       string
+	Description string
+	URL         string
+	Start       time.Time
+	End         *time.Time
+	RRule       string
+	UpdatedAt   time.Time
+
+	TaskUUID    uuid.UUID
+	ProjectUUID uuid.UUID
+	People      []string // email участников, для фильтра по людям
+}
+
+// FilterCalendar - события выбранных типов и с участием выбранных людей (пустой фильтр - все), по времени начала.
+func FilterCalendar(events []CalendarEvent, types, people []string) []CalendarEvent {
+	res := lo.Filter(events, func(e CalendarEvent, _ int) bool {
+		if len(types) > 0 && !lo.Contains(types, e.Type) {
+			return false
+		}
+
+		return len(people) == 0 || lo.Some(e.People, people)
+	})
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+
+	return res
+}
+
+// ICS - календарь в формате iCalendar (RFC 5545). Время событий в UTC, часовой пояс применяет приложение календаря.
+func ICS(name string, events []CalendarEvent) []byte {
+	var b strings.Builder
+
+	line := func(s string) {
+		b.WriteString(icsFold(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//crm-backend//calendar//RU")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + icsEscape(name))
+
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UUID.String())
+		line("DTSTAMP:" + e.UpdatedAt.UTC().Format(icsTimeLayout))
+		line("DTSTART:" + e.Start.UTC().Format(icsTimeLayout))
+
+		if e.End != nil && e.End.After(e.Start) {
+			line("DTEND:" + e.End.UTC().Format(icsTimeLayout))
+		}
+
+		if e.RRule != "" {
+			line("RRULE:" + e.RRule)
+		}
+
+		line("SUMMARY:" + icsEscape(e.Title))
+
+		if e.Description != "" {
+			line("DESCRIPTION:" + icsEscape(e.Description))
+		}
+
+		if e.URL != "" {
+			line("URL:" + e.URL)
+		}
+
+		line("CATEGORIES:" + e.Type)
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func icsEscape(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+// icsFold - строки длиннее 75 байт переносятся, перенос не разрывает символ UTF-8.
+func icsFold(s string) string {
+	const limit = 75
+
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+
+	size := 0
+	for _, r := range s {
+		n := len(string(r))
+		if size+n > limit {
+			b.WriteString("\r\n ")
+			size = 1
+		}
+
+		b.WriteRune(r)
+		size += n
+	}
+
+	return b.String()
+}
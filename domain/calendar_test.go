@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestICS(t *testing.T) {
+	uid := uuid.MustParse("5b1f3c2e-1d2a-4c6b-9e0f-1a2b3c4d5e6f")
+	start := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	ics := string(ICS("Задачи", []CalendarEvent{{
+		UUID:      uid,
+		Type:      CalendarReminder,
+		Title:     "Позвонить; обсудить, договор",
+		Start:     start,
+		End:       &end,
+		RRule:     ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 4}}.RRule(),
+		UpdatedAt: start,
+	}}))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:" + uid.String() + "\r\n",
+		"DTSTART:20261019T100000Z\r\n",
+		"DTEND:20261019T110000Z\r\n",
+		"RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TH\r\n",
+		`SUMMARY:Позвонить\; обсудить\, договор` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("ICS() does not contain %q:\n%s", want, ics)
+		}
+	}
+}
+
+func TestICSFold(t *testing.T) {
+	tests := []string{
+		"SUMMARY:short",
+		"SUMMARY:" + strings.Repeat("a", 200),
+		"SUMMARY:" + strings.Repeat("ж", 100),
+	}
+
+	for _, s := range tests {
+		folded := icsFold(s)
+
+		for _, line := range strings.Split(folded, "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("icsFold(%q) line longer than 75 bytes: %q", s, line)
+			}
+
+			if !strings.HasPrefix(line, " ") && line != strings.Split(folded, "\r\n")[0] {
+				t.Errorf("icsFold(%q) continuation without space: %q", s, line)
+			}
+		}
+
+		if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != s {
+			t.Errorf("icsFold(%q) unfolded = %q", s, unfolded)
+		}
+	}
+}
+
+func TestFilterCalendar(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	events := []CalendarEvent{
+		{Title: "sms", Type: CalendarSms, Start: day.Add(3 * time.Hour), People: []string{"a@mail.ru"}},
+		{Title: "deadline", Type: CalendarDeadline, Start: day.Add(time.Hour), People: []string{"b@mail.ru"}},
+		{Title: "reminder", Type: CalendarReminder, Start: day.Add(2 * time.Hour), People: []string{"a@mail.ru", "b@mail.ru"}},
+	}
+
+	titles := func(events []CalendarEvent) string {
+		return strings.Join(lo.Map(events, func(e CalendarEvent, _ int) string {
+			return e.Title
+		}), ",")
+	}
+
+	tests := []struct {
+		types  []string
+		people []string
+		want   string
+	}{
+		{want: "deadline,reminder,sms"},
+		{types: []string{CalendarSms, CalendarReminder}, want: "reminder,sms"},
+		{people: []string{"b@mail.ru"}, want: "deadline,reminder"},
+		{types: []string{CalendarSms}, people: []string{"b@mail.ru"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := titles(FilterCalendar(events, tt.types, tt.people)); got != tt.want {
+			t.Errorf("FilterCalendar(%v, %v) = %s, want %s", tt.types, tt.people, got, tt.want)
+		}
+	}
+}
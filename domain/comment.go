@@ -17,11 +17,16 @@ type Comment struct {
 	ReplyUUID    *uuid.UUID `validate:"uuid"  ru:"комментарий (uuid)"`
 	ReplyComment *string
 
+	ThreadUUID   *uuid.UUID
+	Depth        int
+	RepliesCount int
+
 	TaskUUID uuid.UUID `validate:"required,uuid"  ru:"задача (uuid)"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+	EditedAt  *time.Time
 
 	Files []File
 
@@ -31,6 +36,9 @@ type Comment struct {
 	Likes     map[string]int64
 	UserLikes []UserLike
 
+	Reactions     CommentReactions
+	UserReactions []CommentReaction
+
 	Pin bool
 
 	Meta map[string]interface{}
@@ -53,6 +61,8 @@ func NewComment(createdBy string, taskUUID, replyUUID uuid.UUID, emails []string
 
 		Likes:     make(map[string]int64),
 		UserLikes: []UserLike{},
+
+		Reactions: CommentReactions{},
 	}
 
 	errs, ok := helpers.ValidationStruct(comment)
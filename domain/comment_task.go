@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Куда поместить задачу, созданную из комментария.
+const (
+	CommentToSubtask = "subtask"
+	CommentToSibling = "sibling"
+)
+
+const commentTaskDefaultName = "Задача из комментария"
+
+// CommentTaskUUID - uuid задачи, созданной из комментария: одна задача на комментарий.
+func CommentTaskUUID(commentUUID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(commentUUID, []byte("task"))
+}
+
+// CommentTaskLinkUUID - uuid ответа со ссылкой на задачу, созданную из комментария.
+func CommentTaskLinkUUID(commentUUID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(commentUUID, []byte("task-link"))
+}
+
+// CommentTaskName - название задачи по тексту комментария: первая непустая строка, не длиннее 100 символов.
+func CommentTaskName(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(line) > 100 {
+			line = strings.TrimSpace(string([]rune(line)[:99])) + "…"
+		}
+
+		if utf8.RuneCountInString(line) < 3 {
+			break
+		}
+
+		return line
+	}
+
+	return commentTaskDefaultName
+}
+
+// CommentTaskPath - path новой задачи без ее собственного uuid: подзадача или задача на том же уровне, что и parent.
+func CommentTaskPath(parent []string, mode string) ([]string, error) {
+	switch mode {
+	case CommentToSubtask:
+		return append([]string{}, parent...), nil
+	case CommentToSibling:
+		if len(parent) == 0 {
+			return []string{}, nil
+		}
+
+		return append([]string{}, parent[:len(parent)-1]...), nil
+	}
+
+	return nil, errors.New("неизвестный тип задачи из комментария")
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+func TestCommentTaskName(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "\n  Подготовить отчет  \nдо пятницы", want: "Подготовить отчет"},
+		{text: "ок", want: commentTaskDefaultName},
+		{text: "", want: commentTaskDefaultName},
+	}
+
+	for _, tt := range tests {
+		if got := CommentTaskName(tt.text); got != tt.want {
+			t.Errorf("CommentTaskName(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	if got := CommentTaskName(strings.Repeat("я", 150)); utf8.RuneCountInString(got) != 100 {
+		t.Errorf("CommentTaskName() length = %d, want 100", utf8.RuneCountInString(got))
+	}
+}
+
+func TestCommentTaskPath(t *testing.T) {
+	tests := []struct {
+		parent  []string
+		mode    string
+		want    []string
+		wantErr bool
+	}{
+		{parent: []string{"a", "b"}, mode: CommentToSubtask, want: []string{"a", "b"}},
+		{parent: []string{"a", "b"}, mode: CommentToSibling, want: []string{"a"}},
+		{parent: []string{"a"}, mode: CommentToSibling, want: []string{}},
+		{parent: []string{"a"}, mode: "child", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := CommentTaskPath(tt.parent, tt.mode)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CommentTaskPath(%v, %q) error = %v, wantErr %v", tt.parent, tt.mode, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CommentTaskPath(%v, %q) = %v, want %v", tt.parent, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestCommentTaskUUID(t *testing.T) {
+	comment := uuid.New()
+
+	if CommentTaskUUID(comment) != CommentTaskUUID(comment) {
+		t.Errorf("CommentTaskUUID() differs for the same comment")
+	}
+
+	if CommentTaskUUID(comment) == CommentTaskUUID(uuid.New()) {
+		t.Errorf("CommentTaskUUID() equal for different comments")
+	}
+
+	if CommentTaskUUID(comment) == CommentTaskLinkUUID(comment) {
+		t.Errorf("CommentTaskUUID() equal to CommentTaskLinkUUID()")
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// CommentMaxDepth - максимальная вложенность ответов в треде.
+const CommentMaxDepth = 10
+
+var reactionShortcodeExp = regexp.MustCompile(`^:[a-z0-9_+\-]{1,30}:$`)
+
+// CommentReactions - emoji -> email -> время реакции (unix micro).
+type CommentReactions map[string]map[string]int64
+
+// CommentReaction - пользователи, поставившие реакцию emoji.
+type CommentReaction struct {
+	Emoji string
+	Users []UserLike
+}
+
+// IsRoot - комментарий не является ответом.
+func (c Comment) IsRoot() bool {
+	return c.ReplyUUID == nil || *c.ReplyUUID == uuid.Nil
+}
+
+// RootUUID - uuid первого комментария треда.
+func (c Comment) RootUUID() uuid.UUID {
+	if c.ThreadUUID != nil && *c.ThreadUUID != uuid.Nil {
+		return *c.ThreadUUID
+	}
+
+	return c.UUID
+}
+
+// Toggle - ставит или снимает реакцию пользователя, возвращает true, если реакция поставлена.
+func (r CommentReactions) Toggle(emoji, email string, at int64) bool {
+	users, ok := r[emoji]
+	if !ok {
+		users = make(map[string]int64)
+		r[emoji] = users
+	}
+
+	if _, ok := users[email]; ok {
+		delete(users, email)
+		if len(users) == 0 {
+			delete(r, emoji)
+		}
+
+		return false
+	}
+
+	users[email] = at
+
+	return true
+}
+
+// Emails - все пользователи, поставившие хотя бы одну реакцию.
+func (r CommentReactions) Emails() []string {
+	emails := []string{}
+	seen := make(map[string]bool)
+	for _, users := range r {
+		for email := range users {
+			if !seen[email] {
+				seen[email] = true
+				emails = append(emails, email)
+			}
+		}
+	}
+
+	return emails
+}
+
+// Emojis - emoji в порядке первой реакции.
+func (r CommentReactions) Emojis() []string {
+	first := make(map[string]int64, len(r))
+	emojis := make([]string, 0, len(r))
+	for emoji, users := range r {
+		emojis = append(emojis, emoji)
+		for _, at := range users {
+			if first[emoji] == 0 || at < first[emoji] {
+				first[emoji] = at
+			}
+		}
+	}
+
+	sort.Slice(emojis, func(i, j int) bool {
+		if first[emojis[i]] != first[emojis[j]] {
+			return first[emojis[i]] < first[emojis[j]]
+		}
+		return emojis[i] < emojis[j]
+	})
+
+	return emojis
+}
+
+// ValidateReaction - emoji (один графем с модификаторами) или shortcode вида :thumbsup:.
+func ValidateReaction(emoji string) error {
+	if reactionShortcodeExp.MatchString(emoji) {
+		return nil
+	}
+
+	if emoji == "" || utf8.RuneCountInString(emoji) > 10 {
+		return errors.New("реакция: некорректный emoji")
+	}
+
+	for _, r := range emoji {
+		if r < utf8.RuneSelf || unicode.IsSpace(r) || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return errors.New("реакция: некорректный emoji")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCommentReactionsToggle(t *testing.T) {
+	r := CommentReactions{}
+
+	if !r.Toggle("👍", "a@mail.ru", 2) || !r.Toggle("🎉", "b@mail.ru", 1) || !r.Toggle("👍", "b@mail.ru", 3) {
+		t.Fatal("Toggle() should add reactions")
+	}
+
+	if got := r.Emojis(); len(got) != 2 || got[0] != "🎉" || got[1] != "👍" {
+		t.Errorf("Emojis() = %v, want in order of first reaction", got)
+	}
+
+	if got := r.Emails(); len(got) != 2 {
+		t.Errorf("Emails() = %v, want 2 users", got)
+	}
+
+	if r.Toggle("🎉", "b@mail.ru", 4) {
+		t.Error("Toggle() should remove existing reaction")
+	}
+
+	if _, ok := r["🎉"]; ok {
+		t.Error("Toggle() should drop emoji without users")
+	}
+}
+
+func TestValidateReaction(t *testing.T) {
+	tests := []struct {
+		emoji   string
+		wantErr bool
+	}{
+		{emoji: "👍"},
+		{emoji: "👍🏽"},
+		{emoji: "👨‍👩‍👧"},
+		{emoji: ":thumbsup:"},
+		{emoji: "", wantErr: true},
+		{emoji: "like", wantErr: true},
+		{emoji: "ок", wantErr: true},
+		{emoji: "👍 👍", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.emoji, func(t *testing.T) {
+			if err := ValidateReaction(tt.emoji); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateReaction(%q) error = %v, wantErr %v", tt.emoji, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommentRootUUID(t *testing.T) {
+	root := Comment{UUID: uuid.New(), ReplyUUID: &uuid.Nil}
+	reply := Comment{UUID: uuid.New(), ReplyUUID: &root.UUID, ThreadUUID: &root.UUID, Depth: 1}
+
+	if !root.IsRoot() || reply.IsRoot() {
+		t.Error("IsRoot() wrong for root or reply")
+	}
+
+	if root.RootUUID() != root.UUID || reply.RootUUID() != root.UUID {
+		t.Error("RootUUID() should point to the thread root")
+	}
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	TextDiffEqual  = "equal"
+	TextDiffInsert = "insert"
+	TextDiffDelete = "delete"
+)
+
+// CommentVersion - версия текста комментария. Версия 1 - исходный текст.
+type CommentVersion struct {
+	Version   int
+	Comment   string
+	EditedBy  string
+	CreatedAt time.Time
+}
+
+// TextDiff - фрагмент разницы между версиями текста.
+type TextDiff struct {
+	Op   string
+	Text string
+}
+
+// IsEdited - текст комментария менялся после публикации.
+func (c Comment) IsEdited() bool {
+	return c.EditedAt != nil
+}
+
+// EditAllowed - можно ли редактировать комментарий спустя minutes минут после публикации (0 - без ограничений).
+func (c Comment) EditAllowed(minutes int, now time.Time) bool {
+	if minutes <= 0 {
+		return true
+	}
+
+	return now.Sub(c.CreatedAt) <= time.Duration(minutes)*time.Minute
+}
+
+// CommentDiffMaxCells - предел размера таблицы LCS (слов from × слов to).
+// Для текстов больше разница после общего начала и конца отдается целиком.
+const CommentDiffMaxCells = 1 << 20
+
+// DiffText - пословная разница между from и to (пробелы и переносы сохраняются).
+func DiffText(from, to string) []TextDiff {
+	a, b := splitWords(from), splitWords(to)
+
+	// общее начало и конец не участвуют в LCS
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	head, tail := a[:prefix], a[len(a)-suffix:]
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	if (len(a)+1)*(len(b)+1) > CommentDiffMaxCells {
+		diff := []TextDiff{}
+		for _, part := range []TextDiff{
+			{Op: TextDiffEqual, Text: strings.Join(head, "")},
+			{Op: TextDiffDelete, Text: strings.Join(a, "")},
+			{Op: TextDiffInsert, Text: strings.Join(b, "")},
+			{Op: TextDiffEqual, Text: strings.Join(tail, "")},
+		} {
+			if part.Text != "" {
+				diff = append(diff, part)
+			}
+		}
+
+		return diff
+	}
+
+	// lcs[i][j] - длина общей подпоследовательности a[i:] и b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := []TextDiff{}
+	push := func(op, text string) {
+		if n := len(diff); n > 0 && diff[n-1].Op == op {
+			diff[n-1].Text += text
+			return
+		}
+
+		diff = append(diff, TextDiff{Op: op, Text: text})
+	}
+
+	for _, w := range head {
+		push(TextDiffEqual, w)
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			push(TextDiffEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			push(TextDiffDelete, a[i])
+			i++
+		default:
+			push(TextDiffInsert, b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		push(TextDiffDelete, a[i])
+	}
+
+	for ; j < len(b); j++ {
+		push(TextDiffInsert, b[j])
+	}
+
+	for _, w := range tail {
+		push(TextDiffEqual, w)
+	}
+
+	return diff
+}
+
+// splitWords - слова и промежутки между ними отдельными токенами.
+func splitWords(s string) []string {
+	words := []string{}
+	var b strings.Builder
+
+	space := false
+	for i, r := range s {
+		if i > 0 && unicode.IsSpace(r) != space {
+			words = append(words, b.String())
+			b.Reset()
+		}
+
+		space = unicode.IsSpace(r)
+		b.WriteRune(r)
+	}
+
+	if b.Len() > 0 {
+		words = append(words, b.String())
+	}
+
+	return words
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []TextDiff
+	}{
+		{
+			name: "equal",
+			from: "привет мир",
+			to:   "привет мир",
+			want: []TextDiff{{Op: TextDiffEqual, Text: "привет мир"}},
+		},
+		{
+			name: "replace word",
+			from: "срок в пятницу",
+			to:   "срок в понедельник",
+			want: []TextDiff{
+				{Op: TextDiffEqual, Text: "срок в "},
+				{Op: TextDiffDelete, Text: "пятницу"},
+				{Op: TextDiffInsert, Text: "понедельник"},
+			},
+		},
+		{
+			name: "append",
+			from: "готово",
+			to:   "готово, проверь",
+			want: []TextDiff{
+				{Op: TextDiffDelete, Text: "готово"},
+				{Op: TextDiffInsert, Text: "готово, проверь"},
+			},
+		},
+		{
+			name: "from empty",
+			from: "",
+			to:   "текст",
+			want: []TextDiff{{Op: TextDiffInsert, Text: "текст"}},
+		},
+		{
+			name: "to empty",
+			from: "текст",
+			to:   "",
+			want: []TextDiff{{Op: TextDiffDelete, Text: "текст"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffText(tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffTextLarge(t *testing.T) {
+	from := "начало " + strings.Repeat("а ", 50000) + "конец"
+	to := "начало " + strings.Repeat("б ", 50000) + "конец"
+
+	diff := DiffText(from, to)
+
+	var gotFrom, gotTo strings.Builder
+	for _, d := range diff {
+		if d.Op != TextDiffInsert {
+			gotFrom.WriteString(d.Text)
+		}
+
+		if d.Op != TextDiffDelete {
+			gotTo.WriteString(d.Text)
+		}
+	}
+
+	if gotFrom.String() != from || gotTo.String() != to {
+		t.Fatalf("DiffText() does not restore texts")
+	}
+
+	if len(diff) != 4 || diff[0].Text != "начало " || diff[3].Text != " конец" {
+		t.Errorf("DiffText() = %d parts, want common head and tail around the change", len(diff))
+	}
+}
+
+func TestCommentEditAllowed(t *testing.T) {
+	now := time.Now()
+	c := Comment{CreatedAt: now.Add(-10 * time.Minute)}
+
+	tests := []struct {
+		minutes int
+		want    bool
+	}{
+		{minutes: 0, want: true},
+		{minutes: 15, want: true},
+		{minutes: 5, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := c.EditAllowed(tt.minutes, now); got != tt.want {
+			t.Errorf("EditAllowed(%d) = %v, want %v", tt.minutes, got, tt.want)
+		}
+	}
+}
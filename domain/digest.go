@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Периодичность email-дайджеста непрочитанных уведомлений.
+const (
+	DigestOff    = "off"
+	DigestHourly = "hourly"
+	DigestDaily  = "daily"
+	DigestWeekly = "weekly"
+
+	// DigestHour - час по времени пользователя, в который отправляются ежедневный и еженедельный дайджесты.
+	DigestHour = 9
+)
+
+var DigestPeriods = []string{DigestOff, DigestHourly, DigestDaily, DigestWeekly}
+
+// DigestPeriodKey - идентификатор текущего периода дайджеста в часовом поясе пользователя.
+// Пустая строка - время отправки еще не наступило или дайджест выключен.
+func DigestPeriodKey(period string, now time.Time, loc *time.Location) string {
+	local := now.In(loc)
+
+	switch period {
+	case DigestHourly:
+		return local.Format("2006-01-02T15")
+	case DigestDaily:
+		if local.Hour() < DigestHour {
+			return ""
+		}
+
+		return local.Format("2006-01-02")
+	case DigestWeekly:
+		if local.Weekday() == time.Monday && local.Hour() < DigestHour {
+			return ""
+		}
+
+		year, week := local.ISOWeek()
+
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}
+
+	return ""
+}
+
+// DigestSince - начало периода, за который собираются уведомления, если дайджест еще не отправлялся.
+func DigestSince(period string, now time.Time) time.Time {
+	switch period {
+	case DigestHourly:
+		return now.Add(-time.Hour)
+	case DigestWeekly:
+		return now.AddDate(0, 0, -7)
+	}
+
+	return now.AddDate(0, 0, -1)
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigestPeriodKey(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// понедельник 2026-10-19 05:30 UTC = 08:30 MSK
+	monday := time.Date(2026, 10, 19, 5, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		period string
+		now    time.Time
+		want   string
+	}{
+		{period: DigestHourly, now: monday, want: "2026-10-19T08"},
+		{period: DigestDaily, now: monday, want: ""},
+		{period: DigestDaily, now: monday.Add(time.Hour), want: "2026-10-19"},
+		{period: DigestWeekly, now: monday, want: ""},
+		{period: DigestWeekly, now: monday.Add(time.Hour), want: "2026-W43"},
+		{period: DigestWeekly, now: monday.AddDate(0, 0, 3), want: "2026-W43"},
+		{period: DigestOff, now: monday.Add(time.Hour), want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := DigestPeriodKey(tt.period, tt.now, msk); got != tt.want {
+			t.Errorf("DigestPeriodKey(%s, %v) = %q, want %q", tt.period, tt.now, got, tt.want)
+		}
+	}
+}
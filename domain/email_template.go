@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Типы шаблонов писем в таблице templates.
+const (
+	EmailTemplateConfirmation = "email_confirmation"
+	EmailTemplateReset        = "email_reset"
+	EmailTemplateDigest       = "email_digest"
+	EmailTemplateNotification = "email_notification"
+
+	// EmailBrandingType - оформление писем федерации, хранится рядом с шаблонами
+	EmailBrandingType = "email_branding"
+)
+
+// Языки писем, LangDefault - язык встроенных шаблонов.
+const (
+	LangRu      = "ru"
+	LangEn      = "en"
+	LangDefault = LangRu
+)
+
+var (
+	EmailTemplateTypes = []string{EmailTemplateConfirmation, EmailTemplateReset, EmailTemplateDigest, EmailTemplateNotification}
+	Languages          = []string{LangRu, LangEn}
+
+	// EmailTemplateScopedTypes - шаблоны, которые переопределяются для федерации или компании. Подтверждение email
+	// и сброс пароля отправляются без федерации, дайджест - по всем федерациям пользователя: для них только общий шаблон.
+	EmailTemplateScopedTypes = []string{EmailTemplateNotification}
+)
+
+// EmailScope - для кого ищется шаблон: компания и федерация, uuid.Nil - общий шаблон.
+type EmailScope struct {
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+}
+
+// Global - общий шаблон, не федерации и не компании.
+func (s EmailScope) Global() bool {
+	return s.FederationUUID == uuid.Nil && s.CompanyUUID == uuid.Nil
+}
+
+// EmailTemplate - шаблон темы и тела письма (Go template), переопределяет встроенный для федерации, компании или всех.
+type EmailTemplate struct {
+	UUID           uuid.UUID
+	Type           string
+	Lang           string
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+
+	Subject string
+	Body    string
+
+	CreatedBy uuid.UUID
+	UpdatedAt time.Time
+}
+
+// EmailBranding - оформление писем федерации, пустые поля - оформление по умолчанию.
+type EmailBranding struct {
+	LogoURL         string `json:"logo_url,omitempty"`
+	PrimaryColor    string `json:"primary_color,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	SenderName      string `json:"sender_name,omitempty"`
+}
+
+// PickEmailTemplate - самый точный шаблон для области: компания, затем федерация, затем общий.
+// Язык получателя важнее точности области, на LangDefault переходим, только если шаблона на языке нет нигде.
+func PickEmailTemplate(templates []EmailTemplate, scope EmailScope, lang string) (EmailTemplate, bool) {
+	scopes := []EmailScope{
+		scope,
+		{FederationUUID: scope.FederationUUID},
+		{},
+	}
+
+	for _, l := range []string{lang, LangDefault} {
+		for _, s := range scopes {
+			for _, t := range templates {
+				if t.Lang == l && t.FederationUUID == s.FederationUUID && t.CompanyUUID == s.CompanyUUID {
+					return t, true
+				}
+			}
+		}
+	}
+
+	return EmailTemplate{}, false
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPickEmailTemplate(t *testing.T) {
+	federation := uuid.New()
+	company := uuid.New()
+
+	templates := []EmailTemplate{
+		{Subject: "global ru", Lang: LangRu},
+		{Subject: "global en", Lang: LangEn},
+		{Subject: "federation ru", Lang: LangRu, FederationUUID: federation},
+		{Subject: "company ru", Lang: LangRu, FederationUUID: federation, CompanyUUID: company},
+		{Subject: "other company en", Lang: LangEn, FederationUUID: federation, CompanyUUID: uuid.New()},
+	}
+
+	tests := []struct {
+		name      string
+		templates []EmailTemplate
+		scope     EmailScope
+		lang      string
+		want      string
+		wantOk    bool
+	}{
+		{name: "company", templates: templates, scope: EmailScope{federation, company}, lang: LangRu, want: "company ru", wantOk: true},
+		{name: "federation", templates: templates, scope: EmailScope{federation, uuid.New()}, lang: LangRu, want: "federation ru", wantOk: true},
+		{name: "global", templates: templates, scope: EmailScope{}, lang: LangRu, want: "global ru", wantOk: true},
+		{name: "lang before scope", templates: templates, scope: EmailScope{federation, company}, lang: LangEn, want: "global en", wantOk: true},
+		{name: "default lang", templates: templates[2:4], scope: EmailScope{federation, company}, lang: LangEn, want: "company ru", wantOk: true},
+		{name: "none", templates: templates[4:], scope: EmailScope{federation, company}, lang: LangEn, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PickEmailTemplate(tt.templates, tt.scope, tt.lang)
+			if ok != tt.wantOk || got.Subject != tt.want {
+				t.Errorf("PickEmailTemplate() = %q, %v, want %q, %v", got.Subject, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FieldMigrationTargetCompany = "company_field"
+	FieldMigrationTargetCatalog = "catalog_field"
+
+	// FieldMigrationStrategyFail - не запускать миграцию, если есть неконвертируемые значения.
+	FieldMigrationStrategyFail    = "fail"
+	FieldMigrationStrategyDefault = "default"
+	FieldMigrationStrategyClear   = "clear"
+
+	FieldMigrationStatusRunning = "running"
+	FieldMigrationStatusDone    = "done"
+	FieldMigrationStatusFailed  = "failed"
+
+	FieldMigrationBatchSize = 500
+
+	// FieldMigrationStaleAfter - прогресс сохраняется после каждого батча; миграция в статусе running
+	// без обновлений дольше этого времени прервана перезапуском сервиса.
+	FieldMigrationStaleAfter = 10 * time.Minute
+)
+
+var ErrFieldNotConvertible = errors.New("значение нельзя конвертировать")
+
+var (
+	fieldEmailExp = regexp.MustCompile(`^[a-z0-9._%+\-]+@[a-z0-9.\-]+\.[a-z]{2,4}$`)
+	fieldLinkExp  = regexp.MustCompile(`^\[.*]\((http:\/\/www\.|https:\/\/www\.|http:\/\/|https:\/\/|\/|\/\/)?[A-z0-9_-]*?[:]?[A-z0-9_-]*?[@]?[A-z0-9]+([\-\.]{1}[a-z0-9]+)*\.[a-z]{2,5}(:[0-9]{1,5})?(\/.*)?\)$`)
+)
+
+// FieldMigration - смена типа кастомного поля с конвертацией сохраненных значений.
+type FieldMigration struct {
+	UUID        uuid.UUID
+	CompanyUUID uuid.UUID
+	Target      string
+	FieldUUID   uuid.UUID
+	Hash        string
+	FromType    FieldDataType
+	ToType      FieldDataType
+	Strategy    string
+	Default     interface{}
+	Status      string
+	Total       int
+	Processed   int
+	Converted   int
+	Cleared     int
+	Defaulted   int
+	Error       string
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// FieldValue - значение поля в задаче или записи справочника.
+type FieldValue struct {
+	UUID  uuid.UUID
+	Value interface{}
+}
+
+type FieldMigrationFailure struct {
+	UUID  uuid.UUID
+	Value interface{}
+	Error string
+}
+
+// FieldMigrationReport - результат пробного прогона конвертации.
+type FieldMigrationReport struct {
+	Total       int
+	Convertible int
+	Failed      int
+	Samples     []FieldMigrationFailure
+}
+
+// ConvertFieldValue - приводит сохраненное значение поля к новому типу.
+// Значения, которые уже соответствуют типу to, возвращаются без изменений.
+func ConvertFieldValue(value interface{}, to FieldDataType, options CompanyFieldOptions) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch to {
+	case Integer, Phone:
+		switch v := value.(type) {
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("%w: дробное число", ErrFieldNotConvertible)
+			}
+			return int(v), nil
+		case int:
+			return v, nil
+		case bool:
+			if v {
+				return 1, nil
+			}
+			return 0, nil
+		case string:
+			s := strings.TrimSpace(v)
+			if to == Phone {
+				s = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", "+", "").Replace(s)
+			}
+
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("%w: не целое число", ErrFieldNotConvertible)
+			}
+			return i, nil
+		}
+	case Float:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case string:
+			f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(v), ",", "."), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: не число", ErrFieldNotConvertible)
+			}
+			return f, nil
+		case map[string]interface{}:
+			if r, err := ParseAmount(v["amount"]); err == nil {
+				f, _ := r.Float64()
+				return f, nil
+			}
+		}
+	case String, Text:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case int:
+			return strconv.Itoa(v), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case []interface{}:
+			return strings.Join(stringsOf(v), ", "), nil
+		case map[string]interface{}:
+			if m, err := NewMoney(v["amount"], fmt.Sprint(v["currency"])); err == nil {
+				return m.Amount + " " + m.Currency, nil
+			}
+		}
+	case Bool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case float64:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case int:
+			if v == 0 || v == 1 {
+				return v == 1, nil
+			}
+		case string:
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "true", "1", "да", "yes":
+				return true, nil
+			case "false", "0", "нет", "no", "":
+				return false, nil
+			}
+		}
+	case Switch:
+		switch v := value.(type) {
+		case float64:
+			if v == 0 || v == 1 || v == 2 {
+				return int(v), nil
+			}
+		case int:
+			if v == 0 || v == 1 || v == 2 {
+				return v, nil
+			}
+		case bool:
+			if v {
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case Array:
+		switch v := value.(type) {
+		case []interface{}:
+			return stringsOf(v), nil
+		case string:
+			return []string{v}, nil
+		case float64, int, bool:
+			return []string{fmt.Sprint(v)}, nil
+		}
+	case People:
+		items := []interface{}{value}
+		if v, ok := value.([]interface{}); ok {
+			items = v
+		}
+
+		emails := []string{}
+		for _, s := range stringsOf(items) {
+			s = strings.ToLower(strings.TrimSpace(s))
+			if !fieldEmailExp.MatchString(s) {
+				return nil, fmt.Errorf("%w: %s не email", ErrFieldNotConvertible, s)
+			}
+			emails = append(emails, s)
+		}
+		return emails, nil
+	case Email:
+		if v, ok := value.(string); ok {
+			s := strings.ToLower(strings.TrimSpace(v))
+			if fieldEmailExp.MatchString(s) {
+				return s, nil
+			}
+			return nil, fmt.Errorf("%w: не email", ErrFieldNotConvertible)
+		}
+	case Link:
+		if v, ok := value.(string); ok {
+			if fieldLinkExp.MatchString(v) {
+				return v, nil
+			}
+
+			link := "[" + v + "](" + v + ")"
+			if fieldLinkExp.MatchString(link) {
+				return link, nil
+			}
+			return nil, fmt.Errorf("%w: не ссылка", ErrFieldNotConvertible)
+		}
+	case DateTime:
+		if v, ok := value.(string); ok {
+			if _, err := time.Parse(time.RFC3339, v); err == nil {
+				return v, nil
+			}
+			if t, err := time.Parse(time.DateOnly, v); err == nil {
+				return t.Format(time.RFC3339), nil
+			}
+			return nil, fmt.Errorf("%w: не дата RFC3339", ErrFieldNotConvertible)
+		}
+	case Time:
+		if v, ok := value.(string); ok {
+			if _, err := time.Parse(time.RFC3339, v); err == nil {
+				return v[11:], nil
+			}
+			if _, err := time.Parse("15:04:05Z07:00", v); err == nil {
+				return v, nil
+			}
+			return nil, fmt.Errorf("%w: не время", ErrFieldNotConvertible)
+		}
+	case Select:
+		if v, ok := value.([]interface{}); ok {
+			if len(v) != 1 {
+				return nil, fmt.Errorf("%w: несколько значений", ErrFieldNotConvertible)
+			}
+			value = v[0]
+		}
+
+		if o, ok := findOption(options, fmt.Sprint(value)); ok {
+			return o.UUID.String(), nil
+		}
+		return nil, fmt.Errorf("%w: нет варианта %v", ErrFieldNotConvertible, value)
+	case MultiSelect:
+		items := []interface{}{value}
+		if v, ok := value.([]interface{}); ok {
+			items = v
+		}
+
+		uids := []string{}
+		for _, s := range stringsOf(items) {
+			o, ok := findOption(options, s)
+			if !ok {
+				return nil, fmt.Errorf("%w: нет варианта %s", ErrFieldNotConvertible, s)
+			}
+			uids = append(uids, o.UUID.String())
+		}
+		return uids, nil
+	case MoneyType:
+		if v, ok := value.(map[string]interface{}); ok {
+			m, err := NewMoney(v["amount"], fmt.Sprint(v["currency"]))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrFieldNotConvertible, err.Error())
+			}
+			return m, nil
+		}
+
+		// сумма без валюты: "100 USD"
+		if v, ok := value.(string); ok {
+			if parts := strings.Fields(v); len(parts) == 2 {
+				if m, err := NewMoney(parts[0], parts[1]); err == nil {
+					return m, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrFieldNotConvertible, value)
+}
+
+// findOption - по uuid или по названию варианта без учета регистра.
+func findOption(options CompanyFieldOptions, s string) (CompanyFieldOption, bool) {
+	if o, ok := options.Find(s); ok {
+		return o, true
+	}
+
+	for _, o := range options {
+		if strings.EqualFold(strings.TrimSpace(o.Label), strings.TrimSpace(s)) {
+			return o, true
+		}
+	}
+
+	return CompanyFieldOption{}, false
+}
+
+func stringsOf(items []interface{}) []string {
+	out := make([]string, 0, len(items))
+	for _, i := range items {
+		out = append(out, fmt.Sprintf("%v", i))
+	}
+
+	return out
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestConvertFieldValue(t *testing.T) {
+	moscow := CompanyFieldOption{UUID: uuid.New(), Label: "Москва"}
+	options := CompanyFieldOptions{moscow}
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		to      FieldDataType
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "String to integer", value: " 42 ", to: Integer, want: 42},
+		{name: "Float to integer", value: 42.5, to: Integer, wantErr: true},
+		{name: "Text to integer", value: "abc", to: Integer, wantErr: true},
+		{name: "Integer to string", value: float64(42), to: String, want: "42"},
+		{name: "String to float", value: "1,5", to: Float, want: 1.5},
+		{name: "String to bool", value: "да", to: Bool, want: true},
+		{name: "Array to string", value: []interface{}{"a", "b"}, to: Text, want: "a, b"},
+		{name: "String to array", value: "a", to: Array, want: []string{"a"}},
+		{name: "String to email", value: "Info@Example.com", to: Email, want: "info@example.com"},
+		{name: "Date to datetime", value: "2026-10-19", to: DateTime, want: "2026-10-19T00:00:00Z"},
+		{name: "Label to select", value: "москва", to: Select, want: moscow.UUID.String()},
+		{name: "Unknown option", value: "Казань", to: Select, wantErr: true},
+		{name: "Array to multi select", value: []interface{}{"Москва"}, to: MultiSelect, want: []string{moscow.UUID.String()}},
+		{name: "String to money", value: "100 usd", to: MoneyType, want: Money{Amount: "100.00", Currency: "USD"}},
+		{name: "Number to money", value: float64(100), to: MoneyType, wantErr: true},
+		{name: "Nil", value: nil, to: Integer, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertFieldValue(tt.value, tt.to, options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertFieldValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertFieldValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	RelationTargetCatalog = "catalog"
+	RelationTargetAgent   = "agent"
+	RelationTargetTask    = "task"
+)
+
+// FieldRelation - настройка поля типа relation: на какие сущности компании ссылается значение.
+// В задаче хранится uuid сущности, для Multiple - массив uuid.
+type FieldRelation struct {
+	Target      string     `json:"target"`
+	CatalogUUID *uuid.UUID `json:"catalog_uuid,omitempty"`
+	Multiple    bool       `json:"multiple"`
+}
+
+func (j *FieldRelation) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := FieldRelation{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j FieldRelation) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j FieldRelation) Validate() error {
+	switch j.Target {
+	case RelationTargetCatalog:
+		if j.CatalogUUID == nil || *j.CatalogUUID == uuid.Nil {
+			return errors.New("relation: для справочника нужен catalog_uuid")
+		}
+	case RelationTargetAgent, RelationTargetTask:
+		if j.CatalogUUID != nil {
+			return errors.New("relation: catalog_uuid только для справочника")
+		}
+	default:
+		return errors.New("relation: target catalog|agent|task")
+	}
+
+	return nil
+}
+
+// ParseValue - uuid сущностей из значения поля (строка или массив строк для Multiple).
+func (j FieldRelation) ParseValue(value interface{}) (uids []uuid.UUID, err error) {
+	items := []interface{}{value}
+	if j.Multiple {
+		v, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("should be array of uuid")
+		}
+
+		items = v
+	}
+
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, errors.New("should be uuid")
+		}
+
+		uid, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not uuid", s)
+		}
+
+		uids = append(uids, uid)
+	}
+
+	return lo.Uniq(uids), nil
+}
+
+// ReferencedUUIDs - uuid сущностей из сохраненного значения поля.
+func (j FieldRelation) ReferencedUUIDs(value interface{}) []uuid.UUID {
+	if s, ok := value.([]string); ok {
+		value = lo.ToAnySlice(s)
+	}
+
+	if j.Multiple {
+		if _, ok := value.([]interface{}); !ok {
+			return nil
+		}
+	} else if _, ok := value.(string); !ok {
+		return nil
+	}
+
+	uids, err := j.ParseValue(value)
+	if err != nil {
+		return nil
+	}
+
+	return uids
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFieldRelationValidate(t *testing.T) {
+	catalogUUID := uuid.New()
+
+	tests := []struct {
+		name    string
+		rel     FieldRelation
+		wantErr bool
+	}{
+		{name: "Catalog", rel: FieldRelation{Target: RelationTargetCatalog, CatalogUUID: &catalogUUID}},
+		{name: "Catalog without uuid", rel: FieldRelation{Target: RelationTargetCatalog}, wantErr: true},
+		{name: "Agent", rel: FieldRelation{Target: RelationTargetAgent, Multiple: true}},
+		{name: "Task with catalog", rel: FieldRelation{Target: RelationTargetTask, CatalogUUID: &catalogUUID}, wantErr: true},
+		{name: "Unknown target", rel: FieldRelation{Target: "user"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rel.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFieldRelationParseValue(t *testing.T) {
+	uid := uuid.New()
+
+	tests := []struct {
+		name    string
+		rel     FieldRelation
+		value   interface{}
+		want    int
+		wantErr bool
+	}{
+		{name: "Single", rel: FieldRelation{}, value: uid.String(), want: 1},
+		{name: "Single not uuid", rel: FieldRelation{}, value: "abc", wantErr: true},
+		{name: "Single array", rel: FieldRelation{}, value: []interface{}{uid.String()}, wantErr: true},
+		{name: "Multiple uniq", rel: FieldRelation{Multiple: true}, value: []interface{}{uid.String(), uid.String()}, want: 1},
+		{name: "Multiple string", rel: FieldRelation{Multiple: true}, value: uid.String(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rel.ParseValue(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(got) != tt.want {
+				t.Errorf("ParseValue() = %v, want %d items", got, tt.want)
+			}
+		})
+	}
+
+	if got := (FieldRelation{Multiple: true}).ReferencedUUIDs([]string{uid.String()}); len(got) != 1 || got[0] != uid {
+		t.Errorf("ReferencedUUIDs() = %v", got)
+	}
+}
@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+// FieldRules - правила проверки значения кастомного поля.
+// Задаются на CompanyField и могут быть переопределены в поле проекта.
+type FieldRules struct {
+	Required  *bool    `json:"required,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	MinLength *int     `json:"min_length,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty"`
+	Regex     *string  `json:"regex,omitempty"`
+	DateFrom  *string  `json:"date_from,omitempty"`
+	DateTo    *string  `json:"date_to,omitempty"`
+	Unique    *bool    `json:"unique,omitempty"`
+}
+
+const (
+	RuleRequired  = "required"
+	RuleMin       = "min"
+	RuleMax       = "max"
+	RuleMinLength = "min_length"
+	RuleMaxLength = "max_length"
+	RuleRegex     = "regex"
+	RuleDateFrom  = "date_from"
+	RuleDateTo    = "date_to"
+	RuleUnique    = "unique"
+)
+
+// FieldRuleViolation - нарушение правила для конкретного поля.
+type FieldRuleViolation struct {
+	Rule    string
+	Message string
+}
+
+func (j *FieldRules) Scan(value interface{}) error {
+	if value == nil {
+		*j = FieldRules{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := FieldRules{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j FieldRules) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j FieldRules) IsRequired() bool {
+	return j.Required != nil && *j.Required
+}
+
+func (j FieldRules) IsUnique() bool {
+	return j.Unique != nil && *j.Unique
+}
+
+// Merge - правила проекта (o) перекрывают правила компании.
+func (j FieldRules) Merge(o FieldRules) FieldRules {
+	if o.Required != nil {
+		j.Required = o.Required
+	}
+	if o.Min != nil {
+		j.Min = o.Min
+	}
+	if o.Max != nil {
+		j.Max = o.Max
+	}
+	if o.MinLength != nil {
+		j.MinLength = o.MinLength
+	}
+	if o.MaxLength != nil {
+		j.MaxLength = o.MaxLength
+	}
+	if o.Regex != nil {
+		j.Regex = o.Regex
+	}
+	if o.DateFrom != nil {
+		j.DateFrom = o.DateFrom
+	}
+	if o.DateTo != nil {
+		j.DateTo = o.DateTo
+	}
+	if o.Unique != nil {
+		j.Unique = o.Unique
+	}
+
+	return j
+}
+
+// Validate - проверка самих правил при сохранении поля.
+func (j FieldRules) Validate() error {
+	if j.Min != nil && j.Max != nil && *j.Min > *j.Max {
+		return errors.New("правила: min больше max")
+	}
+
+	if (j.MinLength != nil && *j.MinLength < 0) || (j.MaxLength != nil && *j.MaxLength < 0) {
+		return errors.New("правила: длина не может быть отрицательной")
+	}
+
+	if j.MinLength != nil && j.MaxLength != nil && *j.MinLength > *j.MaxLength {
+		return errors.New("правила: min_length больше max_length")
+	}
+
+	if j.Regex != nil {
+		if len(*j.Regex) > 500 {
+			return errors.New("правила: regex до 500 символов")
+		}
+
+		if _, err := regexp.Compile(*j.Regex); err != nil {
+			return fmt.Errorf("правила: некорректный regex: %w", err)
+		}
+	}
+
+	from, err := parseRuleDate(j.DateFrom)
+	if err != nil {
+		return fmt.Errorf("правила: date_from: %w", err)
+	}
+
+	to, err := parseRuleDate(j.DateTo)
+	if err != nil {
+		return fmt.Errorf("правила: date_to: %w", err)
+	}
+
+	if from != nil && to != nil && from.After(*to) {
+		return errors.New("правила: date_from позже date_to")
+	}
+
+	return nil
+}
+
+// Check - проверка отфильтрованного значения поля (без required и unique).
+func (j FieldRules) Check(value interface{}) (violations []FieldRuleViolation) {
+	num, isNum := ruleNumber(value)
+	if isNum {
+		if j.Min != nil && num < *j.Min {
+			violations = append(violations, FieldRuleViolation{RuleMin, fmt.Sprintf("значение должно быть не меньше %v", *j.Min)})
+		}
+		if j.Max != nil && num > *j.Max {
+			violations = append(violations, FieldRuleViolation{RuleMax, fmt.Sprintf("значение должно быть не больше %v", *j.Max)})
+		}
+	}
+
+	length, isLen := ruleLength(value)
+	if isLen {
+		if j.MinLength != nil && length < *j.MinLength {
+			violations = append(violations, FieldRuleViolation{RuleMinLength, fmt.Sprintf("длина должна быть не меньше %d", *j.MinLength)})
+		}
+		if j.MaxLength != nil && length > *j.MaxLength {
+			violations = append(violations, FieldRuleViolation{RuleMaxLength, fmt.Sprintf("длина должна быть не больше %d", *j.MaxLength)})
+		}
+	}
+
+	if s, ok := value.(string); ok {
+		if j.Regex != nil {
+			if re, err := regexp.Compile(*j.Regex); err == nil && !re.MatchString(s) {
+				violations = append(violations, FieldRuleViolation{RuleRegex, "значение не соответствует формату"})
+			}
+		}
+
+		if j.DateFrom != nil || j.DateTo != nil {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				if from, _ := parseRuleDate(j.DateFrom); from != nil && t.Before(*from) {
+					violations = append(violations, FieldRuleViolation{RuleDateFrom, "дата должна быть не раньше " + *j.DateFrom})
+				}
+				if to, _ := parseRuleDate(j.DateTo); to != nil && t.After(*to) {
+					violations = append(violations, FieldRuleViolation{RuleDateTo, "дата должна быть не позже " + *j.DateTo})
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+// IsEmptyFieldValue - значение отсутствует для правила required.
+func IsEmptyFieldValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+func ruleNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case Money:
+		f, _ := v.Rat().Float64()
+		return f, true
+	}
+
+	return 0, false
+}
+
+func ruleLength(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case string:
+		return utf8.RuneCountInString(v), true
+	case []string:
+		return len(v), true
+	}
+
+	return 0, false
+}
+
+func parseRuleDate(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, *s); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, *s)
+	if err != nil {
+		return nil, errors.New("дата в формате RFC3339 или YYYY-MM-DD")
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestFieldRulesCheck(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	length := func(v int) *int { return &v }
+	str := func(v string) *string { return &v }
+
+	company := FieldRules{Min: ptr(0), Max: ptr(100), Regex: str(`^[A-Z]{2}-\d+$`)}
+	rules := company.Merge(FieldRules{Max: ptr(10), MaxLength: length(5), DateFrom: str("2026-01-01")})
+
+	if *rules.Max != 10 || *rules.Min != 0 {
+		t.Fatalf("Merge() project rules should override company rules, got %+v", rules)
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  []string
+	}{
+		{name: "Valid integer", value: 5},
+		{name: "Max", value: 11, want: []string{RuleMax}},
+		{name: "Min float", value: -0.5, want: []string{RuleMin}},
+		{name: "Money", value: Money{Amount: "10.50", Currency: "USD"}, want: []string{RuleMax}},
+		{name: "Valid string", value: "AB-12"},
+		{name: "Regex and length", value: "abc-123", want: []string{RuleMaxLength, RuleRegex}},
+		{name: "Array length", value: []string{"1", "2", "3", "4", "5", "6"}, want: []string{RuleMaxLength}},
+		{name: "Date before", value: "2025-12-31T10:00:00Z", want: []string{RuleMaxLength, RuleRegex, RuleDateFrom}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rules.Check(tt.value)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Check() = %+v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i].Rule != tt.want[i] {
+					t.Errorf("Check() rule = %s, want %s", got[i].Rule, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFieldRulesValidate(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	str := func(v string) *string { return &v }
+
+	tests := []struct {
+		name    string
+		rules   FieldRules
+		wantErr bool
+	}{
+		{name: "Empty", rules: FieldRules{}},
+		{name: "Min greater than max", rules: FieldRules{Min: ptr(10), Max: ptr(1)}, wantErr: true},
+		{name: "Broken regex", rules: FieldRules{Regex: str("([a-z")}, wantErr: true},
+		{name: "Broken date", rules: FieldRules{DateTo: str("31.12.2026")}, wantErr: true},
+		{name: "Dates", rules: FieldRules{DateFrom: str("2026-01-01"), DateTo: str("2026-12-31T00:00:00Z")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rules.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var intakeSubjectPrefix = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|ha|отв|пересл)\s*(\[\d+\])?\s*:\s*)+`)
+
+// IntakeAddress - адрес проекта для заявок: новое письмо на него становится задачей проекта.
+type IntakeAddress struct {
+	UUID        uuid.UUID
+	ProjectUUID uuid.UUID
+	Email       string
+
+	// CreatedBy - автор задач из писем, ResponsibleBy - ответственный (пусто - ответственный проекта)
+	CreatedBy     string
+	CreatedByUUID uuid.UUID
+	ResponsibleBy string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IntakeMessage - письмо переписки по задаче из заявки, по Message-ID находятся ответы в той же переписке.
+type IntakeMessage struct {
+	MessageID string
+	TaskUUID  uuid.UUID
+	AgentUUID *uuid.UUID
+	CreatedAt time.Time
+}
+
+// IntakeTaskUUID - uuid задачи из письма: повторная доставка того же письма находит уже созданную задачу.
+func IntakeTaskUUID(projectUUID uuid.UUID, messageID string) uuid.UUID {
+	return uuid.NewSHA1(projectUUID, []byte("intake:"+messageID))
+}
+
+// IntakeTaskName - название задачи из темы письма без Re:/Fwd:, от 3 до 100 символов.
+func IntakeTaskName(subject, from string) string {
+	name := strings.Join(strings.Fields(intakeSubjectPrefix.ReplaceAllString(subject, "")), " ")
+
+	if len([]rune(name)) < 3 {
+		name = "Письмо от " + from
+	}
+
+	if r := []rune(name); len(r) > 100 {
+		name = string(r[:99]) + "…"
+	}
+
+	return name
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestIntakeTaskName(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		want    string
+	}{
+		{name: "plain", subject: "Не работает оплата", want: "Не работает оплата"},
+		{name: "prefixes", subject: "Fwd: RE:  Не работает\tоплата", want: "Не работает оплата"},
+		{name: "ru prefix", subject: "Отв: Счет", want: "Счет"},
+		{name: "empty", subject: "  ", want: "Письмо от ivan@mail.ru"},
+		{name: "short", subject: "Re: ok", want: "Письмо от ivan@mail.ru"},
+		{name: "long", subject: strings.Repeat("я", 120), want: strings.Repeat("я", 99) + "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IntakeTaskName(tt.subject, "ivan@mail.ru"); got != tt.want {
+				t.Errorf("IntakeTaskName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntakeTaskUUID(t *testing.T) {
+	project, other := uuid.New(), uuid.New()
+
+	if IntakeTaskUUID(project, "<a@mail.ru>") != IntakeTaskUUID(project, "<a@mail.ru>") {
+		t.Error("IntakeTaskUUID() differs for the same message")
+	}
+
+	if IntakeTaskUUID(project, "<a@mail.ru>") == IntakeTaskUUID(project, "<b@mail.ru>") {
+		t.Error("IntakeTaskUUID() equal for different messages")
+	}
+
+	if IntakeTaskUUID(project, "<a@mail.ru>") == IntakeTaskUUID(other, "<a@mail.ru>") {
+		t.Error("IntakeTaskUUID() equal for different projects")
+	}
+}
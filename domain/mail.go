@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Статусы исходящего письма.
+const (
+	MailPending = "pending"
+	MailSending = "sending"
+	MailSent    = "sent"
+	MailDead    = "dead" // попытки исчерпаны, письмо можно отправить повторно вручную
+)
+
+const (
+	MailMaxAttempts = 8
+
+	mailRetryBase = time.Minute
+	mailRetryMax  = 2 * time.Hour
+)
+
+var MailStatuses = []string{MailPending, MailSending, MailSent, MailDead}
+
+// Mail - письмо в очереди отправки.
+type Mail struct {
+	UUID    uuid.UUID
+	From    string
+	ReplyTo string // ответ на письмо уходит в комментарии задачи, см. emails.ReplyAddress
+	To      []string
+	Subject string
+	Body    string
+
+	// ListUnsubscribe - адрес отписки в один клик (RFC 8058), пустой - без заголовков List-Unsubscribe.
+	ListUnsubscribe string
+
+	Status        string
+	Attempts      int
+	Error         string
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RetryAt - следующая попытка с экспоненциальной паузой: 1, 2, 4... минуты, но не больше mailRetryMax.
+func (m Mail) RetryAt(now time.Time) time.Time {
+	delay := mailRetryBase
+	for i := 1; i < m.Attempts && delay < mailRetryMax; i++ {
+		delay *= 2
+	}
+
+	return now.Add(min(delay, mailRetryMax))
+}
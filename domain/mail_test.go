@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMailRetryAt(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Minute},
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 4, want: 8 * time.Minute},
+		{attempts: 8, want: 2 * time.Hour},
+		{attempts: 100, want: 2 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := (Mail{Attempts: tt.attempts}).RetryAt(now).Sub(now); got != tt.want {
+			t.Errorf("RetryAt(attempts=%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
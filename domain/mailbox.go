@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Статусы подключенного почтового ящика.
+const (
+	MailboxPaused = 0
+	MailboxActive = 1
+)
+
+// Статусы письма, отправленного из почтового ящика пользователя.
+const (
+	SentEmailQueued = "queued"
+	SentEmailSent   = "sent"
+	SentEmailFailed = "failed"
+)
+
+var ErrMailboxDomain = errors.New("некорректный домен почтового сервиса")
+
+// Mailbox - почтовый ящик пользователя: письма забираются по IMAP, отправляются через SMTP этого ящика.
+type Mailbox struct {
+	UUID     uuid.UUID
+	UserUUID uuid.UUID
+	Email    string
+	Password string // в открытом виде только в памяти, в базе - зашифрованный
+	Domain   string
+	Status   int
+
+	// UIDValidity и LastUID - с какого письма INBOX продолжать забирать почту
+	UIDValidity   uint32
+	LastUID       uint32
+	LastFetchedAt *time.Time
+	LastError     string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewMailbox - домен почтового сервиса по умолчанию берется из адреса.
+func NewMailbox(userUUID uuid.UUID, email, password, domain string) (Mailbox, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	domain, err := MailboxDomain(email, domain)
+	if err != nil {
+		return Mailbox{}, err
+	}
+
+	return Mailbox{
+		UUID:     uuid.New(),
+		UserUUID: userUUID,
+		Email:    email,
+		Password: password,
+		Domain:   domain,
+		Status:   MailboxActive,
+	}, nil
+}
+
+// MailboxDomain - домен почтового сервиса без схемы и порта, пустой - домен адреса.
+func MailboxDomain(email, domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		domain = strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+	}
+
+	if domain == "" || len(domain) > 100 || strings.ContainsAny(domain, "/:@ ") || !strings.Contains(domain, ".") {
+		return "", ErrMailboxDomain
+	}
+
+	return domain, nil
+}
+
+// MailboxEmail - письмо, полученное в почтовый ящик пользователя.
+type MailboxEmail struct {
+	ID          uint
+	MailboxUUID uuid.UUID
+	UserUUID    uuid.UUID
+	Email       string
+
+	Sender     string
+	Recipients []string
+	Subject    string
+	BodyText   string
+	MessageID  string
+	BodyHash   string
+
+	// агенты и задачи, связанные с письмом по адресам отправителя и получателей
+	AgentUUIDs []uuid.UUID
+	TaskUUIDs  []uuid.UUID
+
+	ReceivedAt time.Time
+	CreatedAt  time.Time
+}
+
+// MailboxEmailHash - одно и то же письмо, забранное повторно (или лежащее в ящике дважды), дает тот же хэш.
+func MailboxEmailHash(messageID, sender, subject, body string) string {
+	h := sha256.New()
+
+	for _, s := range []string{messageID, strings.ToLower(sender), subject, strings.Join(strings.Fields(body), " ")} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SentEmail - письмо, отправленное пользователем из своего почтового ящика.
+type SentEmail struct {
+	UUID           uuid.UUID
+	MailboxUUID    uuid.UUID
+	UserUUID       uuid.UUID
+	SenderEmail    string
+	RecipientEmail string
+	Subject        string
+	Body           string
+
+	Status string
+	Error  string
+
+	CreatedAt time.Time
+	SentAt    *time.Time
+}
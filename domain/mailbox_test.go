@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestMailboxDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		domain  string
+		want    string
+		wantErr bool
+	}{
+		{name: "from email", email: "ivan@mail.ru", want: "mail.ru"},
+		{name: "explicit", email: "ivan@company.ru", domain: " Yandex.RU ", want: "yandex.ru"},
+		{name: "with port", email: "ivan@mail.ru", domain: "mail.ru:993", wantErr: true},
+		{name: "with scheme", email: "ivan@mail.ru", domain: "imaps://mail.ru", wantErr: true},
+		{name: "no dot", email: "ivan@localhost", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MailboxDomain(tt.email, tt.domain)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("MailboxDomain() = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMailboxEmailHash(t *testing.T) {
+	h := MailboxEmailHash("<1@mail.ru>", "Ivan@mail.ru", "Счет", "Добрый день,\r\n счет во вложении")
+
+	if h != MailboxEmailHash("<1@mail.ru>", "ivan@mail.ru", "Счет", "Добрый день, счет во вложении") {
+		t.Error("MailboxEmailHash() differs for the same message")
+	}
+
+	if h == MailboxEmailHash("<2@mail.ru>", "ivan@mail.ru", "Счет", "Добрый день, счет во вложении") {
+		t.Error("MailboxEmailHash() equals for different messages")
+	}
+}
@@ -0,0 +1,476 @@
+package domain
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Поддерживаемый диалект Markdown для описаний задач и комментариев:
+//
+//	# заголовок (1-6 уровней), > цитата, ``` блок кода ```, `код`, ---
+//	- список, 1. нумерованный список, - [ ] / - [x] чекбоксы
+//	**жирный**, *курсив*, _курсив_, ~~зачеркнутый~~
+//	[текст](https://...), https://... - ссылки (http, https, mailto, относительные)
+//	@упоминание, @[Имя Фамилия], PRJ-12 - ссылка на задачу
+//
+// Остальная разметка выводится как текст, HTML в исходнике не допускается.
+
+var (
+	mdHeadingExp  = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	mdFenceExp    = regexp.MustCompile("^\\s*```\\s*([\\w+\\-]*)\\s*$")
+	mdBulletExp   = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	mdOrderedExp  = regexp.MustCompile(`^\s*(\d{1,9})[.)]\s+(.*)$`)
+	mdCheckboxExp = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	mdQuoteExp    = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdRuleExp     = regexp.MustCompile(`^\s*(?:-\s*){3,}$|^\s*(?:\*\s*){3,}$`)
+
+	mdCodeExp     = regexp.MustCompile("`([^`\n]+)`")
+	mdLinkExp     = regexp.MustCompile(`\[([^\[\]\n]+)\]\(([^()\s\x00]*)\)`)
+	mdAutoLinkExp = regexp.MustCompile(`https?://[^\s<>()\[\]"'\x00]+[^\s<>()\[\]"'.,;:!?\x00]`)
+	mdStrongExp   = regexp.MustCompile(`\*\*([^*\n]+)\*\*`)
+	mdStrikeExp   = regexp.MustCompile(`~~([^~\n]+)~~`)
+	mdEmExp       = regexp.MustCompile(`\*([^*\n]+)\*`)
+	mdEmUnderExp  = regexp.MustCompile(`(^|[^\p{L}\p{N}_])_([^_\n]+)_($|[^\p{L}\p{N}_])`)
+	mdTaskRefExp  = regexp.MustCompile(`(^|[^\p{L}\p{N}_\-])([A-Z][A-Z0-9]{1,9})-(\d{1,9})\b`)
+
+	mdCommentExp = regexp.MustCompile(`(?s)<!--.*?(?:-->|$)`)
+	mdTagExp     = regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9\-]*(?:\s[^<>]*)?/?>`)
+)
+
+// TaskRef - ссылка на задачу вида PRJ-12.
+type TaskRef struct {
+	Prefix string
+	ID     int
+}
+
+func (r TaskRef) String() string {
+	return fmt.Sprintf("%s-%d", r.Prefix, r.ID)
+}
+
+// taskRefTranslit - транслитерация кириллицы для префикса ссылок на задачи.
+var taskRefTranslit = strings.NewReplacer(
+	"А", "A", "Б", "B", "В", "V", "Г", "G", "Д", "D", "Е", "E", "Ё", "E", "Ж", "ZH", "З", "Z", "И", "I", "Й", "Y",
+	"К", "K", "Л", "L", "М", "M", "Н", "N", "О", "O", "П", "P", "Р", "R", "С", "S", "Т", "T", "У", "U", "Ф", "F",
+	"Х", "H", "Ц", "C", "Ч", "CH", "Ш", "SH", "Щ", "SCH", "Ъ", "", "Ы", "Y", "Ь", "", "Э", "E", "Ю", "YU", "Я", "YA",
+)
+
+// NewTaskRef - ссылка на задачу проекта: префикс из латинских инициалов названия проекта
+// («Отдел продаж» - OP), для названия из одного слова - первые три буквы, иначе TASK.
+func NewTaskRef(projectName string, id int) TaskRef {
+	words := []string{}
+	for _, w := range strings.FieldsFunc(taskRefTranslit.Replace(strings.ToUpper(projectName)), func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		if w[0] >= 'A' && w[0] <= 'Z' {
+			words = append(words, w)
+		}
+	}
+
+	prefix := "TASK"
+	switch {
+	case len(words) == 1 && len(words[0]) >= 2:
+		prefix = words[0][:min(3, len(words[0]))]
+	case len(words) > 1:
+		prefix = ""
+		for _, w := range words[:min(len(words), 10)] {
+			prefix += w[:1]
+		}
+	}
+
+	return TaskRef{Prefix: prefix, ID: id}
+}
+
+// ParseTaskRefs - уникальные ссылки на задачи в тексте (без блоков кода).
+func ParseTaskRefs(text string) []TaskRef {
+	refs := []TaskRef{}
+	seen := make(map[TaskRef]bool)
+
+	for _, m := range mdTaskRefExp.FindAllStringSubmatch(stripMarkdownCode(text), -1) {
+		id, err := strconv.Atoi(m[3])
+		if err != nil || id == 0 {
+			continue
+		}
+
+		ref := TaskRef{Prefix: m[2], ID: id}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// SanitizeMarkdown - приводит текст к поддерживаемому диалекту перед сохранением:
+// переводы строк, управляющие символы, HTML-теги и ссылки с небезопасными схемами.
+// Содержимое блоков кода не меняется.
+func SanitizeMarkdown(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+
+	src = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+
+		if unicode.IsControl(r) || r == unicode.ReplacementChar {
+			return -1
+		}
+
+		return r
+	}, src)
+
+	// блоки кода не меняются, остальной текст чистится целиком (HTML-комментарий может быть многострочным)
+	out := []string{}
+	text := []string{}
+	fenced := false
+
+	flush := func() {
+		if len(text) == 0 {
+			return
+		}
+
+		out = append(out, sanitizeMarkdownText(strings.Join(text, "\n")))
+		text = text[:0]
+	}
+
+	for _, line := range strings.Split(src, "\n") {
+		if mdFenceExp.MatchString(line) {
+			if !fenced {
+				flush()
+			}
+
+			fenced = !fenced
+			out = append(out, line)
+
+			continue
+		}
+
+		if fenced {
+			out = append(out, line)
+		} else {
+			text = append(text, line)
+		}
+	}
+
+	flush()
+
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+func sanitizeMarkdownText(s string) string {
+	return mapOutsideCode(s, func(s string) string {
+		// до неподвижной точки: удаление тега может склеить новый (<scr<script>ipt>)
+		for prev := ""; prev != s; {
+			prev = s
+			s = mdCommentExp.ReplaceAllString(s, "")
+			s = mdTagExp.ReplaceAllString(s, "")
+		}
+
+		return mdLinkExp.ReplaceAllStringFunc(s, func(link string) string {
+			m := mdLinkExp.FindStringSubmatch(link)
+			if _, ok := safeURL(m[2]); ok {
+				return link
+			}
+
+			return m[1]
+		})
+	})
+}
+
+// RenderMarkdown - безопасный HTML по тексту в поддерживаемом диалекте. Весь пользовательский текст экранируется.
+func RenderMarkdown(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\x00", "")
+
+	var b strings.Builder
+
+	lines := strings.Split(src, "\n")
+	paragraph := []string{}
+	list := ""
+
+	closeParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(mapStrings(paragraph, renderInline), "<br>"))
+		b.WriteString("</p>")
+
+		paragraph = paragraph[:0]
+	}
+
+	closeList := func() {
+		if list != "" {
+			b.WriteString("</" + list + ">")
+			list = ""
+		}
+	}
+
+	openList := func(tag string) {
+		if list != tag {
+			closeList()
+			b.WriteString("<" + tag + ">")
+			list = tag
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if m := mdFenceExp.FindStringSubmatch(line); m != nil {
+			closeParagraph()
+			closeList()
+
+			code := []string{}
+			for i++; i < len(lines) && !mdFenceExp.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+
+			if m[1] != "" {
+				b.WriteString(`<pre><code class="language-` + html.EscapeString(m[1]) + `">`)
+			} else {
+				b.WriteString("<pre><code>")
+			}
+
+			b.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			b.WriteString("</code></pre>")
+
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			closeParagraph()
+			closeList()
+
+			continue
+		}
+
+		if mdRuleExp.MatchString(line) {
+			closeParagraph()
+			closeList()
+			b.WriteString("<hr>")
+
+			continue
+		}
+
+		if m := mdHeadingExp.FindStringSubmatch(line); m != nil {
+			closeParagraph()
+			closeList()
+
+			level := strconv.Itoa(len(m[1]))
+			b.WriteString("<h" + level + ">" + renderInline(m[2]) + "</h" + level + ">")
+
+			continue
+		}
+
+		if m := mdQuoteExp.FindStringSubmatch(line); m != nil {
+			closeParagraph()
+			closeList()
+
+			quote := []string{m[1]}
+			for i+1 < len(lines) {
+				next := mdQuoteExp.FindStringSubmatch(lines[i+1])
+				if next == nil {
+					break
+				}
+
+				quote = append(quote, next[1])
+				i++
+			}
+
+			b.WriteString("<blockquote>" + strings.Join(mapStrings(quote, renderInline), "<br>") + "</blockquote>")
+
+			continue
+		}
+
+		if m := mdBulletExp.FindStringSubmatch(line); m != nil {
+			closeParagraph()
+			openList("ul")
+
+			if c := mdCheckboxExp.FindStringSubmatch(m[1]); c != nil {
+				checked := ""
+				if c[1] != " " {
+					checked = " checked"
+				}
+
+				b.WriteString(`<li class="task-list-item"><input type="checkbox" disabled` + checked + `> ` + renderInline(c[2]) + "</li>")
+			} else {
+				b.WriteString("<li>" + renderInline(m[1]) + "</li>")
+			}
+
+			continue
+		}
+
+		if m := mdOrderedExp.FindStringSubmatch(line); m != nil {
+			closeParagraph()
+
+			if list != "ol" {
+				closeList()
+
+				if m[1] != "1" {
+					b.WriteString(`<ol start="` + m[1] + `">`)
+				} else {
+					b.WriteString("<ol>")
+				}
+
+				list = "ol"
+			}
+
+			b.WriteString("<li>" + renderInline(m[2]) + "</li>")
+
+			continue
+		}
+
+		closeList()
+		paragraph = append(paragraph, line)
+	}
+
+	closeParagraph()
+	closeList()
+
+	return b.String()
+}
+
+// renderInline - строка без блочной разметки. Код, ссылки, упоминания и ссылки на задачи заменяются
+// плейсхолдерами, чтобы их содержимое не затрагивалось остальными правилами. Атрибуты строятся из исходного
+// текста с экранированием, плейсхолдер в атрибут не попадает: адреса ссылок их не захватывают,
+// а упоминание с плейсхолдером остается текстом.
+func renderInline(s string) string {
+	holders := []string{}
+	hold := func(h string) string {
+		holders = append(holders, h)
+		return "\x00" + strconv.Itoa(len(holders)-1) + "\x00"
+	}
+
+	s = mdCodeExp.ReplaceAllStringFunc(s, func(code string) string {
+		return hold("<code>" + html.EscapeString(code[1:len(code)-1]) + "</code>")
+	})
+
+	s = mdLinkExp.ReplaceAllStringFunc(s, func(link string) string {
+		m := mdLinkExp.FindStringSubmatch(link)
+
+		href, ok := safeURL(m[2])
+		if !ok {
+			return hold(html.EscapeString(m[1]))
+		}
+
+		return hold(anchor(href, html.EscapeString(m[1])))
+	})
+
+	s = mdAutoLinkExp.ReplaceAllStringFunc(s, func(link string) string {
+		return hold(anchor(link, html.EscapeString(link)))
+	})
+
+	s = mentionExp.ReplaceAllStringFunc(s, func(m string) string {
+		at := strings.Index(m, "@")
+		mention := m[at+1:]
+
+		// хвостовые точки не часть упоминания, как и в ParseMentions
+		tail := ""
+		if !strings.HasPrefix(mention, "[") {
+			trimmed := strings.TrimRight(mention, ".-")
+			tail = mention[len(trimmed):]
+			mention = trimmed
+		}
+
+		if mention == "" || strings.Contains(mention, "\x00") {
+			return m
+		}
+
+		name := strings.TrimSpace(strings.Trim(mention, "[]"))
+
+		return m[:at] + hold(`<span class="mention" data-mention="`+html.EscapeString(name)+`">@`+html.EscapeString(mention)+"</span>") + tail
+	})
+
+	s = mdTaskRefExp.ReplaceAllStringFunc(s, func(ref string) string {
+		m := mdTaskRefExp.FindStringSubmatch(ref)
+
+		return m[1] + hold(`<span class="task-ref" data-ref="`+m[2]+"-"+m[3]+`">`+m[2]+"-"+m[3]+"</span>")
+	})
+
+	s = html.EscapeString(s)
+
+	s = mdStrongExp.ReplaceAllString(s, "<strong>$1</strong>")
+	s = mdStrikeExp.ReplaceAllString(s, "<del>$1</del>")
+	s = mdEmExp.ReplaceAllString(s, "<em>$1</em>")
+	s = mdEmUnderExp.ReplaceAllString(s, "$1<em>$2</em>$3")
+
+	// с конца: текст ссылки может содержать плейсхолдер кода
+	for i := len(holders) - 1; i >= 0; i-- {
+		s = strings.Replace(s, "\x00"+strconv.Itoa(i)+"\x00", holders[i], 1)
+	}
+
+	return s
+}
+
+func anchor(href, text string) string {
+	return `<a href="` + html.EscapeString(href) + `" rel="nofollow noopener noreferrer" target="_blank">` + text + "</a>"
+}
+
+// safeURL - ссылки только http(s), mailto и относительные внутри сайта.
+func safeURL(raw string) (string, bool) {
+	u := strings.TrimSpace(raw)
+	lower := strings.ToLower(u)
+
+	switch {
+	case u == "":
+		return "", false
+	case strings.HasPrefix(lower, "http://"), strings.HasPrefix(lower, "https://"), strings.HasPrefix(lower, "mailto:"):
+		return u, true
+	case strings.HasPrefix(u, "/") && !strings.HasPrefix(u, "//"), strings.HasPrefix(u, "#"):
+		return u, true
+	}
+
+	return "", false
+}
+
+// mapOutsideCode - применяет fn к тексту вне `кода` в строке.
+func mapOutsideCode(s string, fn func(string) string) string {
+	var b strings.Builder
+
+	last := 0
+	for _, loc := range mdCodeExp.FindAllStringIndex(s, -1) {
+		b.WriteString(fn(s[last:loc[0]]))
+		b.WriteString(s[loc[0]:loc[1]])
+		last = loc[1]
+	}
+
+	b.WriteString(fn(s[last:]))
+
+	return b.String()
+}
+
+// stripMarkdownCode - текст без блоков и фрагментов кода.
+func stripMarkdownCode(s string) string {
+	lines := strings.Split(s, "\n")
+	out := []string{}
+	fenced := false
+
+	for _, line := range lines {
+		if mdFenceExp.MatchString(line) {
+			fenced = !fenced
+			continue
+		}
+
+		if !fenced {
+			out = append(out, mdCodeExp.ReplaceAllString(line, ""))
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+func mapStrings(items []string, fn func(string) string) []string {
+	res := make([]string, len(items))
+	for i, item := range items {
+		res[i] = fn(item)
+	}
+
+	return res
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSanitizeMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "newlines and control chars",
+			src:  "строка\r\nвторая\x07\r\n",
+			want: "строка\nвторая",
+		},
+		{
+			name: "html tags",
+			src:  "текст <script>alert(1)</script> <b>жирный</b> <!-- скрыто -->и a < b",
+			want: "текст alert(1) жирный и a < b",
+		},
+		{
+			name: "link with parens stays text",
+			src:  "[нажми](javascript:alert(1)) и [сайт](https://example.com)",
+			want: "[нажми](javascript:alert(1)) и [сайт](https://example.com)",
+		},
+		{
+			name: "unsafe link scheme",
+			src:  "[нажми](javascript:void) и [сайт](https://example.com)",
+			want: "нажми и [сайт](https://example.com)",
+		},
+		{
+			name: "nested tags",
+			src:  "<scr<script>ipt>alert(1)</script> <<b>img src=x onerror=alert(1)>",
+			want: "alert(1)",
+		},
+		{
+			name: "code is kept",
+			src:  "`<div>` пример\n```html\n<div>блок</div>\n```",
+			want: "`<div>` пример\n```html\n<div>блок</div>\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeMarkdown(tt.src); got != tt.want {
+				t.Errorf("SanitizeMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "escape",
+			src:  `<img src=x onerror="alert(1)">`,
+			want: `<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>`,
+		},
+		{
+			name: "heading and emphasis",
+			src:  "## План\n**важно** и *курсив*, ~~старое~~",
+			want: "<h2>План</h2><p><strong>важно</strong> и <em>курсив</em>, <del>старое</del></p>",
+		},
+		{
+			name: "lists and checkboxes",
+			src:  "- [ ] сделать\n- [x] готово\n\n1. раз\n2. два",
+			want: `<ul><li class="task-list-item"><input type="checkbox" disabled> сделать</li><li class="task-list-item"><input type="checkbox" disabled checked> готово</li></ul><ol><li>раз</li><li>два</li></ol>`,
+		},
+		{
+			name: "code",
+			src:  "`a < b`\n```go\nif a < b {}\n```",
+			want: `<p><code>a &lt; b</code></p><pre><code class="language-go">if a &lt; b {}</code></pre>`,
+		},
+		{
+			name: "links",
+			src:  "[сайт](https://example.com?a=1&b=2) [плохо](javascript:alert) https://crm.ru/task.",
+			want: `<p><a href="https://example.com?a=1&amp;b=2" rel="nofollow noopener noreferrer" target="_blank">сайт</a> плохо <a href="https://crm.ru/task" rel="nofollow noopener noreferrer" target="_blank">https://crm.ru/task</a>.</p>`,
+		},
+		{
+			name: "mentions and task refs",
+			src:  "@ivan, @[Иван Петров] посмотрите PRJ-12.",
+			want: `<p><span class="mention" data-mention="ivan">@ivan</span>, <span class="mention" data-mention="Иван Петров">@[Иван Петров]</span> посмотрите <span class="task-ref" data-ref="PRJ-12">PRJ-12</span>.</p>`,
+		},
+		{
+			name: "link inside mention",
+			src:  "@[a https://x.com/a/onmouseover=location=/javascript:alert%281%29/.source//]",
+			want: `<p>@[a <a href="https://x.com/a/onmouseover=location=/javascript:alert%281%29/.source//" rel="nofollow noopener noreferrer" target="_blank">https://x.com/a/onmouseover=location=/javascript:alert%281%29/.source//</a>]</p>`,
+		},
+		{
+			name: "code inside mention and link",
+			src:  "@[a `x`] [`код`](https://crm.ru/`\"`)",
+			want: "<p>@[a <code>x</code>] [<code>код</code>](<a href=\"https://crm.ru/\" rel=\"nofollow noopener noreferrer\" target=\"_blank\">https://crm.ru/</a><code>&#34;</code>)</p>",
+		},
+		{
+			name: "task ref inside mention",
+			src:  "@[PRJ-12] и @[a\"b]",
+			want: `<p><span class="mention" data-mention="PRJ-12">@[PRJ-12]</span> и <span class="mention" data-mention="a&#34;b">@[a&#34;b]</span></p>`,
+		},
+		{
+			name: "emphasis around mention",
+			src:  "@[a*b] *c*",
+			want: `<p><span class="mention" data-mention="a*b">@[a*b]</span> <em>c</em></p>`,
+		},
+		{
+			name: "quote and paragraph",
+			src:  "> цитата\n> дальше\nтекст\nстрока",
+			want: "<blockquote>цитата<br>дальше</blockquote><p>текст<br>строка</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderMarkdown(tt.src); got != tt.want {
+				t.Errorf("RenderMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTaskRefs(t *testing.T) {
+	got := ParseTaskRefs("PRJ-12 и CRM-3, снова PRJ-12, `DEV-1`, utf-8, X-1")
+	want := []TaskRef{{Prefix: "PRJ", ID: 12}, {Prefix: "CRM", ID: 3}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTaskRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestNewTaskRef(t *testing.T) {
+	tests := []struct {
+		project string
+		want    string
+	}{
+		{project: "Отдел продаж", want: "OP-12"},
+		{project: "Маркетинг", want: "MAR-12"},
+		{project: "CRM backend v2", want: "CBV-12"},
+		{project: "Я", want: "YA-12"},
+		{project: "42 задачи", want: "ZAD-12"},
+		{project: "!!!", want: "TASK-12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.project, func(t *testing.T) {
+			ref := NewTaskRef(tt.project, 12)
+			if ref.String() != tt.want {
+				t.Errorf("NewTaskRef() = %s, want %s", ref, tt.want)
+			}
+
+			if got := ParseTaskRefs("см. " + ref.String()); len(got) != 1 || got[0] != ref {
+				t.Errorf("ParseTaskRefs(%s) = %v", ref, got)
+			}
+		})
+	}
+}
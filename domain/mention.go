@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// mentionExp - @email, @имя, @Имя_Фамилия или @[название с пробелами].
+// Перед @ не должно быть буквы или цифры, чтобы не ловить обычные email в тексте.
+var mentionExp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@.])@(\[[^\]\n]{1,100}\]|[\p{L}\p{N}._%+\-]+@[\p{L}\p{N}.\-]+\.\p{L}{2,}|[\p{L}\p{N}_.\-]+)`)
+
+// ParseMentions - упоминания из текста комментария без @, уникальные, email в нижнем регистре.
+func ParseMentions(text string) []string {
+	mentions := []string{}
+	seen := make(map[string]bool)
+
+	for _, m := range mentionExp.FindAllStringSubmatch(text, -1) {
+		mention := m[1]
+
+		switch {
+		case strings.HasPrefix(mention, "["):
+			mention = strings.TrimSpace(strings.Trim(mention, "[]"))
+		case IsMentionEmail(mention):
+			mention = strings.ToLower(mention)
+		default:
+			mention = strings.TrimRight(mention, ".-")
+		}
+
+		if mention == "" || seen[mention] {
+			continue
+		}
+
+		seen[mention] = true
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
+
+func IsMentionEmail(mention string) bool {
+	return strings.Contains(mention, "@")
+}
+
+// CommentMention - комментарий с упоминанием пользователя и задача, в которой он оставлен.
+type CommentMention struct {
+	Comment         Comment
+	TaskID          int
+	TaskName        string
+	TaskCompanyUUID uuid.UUID
+	MentionedAt     int64
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "Email", text: "@Ivan@Mail.ru посмотри", want: []string{"ivan@mail.ru"}},
+		{name: "Name and group", text: "@Иван_Петров и @[Отдел продаж], срочно", want: []string{"Иван_Петров", "Отдел продаж"}},
+		{name: "End of sentence", text: "Спасибо, @ivan.", want: []string{"ivan"}},
+		{name: "Plain email is not mention", text: "пишите на info@mail.ru", want: []string{}},
+		{name: "Duplicates", text: "@ivan @ivan", want: []string{"ivan"}},
+		{name: "Start of line", text: "строка\n@maria", want: []string{"maria"}},
+		{name: "Empty brackets", text: "@[ ]", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMentions(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMentions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
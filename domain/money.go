@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAmount   = errors.New("некорректная сумма")
+	ErrInvalidCurrency = errors.New("некорректный код валюты (ISO 4217)")
+	ErrRateNotFound    = errors.New("курс валюты не найден")
+)
+
+// MoneyMaxScale - максимальное количество знаков после запятой в сумме.
+const MoneyMaxScale = 4
+
+// Money - значение кастомного поля типа money.
+// Сумма хранится строкой, чтобы не терять точность при сериализации в jsonb.
+type Money struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func NewMoney(amount interface{}, currency string) (Money, error) {
+	r, err := ParseAmount(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !IsCurrency(currency) {
+		return Money{}, ErrInvalidCurrency
+	}
+
+	return Money{
+		Amount:   FormatAmount(r),
+		Currency: currency,
+	}, nil
+}
+
+func (m Money) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(m.Amount)
+	if !ok {
+		return new(big.Rat)
+	}
+
+	return r
+}
+
+// ParseAmount - принимает строку или число из json и возвращает точное значение суммы.
+func ParseAmount(amount interface{}) (*big.Rat, error) {
+	var s string
+
+	switch v := amount.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	default:
+		return nil, ErrInvalidAmount
+	}
+
+	if s == "" || strings.ContainsAny(s, "eE/") {
+		return nil, ErrInvalidAmount
+	}
+
+	if i := strings.Index(s, "."); i != -1 && len(s)-i-1 > MoneyMaxScale {
+		return nil, fmt.Errorf("сумма: не более %d знаков после запятой", MoneyMaxScale)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+
+	return r, nil
+}
+
+// FormatAmount - 2 знака после запятой, если их достаточно, иначе до MoneyMaxScale.
+func FormatAmount(r *big.Rat) string {
+	for scale := 2; scale < MoneyMaxScale; scale++ {
+		s := r.FloatString(scale)
+		if v, ok := new(big.Rat).SetString(s); ok && v.Cmp(r) == 0 {
+			return s
+		}
+	}
+
+	return r.FloatString(MoneyMaxScale)
+}
+
+func IsCurrency(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+type CurrencyRate struct {
+	UUID        uuid.UUID
+	CompanyUUID uuid.UUID
+	From        string
+	To          string
+	Rate        string
+	Date        time.Time
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewCurrencyRate(companyUUID uuid.UUID, from, to string, rate interface{}, date time.Time, createdBy string) (CurrencyRate, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+
+	if !IsCurrency(from) || !IsCurrency(to) {
+		return CurrencyRate{}, ErrInvalidCurrency
+	}
+
+	if from == to {
+		return CurrencyRate{}, errors.New("валюты курса должны отличаться")
+	}
+
+	r, ok := new(big.Rat).SetString(fmt.Sprintf("%v", rate))
+	if !ok || r.Sign() <= 0 {
+		return CurrencyRate{}, errors.New("курс должен быть положительным числом")
+	}
+
+	return CurrencyRate{
+		UUID:        uuid.New(),
+		CompanyUUID: companyUUID,
+		From:        from,
+		To:          to,
+		Rate:        r.FloatString(10),
+		Date:        time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		CreatedBy:   createdBy,
+	}, nil
+}
+
+type CurrencyRates []CurrencyRate
+
+// Convert - переводит сумму в валюту to по последнему курсу на дату at.
+// Если прямого курса нет, используется обратный.
+func (rs CurrencyRates) Convert(m Money, to string, at time.Time) (Money, error) {
+	if m.Currency == to {
+		return m, nil
+	}
+
+	sorted := make(CurrencyRates, len(rs))
+	copy(sorted, rs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.After(sorted[j].Date)
+	})
+
+	for _, rate := range sorted {
+		if rate.Date.After(at) {
+			continue
+		}
+
+		r, ok := new(big.Rat).SetString(rate.Rate)
+		if !ok || r.Sign() == 0 {
+			continue
+		}
+
+		if rate.From == m.Currency && rate.To == to {
+			return Money{Amount: FormatAmount(roundRat(new(big.Rat).Mul(m.Rat(), r))), Currency: to}, nil
+		}
+
+		if rate.From == to && rate.To == m.Currency {
+			return Money{Amount: FormatAmount(roundRat(new(big.Rat).Quo(m.Rat(), r))), Currency: to}, nil
+		}
+	}
+
+	return Money{}, fmt.Errorf("%w: %s -> %s", ErrRateNotFound, m.Currency, to)
+}
+
+func roundRat(r *big.Rat) *big.Rat {
+	v, _ := new(big.Rat).SetString(r.FloatString(MoneyMaxScale))
+	return v
+}
+
+// currencies - действующие коды ISO 4217.
+var currencies = map[string]struct{}{
+	"AED": {}, "AFN": {}, "ALL": {}, "AMD": {}, "ANG": {}, "AOA": {}, "ARS": {}, "AUD": {}, "AWG": {}, "AZN": {},
+	"BAM": {}, "BBD": {}, "BDT": {}, "BGN": {}, "BHD": {}, "BIF": {}, "BMD": {}, "BND": {}, "BOB": {}, "BRL": {},
+	"BSD": {}, "BTN": {}, "BWP": {}, "BYN": {}, "BZD": {}, "CAD": {}, "CDF": {}, "CHF": {}, "CLP": {}, "CNY": {},
+	"COP": {}, "CRC": {}, "CUP": {}, "CVE": {}, "CZK": {}, "DJF": {}, "DKK": {}, "DOP": {}, "DZD": {}, "EGP": {},
+	"ERN": {}, "ETB": {}, "EUR": {}, "FJD": {}, "FKP": {}, "GBP": {}, "GEL": {}, "GHS": {}, "GIP": {}, "GMD": {},
+	"GNF": {}, "GTQ": {}, "GYD": {}, "HKD": {}, "HNL": {}, "HTG": {}, "HUF": {}, "IDR": {}, "ILS": {}, "INR": {},
+	"IQD": {}, "IRR": {}, "ISK": {}, "JMD": {}, "JOD": {}, "JPY": {}, "KES": {}, "KGS": {}, "KHR": {}, "KMF": {},
+	"KPW": {}, "KRW": {}, "KWD": {}, "KYD": {}, "KZT": {}, "LAK": {}, "LBP": {}, "LKR": {}, "LRD": {}, "LSL": {},
+	"LYD": {}, "MAD": {}, "MDL": {}, "MGA": {}, "MKD": {}, "MMK": {}, "MNT": {}, "MOP": {}, "MRU": {}, "MUR": {},
+	"MVR": {}, "MWK": {}, "MXN": {}, "MYR": {}, "MZN": {}, "NAD": {}, "NGN": {}, "NIO": {}, "NOK": {}, "NPR": {},
+	"NZD": {}, "OMR": {}, "PAB": {}, "PEN": {}, "PGK": {}, "PHP": {}, "PKR": {}, "PLN": {}, "PYG": {}, "QAR": {},
+	"RON": {}, "RSD": {}, "RUB": {}, "RWF": {}, "SAR": {}, "SBD": {}, "SCR": {}, "SDG": {}, "SEK": {}, "SGD": {},
+	"SHP": {}, "SLE": {}, "SOS": {}, "SRD": {}, "SSP": {}, "STN": {}, "SVC": {}, "SYP": {}, "SZL": {}, "THB": {},
+	"TJS": {}, "TMT": {}, "TND": {}, "TOP": {}, "TRY": {}, "TTD": {}, "TWD": {}, "TZS": {}, "UAH": {}, "UGX": {},
+	"USD": {}, "UYU": {}, "UZS": {}, "VES": {}, "VND": {}, "VUV": {}, "WST": {}, "XAF": {}, "XCD": {}, "XOF": {},
+	"XPF": {}, "YER": {}, "ZAR": {}, "ZMW": {}, "ZWL": {},
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   interface{}
+		currency string
+		want     string
+		wantErr  bool
+	}{
+		{name: "Float", amount: 0.1, currency: "rub", want: "0.10"},
+		{name: "String", amount: "1000.125", currency: "USD", want: "1000.125"},
+		{name: "Integer", amount: 15, currency: "EUR", want: "15.00"},
+		{name: "Too precise", amount: "1.00001", currency: "USD", wantErr: true},
+		{name: "Exponent", amount: "1e10", currency: "USD", wantErr: true},
+		{name: "Unknown currency", amount: 1, currency: "XXX", wantErr: true},
+		{name: "Bool", amount: true, currency: "USD", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMoney(tt.amount, tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewMoney() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && m.Amount != tt.want {
+				t.Errorf("NewMoney() amount = %s, want %s", m.Amount, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyRatesConvert(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	old, _ := NewCurrencyRate(uuid.New(), "USD", "RUB", "90", day(1), "")
+	actual, _ := NewCurrencyRate(uuid.New(), "USD", "RUB", "100", day(10), "")
+	rates := CurrencyRates{old, actual}
+
+	tests := []struct {
+		name    string
+		money   Money
+		to      string
+		at      time.Time
+		want    string
+		wantErr bool
+	}{
+		{name: "Direct", money: Money{Amount: "2.50", Currency: "USD"}, to: "RUB", at: day(19), want: "250.00"},
+		{name: "By date", money: Money{Amount: "1.00", Currency: "USD"}, to: "RUB", at: day(5), want: "90.00"},
+		{name: "Inverse", money: Money{Amount: "150.00", Currency: "RUB"}, to: "USD", at: day(19), want: "1.50"},
+		{name: "Same", money: Money{Amount: "1.00", Currency: "RUB"}, to: "RUB", at: day(19), want: "1.00"},
+		{name: "Before first rate", money: Money{Amount: "1.00", Currency: "USD"}, to: "RUB", at: day(0), wantErr: true},
+		{name: "No rate", money: Money{Amount: "1.00", Currency: "EUR"}, to: "RUB", at: day(19), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(tt.money, tt.to, tt.at)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && (got.Amount != tt.want || got.Currency != tt.to) {
+				t.Errorf("Convert() = %+v, want %s %s", got, tt.want, tt.to)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Статусы очереди доставки email и sms уведомлений.
+const (
+	DeliveryPending = "pending" // ждет окна доставки
+	DeliverySending = "sending" // взято в отправку
+	DeliverySent    = "sent"
+	DeliveryFailed  = "failed" // исчерпаны попытки
+)
+
+const DeliveryMaxAttempts = 3
+
+var DeliveryStatuses = []string{DeliveryPending, DeliverySending, DeliverySent, DeliveryFailed}
+
+var notifyEventNames = map[string]string{
+	NotifyAssigned:  "вы назначены",
+	NotifyMentioned: "вас упомянули",
+	NotifyStatus:    "изменен статус",
+	NotifyComment:   "новый комментарий",
+	NotifyReminder:  "напоминание",
+	NotifyFile:      "загружен файл",
+	NotifyUpdated:   "задача обновлена",
+}
+
+// NotificationDelivery - отложенная доставка уведомления по внешнему каналу.
+// Пока доставка в статусе pending, новые события по той же сущности обновляют ее, а не создают новую.
+type NotificationDelivery struct {
+	UUID        uuid.UUID
+	UserEmail   string
+	Channel     string
+	Type        string
+	EntityUUID  uuid.UUID
+	ProjectUUID *uuid.UUID
+	CompanyUUID *uuid.UUID
+
+	Events  []string
+	Subject string
+	URL     string
+	Urgent  bool
+
+	Status   string
+	Attempts int
+	Error    string
+
+	DeliverAt time.Time
+	SentAt    *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RetryAt - время следующей попытки после неудачной отправки.
+func (d NotificationDelivery) RetryAt(now time.Time) time.Time {
+	return now.Add(time.Duration(d.Attempts) * 5 * time.Minute)
+}
+
+// Text - текст уведомления, собирается при отправке, так как события накапливаются пока доставка ждет окна.
+func (d NotificationDelivery) Text() string {
+	names := lo.FilterMap(NotifyEvents, func(event string, _ int) (string, bool) {
+		return notifyEventNames[event], lo.Contains(d.Events, event)
+	})
+
+	return d.Subject + ": " + strings.Join(names, ", ")
+}
@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// События, о которых уведомляется пользователь.
+const (
+	NotifyAssigned  = "assigned"  // назначен ответственным, исполнителем, менеджером или соисполнителем
+	NotifyMentioned = "mentioned" // упомянут в комментарии
+	NotifyStatus    = "status"    // изменен статус задачи
+	NotifyComment   = "comment"   // новый комментарий
+	NotifyReminder  = "reminder"  // напоминание
+	NotifyFile      = "file"      // загружен файл
+	NotifyUpdated   = "updated"   // прочие изменения задачи
+)
+
+// Каналы доставки уведомлений.
+const (
+	ChannelApp     = "app"
+	ChannelEmail   = "email"
+	ChannelSMS     = "sms"
+	ChannelWebhook = "webhook"
+)
+
+var (
+	NotifyEvents   = []string{NotifyAssigned, NotifyMentioned, NotifyStatus, NotifyComment, NotifyReminder, NotifyFile, NotifyUpdated}
+	NotifyChannels = []string{ChannelApp, ChannelEmail, ChannelSMS, ChannelWebhook}
+
+	ErrNotifyEvent   = errors.New("неизвестный тип уведомления")
+	ErrNotifyChannel = errors.New("неизвестный канал уведомлений")
+	ErrDigestPeriod  = errors.New("неизвестная периодичность дайджеста")
+)
+
+// NotificationMatrix - событие -> канал -> включено.
+type NotificationMatrix map[string]map[string]bool
+
+// DefaultNotificationMatrix - все события в приложении, на почту только личные.
+func DefaultNotificationMatrix() NotificationMatrix {
+	m := NotificationMatrix{}
+
+	for _, event := range NotifyEvents {
+		m[event] = map[string]bool{
+			ChannelApp:     true,
+			ChannelEmail:   lo.Contains([]string{NotifyAssigned, NotifyMentioned, NotifyReminder}, event),
+			ChannelSMS:     false,
+			ChannelWebhook: false,
+		}
+	}
+
+	return m
+}
+
+func (m NotificationMatrix) Validate() error {
+	for event, channels := range m {
+		if !lo.Contains(NotifyEvents, event) {
+			return fmt.Errorf("%w: %s", ErrNotifyEvent, event)
+		}
+
+		for channel := range channels {
+			if !lo.Contains(NotifyChannels, channel) {
+				return fmt.Errorf("%w: %s", ErrNotifyChannel, channel)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m NotificationMatrix) get(event, channel string) (enabled, found bool) {
+	channels, ok := m[event]
+	if !ok {
+		return false, false
+	}
+
+	enabled, found = channels[channel]
+
+	return enabled, found
+}
+
+// ProjectNotificationSettings - переопределение настроек для проекта. Muted отключает все уведомления проекта.
+type ProjectNotificationSettings struct {
+	Muted  bool               `json:"muted"`
+	Events NotificationMatrix `json:"events,omitempty"`
+}
+
+// NotificationSettings - настройки уведомлений пользователя.
+// В Events и проектных Events хранятся только заданные пользователем значения, остальное берется из DefaultNotificationMatrix.
+type NotificationSettings struct {
+	Events   NotificationMatrix                        `json:"events,omitempty"`
+	Projects map[uuid.UUID]ProjectNotificationSettings `json:"projects,omitempty"`
+	Digest   string                                    `json:"digest,omitempty"`
+
+	// QuietHours - в тихие часы несрочные email и sms откладываются.
+	QuietHours *QuietHours `json:"quiet_hours,omitempty"`
+}
+
+// DigestPeriod - периодичность email-дайджеста, по умолчанию выключен: пользователь включает его сам.
+func (s NotificationSettings) DigestPeriod() string {
+	if s.Digest == "" {
+		return DigestOff
+	}
+
+	return s.Digest
+}
+
+func ValidateDigestPeriod(period string) error {
+	if !lo.Contains(DigestPeriods, period) {
+		return fmt.Errorf("%w: %s", ErrDigestPeriod, period)
+	}
+
+	return nil
+}
+
+// Allowed - нужно ли уведомлять о событии в проекте по каналу.
+func (s NotificationSettings) Allowed(projectUUID uuid.UUID, event, channel string) bool {
+	if project, ok := s.Projects[projectUUID]; ok {
+		if project.Muted {
+			return false
+		}
+
+		if enabled, found := project.Events.get(event, channel); found {
+			return enabled
+		}
+	}
+
+	if enabled, found := s.Events.get(event, channel); found {
+		return enabled
+	}
+
+	enabled, _ := DefaultNotificationMatrix().get(event, channel)
+
+	return enabled
+}
+
+// AllowedAny - хотя бы одно из событий разрешено по каналу.
+func (s NotificationSettings) AllowedAny(projectUUID uuid.UUID, events []string, channel string) bool {
+	return lo.SomeBy(events, func(event string) bool {
+		return s.Allowed(projectUUID, event, channel)
+	})
+}
+
+// Effective - полная матрица с учетом значений по умолчанию.
+func (s NotificationSettings) Effective() NotificationMatrix {
+	m := DefaultNotificationMatrix()
+
+	for event, channels := range s.Events {
+		if _, ok := m[event]; !ok {
+			continue
+		}
+
+		for channel, enabled := range channels {
+			m[event][channel] = enabled
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNotificationSettingsAllowed(t *testing.T) {
+	project := uuid.New()
+	muted := uuid.New()
+
+	settings := NotificationSettings{
+		Events: NotificationMatrix{
+			NotifyComment: {ChannelApp: false},
+			NotifyStatus:  {ChannelEmail: true},
+		},
+		Projects: map[uuid.UUID]ProjectNotificationSettings{
+			project: {Events: NotificationMatrix{NotifyComment: {ChannelApp: true}}},
+			muted:   {Muted: true},
+		},
+	}
+
+	tests := []struct {
+		project uuid.UUID
+		event   string
+		channel string
+		want    bool
+	}{
+		{project: uuid.New(), event: NotifyComment, channel: ChannelApp, want: false},
+		{project: project, event: NotifyComment, channel: ChannelApp, want: true},
+		{project: uuid.New(), event: NotifyStatus, channel: ChannelEmail, want: true},
+		{project: uuid.New(), event: NotifyMentioned, channel: ChannelEmail, want: true},
+		{project: uuid.New(), event: NotifyFile, channel: ChannelEmail, want: false},
+		{project: uuid.New(), event: NotifyAssigned, channel: ChannelSMS, want: false},
+		{project: muted, event: NotifyMentioned, channel: ChannelApp, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := settings.Allowed(tt.project, tt.event, tt.channel); got != tt.want {
+			t.Errorf("Allowed(%s, %s) = %v, want %v", tt.event, tt.channel, got, tt.want)
+		}
+	}
+
+	if got := settings.Effective()[NotifyComment][ChannelApp]; got {
+		t.Errorf("Effective() comment app = %v, want false", got)
+	}
+}
+
+func TestNotificationMatrixValidate(t *testing.T) {
+	tests := []struct {
+		m    NotificationMatrix
+		want error
+	}{
+		{m: NotificationMatrix{NotifyFile: {ChannelWebhook: true}}, want: nil},
+		{m: NotificationMatrix{"like": {ChannelApp: true}}, want: ErrNotifyEvent},
+		{m: NotificationMatrix{NotifyFile: {"push": true}}, want: ErrNotifyChannel},
+	}
+
+	for _, tt := range tests {
+		if err := tt.m.Validate(); !errors.Is(err, tt.want) {
+			t.Errorf("Validate(%v) = %v, want %v", tt.m, err, tt.want)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -47,6 +49,9 @@ type ProjectOptions struct {
 	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
 	StatusEnable              *bool   `json:"status_enable,omitempty"`
 	Color                     *string `json:"color,omitempty"`
+
+	// CommentEditMinutes - сколько минут после публикации можно редактировать комментарий (0 - без ограничений).
+	CommentEditMinutes *int `json:"comment_edit_minutes,omitempty"`
 }
 
 type ProjectParams struct {
@@ -125,21 +130,25 @@ func (p *Project) ChangeDescription(description string) error {
 type FieldDataType int
 
 const (
-	Integer   FieldDataType = 0
-	Float     FieldDataType = 1
-	String    FieldDataType = 2
-	Text      FieldDataType = 3
-	Bool      FieldDataType = 4
-	Switch    FieldDataType = 5
-	Array     FieldDataType = 6
-	Data      FieldDataType = 7
-	DataArray FieldDataType = 8
-	Phone     FieldDataType = 9
-	Link      FieldDataType = 10
-	Email     FieldDataType = 11
-	Time      FieldDataType = 12
-	DateTime  FieldDataType = 13
-	People    FieldDataType = 14
+	Integer     FieldDataType = 0
+	Float       FieldDataType = 1
+	String      FieldDataType = 2
+	Text        FieldDataType = 3
+	Bool        FieldDataType = 4
+	Switch      FieldDataType = 5
+	Array       FieldDataType = 6
+	Data        FieldDataType = 7
+	DataArray   FieldDataType = 8
+	Phone       FieldDataType = 9
+	Link        FieldDataType = 10
+	Email       FieldDataType = 11
+	Time        FieldDataType = 12
+	DateTime    FieldDataType = 13
+	People      FieldDataType = 14
+	Select      FieldDataType = 15
+	MultiSelect FieldDataType = 16
+	MoneyType   FieldDataType = 17
+	Relation    FieldDataType = 18
 )
 
 type ProjectCatalogType string
@@ -168,7 +177,7 @@ type CompanyField struct {
 	Name               string        `validate:"lte=30,gte=1" ru:"название"`
 	Description        string        `validate:"lte=5000" ru:"описание"`
 	Icon               string        `validate:"lte=50" ru:"иконка"`
-	DataType           FieldDataType `validate:"lte=10,gte=0" ru:"тип данных"`
+	DataType           FieldDataType `validate:"lte=18,gte=0" ru:"тип данных"`
 	CompanyUUID        uuid.UUID     `validate:"uuid" ru:"компания uuid"`
 	RequiredOnStatuses []int         `validate:"lte=50" ru:"необходимо на статусе"`
 	Style              string        `validate:"lte=20" ru:"стиль"`
@@ -178,6 +187,9 @@ type CompanyField struct {
 	DeletedAt          *time.Time
 	Meta               datatypes.JSON
 	ProjectUUID        []uuid.UUID `validate:"uuid" ru:"проект uuid"`
+	Options            CompanyFieldOptions
+	Rules              *FieldRules
+	Relation           *FieldRelation
 
 	TasksTotal        int
 	TasksFilled       int
@@ -227,11 +239,115 @@ func (pf *CompanyField) FieldTypeDesc() string {
 		return "datetime"
 	case People:
 		return "people"
+	case Select:
+		return "select"
+	case MultiSelect:
+		return "multi_select"
+	case MoneyType:
+		return "money"
+	case Relation:
+		return "relation"
 	}
 
 	return "unknown"
 }
 
+func (pf *CompanyField) HasOptions() bool {
+	return pf.DataType == Select || pf.DataType == MultiSelect
+}
+
+// CompanyFieldOption - вариант значения для полей select / multi_select.
+// В задачах хранится UUID варианта, поэтому переименование не ломает значения.
+type CompanyFieldOption struct {
+	UUID  uuid.UUID `json:"uuid"`
+	Label string    `json:"label" validate:"lte=100,gte=1" ru:"название"`
+	Color string    `json:"color" validate:"omitempty,lte=20" ru:"цвет"`
+	Order int       `json:"order"`
+}
+
+type CompanyFieldOptions []CompanyFieldOption
+
+func (j *CompanyFieldOptions) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := CompanyFieldOptions{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j CompanyFieldOptions) Value() (driver.Value, error) {
+	if j == nil {
+		return json.Marshal(CompanyFieldOptions{})
+	}
+
+	return json.Marshal(j)
+}
+
+func (j CompanyFieldOptions) Find(uid string) (CompanyFieldOption, bool) {
+	for _, o := range j {
+		if o.UUID.String() == uid {
+			return o, true
+		}
+	}
+
+	return CompanyFieldOption{}, false
+}
+
+// Merge - применяет новый список вариантов к существующему.
+// Варианты с известным UUID сохраняют его (меняется только название/цвет/порядок),
+// новым вариантам назначается UUID. Дубли названий (без учета регистра) запрещены.
+func (j CompanyFieldOptions) Merge(incoming CompanyFieldOptions) (CompanyFieldOptions, error) {
+	result := CompanyFieldOptions{}
+	labels := map[string]bool{}
+
+	for i, o := range incoming {
+		o.Label = strings.TrimSpace(o.Label)
+		if o.Label == "" || len(o.Label) > 100 {
+			return j, errors.New("название варианта от 1 до 100 символов")
+		}
+
+		key := strings.ToLower(o.Label)
+		if labels[key] {
+			return j, fmt.Errorf("вариант %s уже существует", o.Label)
+		}
+		labels[key] = true
+
+		if o.UUID == uuid.Nil {
+			o.UUID = uuid.New()
+		} else if _, ok := j.Find(o.UUID.String()); !ok {
+			return j, fmt.Errorf("вариант %s не найден", o.UUID)
+		}
+
+		if o.Order == 0 {
+			o.Order = i + 1
+		}
+
+		result = append(result, o)
+	}
+
+	sort.SliceStable(result, func(a, b int) bool {
+		return result[a].Order < result[b].Order
+	})
+
+	return result, nil
+}
+
+// Removed - UUID вариантов, которых нет в новом списке.
+func (j CompanyFieldOptions) Removed(next CompanyFieldOptions) []string {
+	removed := []string{}
+	for _, o := range j {
+		if _, ok := next.Find(o.UUID.String()); !ok {
+			removed = append(removed, o.UUID.String())
+		}
+	}
+
+	return removed
+}
+
 type ProjectUser struct {
 	UUID           uuid.UUID `validate:"uuid"`
 	User           User
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCompanyFieldOptionsMerge(t *testing.T) {
+	moscow := CompanyFieldOption{UUID: uuid.New(), Label: "Moscow", Order: 1}
+	existing := CompanyFieldOptions{moscow}
+
+	// rename keeps uuid
+	merged, err := existing.Merge(CompanyFieldOptions{
+		{UUID: moscow.UUID, Label: "Москва", Order: 2},
+		{Label: "Казань", Order: 1},
+	})
+	if err != nil {
+		t.Fatalf("Error on Merge: %v", err)
+	}
+
+	if len(merged) != 2 {
+		t.Fatalf("Merge() got %d options, want 2", len(merged))
+	}
+
+	if merged[0].Label != "Казань" || merged[0].UUID == uuid.Nil {
+		t.Errorf("Merge() new option = %+v, want Казань with uuid", merged[0])
+	}
+
+	if o, ok := merged.Find(moscow.UUID.String()); !ok || o.Label != "Москва" {
+		t.Errorf("Merge() renamed option = %+v, want Москва", o)
+	}
+
+	tests := []struct {
+		name     string
+		incoming CompanyFieldOptions
+	}{
+		{
+			name:     "Duplicate label",
+			incoming: CompanyFieldOptions{{Label: "moscow"}, {Label: "MOSCOW"}},
+		},
+		{
+			name:     "Empty label",
+			incoming: CompanyFieldOptions{{Label: " "}},
+		},
+		{
+			name:     "Unknown uuid",
+			incoming: CompanyFieldOptions{{UUID: uuid.New(), Label: "Omsk"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := existing.Merge(tt.incoming); err == nil {
+				t.Errorf("Merge() expected error")
+			}
+		})
+	}
+}
+
+func TestCompanyFieldOptionsRemoved(t *testing.T) {
+	moscow := CompanyFieldOption{UUID: uuid.New(), Label: "Moscow"}
+	kazan := CompanyFieldOption{UUID: uuid.New(), Label: "Kazan"}
+	existing := CompanyFieldOptions{moscow, kazan}
+
+	tests := []struct {
+		name string
+		next CompanyFieldOptions
+		want []string
+	}{
+		{name: "Nothing removed", next: CompanyFieldOptions{kazan, moscow, {UUID: uuid.New(), Label: "Omsk"}}, want: []string{}},
+		{name: "One removed", next: CompanyFieldOptions{kazan}, want: []string{moscow.UUID.String()}},
+		{name: "All removed", next: CompanyFieldOptions{}, want: []string{moscow.UUID.String(), kazan.UUID.String()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := existing.Removed(tt.next)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Removed() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Removed() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
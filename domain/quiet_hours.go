@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const clockLayout = "15:04"
+
+var (
+	ErrClock    = errors.New("время должно быть в формате ЧЧ:ММ")
+	ErrWeekday  = errors.New("день недели должен быть от 0 (воскресенье) до 6")
+	ErrTimezone = errors.New("неизвестный часовой пояс")
+)
+
+// QuietHours - тихие часы пользователя в его часовом поясе. From может быть больше To, тогда интервал переходит через полночь.
+type QuietHours struct {
+	Enabled bool   `json:"enabled"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+func (q QuietHours) Validate() error {
+	if !q.Enabled {
+		return nil
+	}
+
+	return validateClocks(q.From, q.To)
+}
+
+// Quiet - попадает ли время (уже в часовом поясе пользователя) в тихие часы.
+func (q QuietHours) Quiet(t time.Time) bool {
+	if !q.Enabled {
+		return false
+	}
+
+	return inClockRange(t, q.From, q.To)
+}
+
+// WorkingHours - рабочее время компании в ее часовом поясе Timezone. Days - дни недели (0 - воскресенье), пусто - все дни.
+// Пустые From и To - весь день. UrgentPriority - задачи с приоритетом выше доставляются вне рабочего времени, 0 - без порога.
+// MentionsUrgent - упоминания доставляются без учета тихих и рабочих часов.
+type WorkingHours struct {
+	Enabled        bool   `json:"enabled"`
+	From           string `json:"from"`
+	To             string `json:"to"`
+	Days           []int  `json:"days"`
+	UrgentPriority int    `json:"urgent_priority"`
+	MentionsUrgent bool   `json:"mentions_urgent"`
+	Timezone       string `json:"timezone"`
+}
+
+func (w WorkingHours) Validate() error {
+	for _, d := range w.Days {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("%w: %d", ErrWeekday, d)
+		}
+	}
+
+	if w.Timezone != "" {
+		if _, err := time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("%w: %s", ErrTimezone, w.Timezone)
+		}
+	}
+
+	if !w.Enabled || (w.From == "" && w.To == "") {
+		return nil
+	}
+
+	return validateClocks(w.From, w.To)
+}
+
+// Open - рабочее ли время (время уже в часовом поясе компании).
+func (w WorkingHours) Open(t time.Time) bool {
+	if !w.Enabled {
+		return true
+	}
+
+	if len(w.Days) > 0 && !lo.Contains(w.Days, int(t.Weekday())) {
+		return false
+	}
+
+	if w.From == "" && w.To == "" {
+		return true
+	}
+
+	return inClockRange(t, w.From, w.To)
+}
+
+// Location - часовой пояс компании, иначе fallback, иначе UTC.
+func (w WorkingHours) Location(fallback string) *time.Location {
+	return loadLocation(w.Timezone, fallback)
+}
+
+// DeliveryUrgent - срочные уведомления (задачи с высоким приоритетом и, если включено в компании, упоминания)
+// доставляются без учета тихих и рабочих часов.
+func DeliveryUrgent(events []string, priority int, w WorkingHours) bool {
+	if w.MentionsUrgent && lo.Contains(events, NotifyMentioned) {
+		return true
+	}
+
+	return w.UrgentPriority > 0 && priority > w.UrgentPriority
+}
+
+// NextDelivery - ближайшее время не раньше now вне тихих часов пользователя (в его часовом поясе loc)
+// и в рабочее время компании (в ее часовом поясе companyLoc). Если окно не открывается в течение недели, доставка не откладывается.
+func NextDelivery(now time.Time, loc *time.Location, quiet QuietHours, companyLoc *time.Location, working WorkingHours) time.Time {
+	open := func(t time.Time) bool {
+		return !quiet.Quiet(t.In(loc)) && working.Open(t.In(companyLoc))
+	}
+
+	if open(now) {
+		return now
+	}
+
+	// окно может открыться только на границе: в начале дня, в конце тихих часов или в начале рабочего дня
+	type boundary struct {
+		loc   *time.Location
+		clock string
+	}
+
+	boundaries := []boundary{{loc, "00:00"}, {companyLoc, "00:00"}}
+	if quiet.Enabled {
+		boundaries = append(boundaries, boundary{loc, quiet.To})
+	}
+
+	if working.Enabled && working.From != "" {
+		boundaries = append(boundaries, boundary{companyLoc, working.From})
+	}
+
+	var candidates []time.Time
+
+	for _, b := range boundaries {
+		c, err := time.Parse(clockLayout, b.clock)
+		if err != nil {
+			continue
+		}
+
+		local := now.In(b.loc)
+		for day := 0; day <= 7; day++ {
+			t := time.Date(local.Year(), local.Month(), local.Day()+day, c.Hour(), c.Minute(), 0, 0, b.loc)
+			if t.After(now) {
+				candidates = append(candidates, t)
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	for _, t := range candidates {
+		if open(t) {
+			return t
+		}
+	}
+
+	return now
+}
+
+// Location - часовой пояс пользователя, иначе fallback, иначе UTC.
+func (p ProfilePreferences) Location(fallback string) *time.Location {
+	return loadLocation(lo.FromPtr(p.Timezone), fallback)
+}
+
+func loadLocation(timezones ...string) *time.Location {
+	for _, tz := range timezones {
+		if tz == "" {
+			continue
+		}
+
+		if loc, err := time.LoadLocation(tz); err == nil {
+			return loc
+		}
+	}
+
+	return time.UTC
+}
+
+func validateClocks(clocks ...string) error {
+	for _, clock := range clocks {
+		if _, err := time.Parse(clockLayout, clock); err != nil {
+			return fmt.Errorf("%w: %s", ErrClock, clock)
+		}
+	}
+
+	return nil
+}
+
+// inClockRange - t в интервале [from, to), интервал может переходить через полночь. Пустой интервал (from == to) не содержит ничего.
+func inClockRange(t time.Time, from, to string) bool {
+	f, err := time.Parse(clockLayout, from)
+	if err != nil {
+		return false
+	}
+
+	e, err := time.Parse(clockLayout, to)
+	if err != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	start := f.Hour()*60 + f.Minute()
+	end := e.Hour()*60 + e.Minute()
+
+	switch {
+	case start == end:
+		return false
+	case start < end:
+		return minute >= start && minute < end
+	default:
+		return minute >= start || minute < end
+	}
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextDelivery(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, msk)
+	}
+
+	vladivostok, err := time.LoadLocation("Asia/Vladivostok")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	night := QuietHours{Enabled: true, From: "22:00", To: "08:00"}
+	office := WorkingHours{Enabled: true, From: "10:00", To: "19:00", Days: []int{1, 2, 3, 4, 5}}
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		quiet      QuietHours
+		working    WorkingHours
+		companyLoc *time.Location
+		want       time.Time
+	}{
+		{name: "no limits", now: at(19, 23, 0), want: at(19, 23, 0)},
+		{name: "daytime", now: at(19, 12, 0), quiet: night, want: at(19, 12, 0)},
+		{name: "before midnight", now: at(19, 23, 0), quiet: night, want: at(20, 8, 0)},
+		{name: "after midnight", now: at(20, 3, 0), quiet: night, want: at(20, 8, 0)},
+		{name: "disabled quiet", now: at(20, 3, 0), quiet: QuietHours{From: "22:00", To: "08:00"}, want: at(20, 3, 0)},
+		{name: "before office", now: at(20, 8, 30), quiet: night, working: office, want: at(20, 10, 0)},
+		{name: "friday evening", now: at(23, 20, 0), quiet: night, working: office, want: at(26, 10, 0)},
+		{name: "weekend", now: at(25, 12, 0), working: office, want: at(26, 10, 0)},
+		{name: "company office open", now: at(20, 3, 0), working: office, companyLoc: vladivostok, want: at(20, 3, 0)},
+		{name: "company office closed", now: at(20, 13, 0), working: office, companyLoc: vladivostok, want: at(21, 3, 0)},
+		{name: "company office and quiet", now: at(20, 13, 0), quiet: night, working: office, companyLoc: vladivostok, want: at(21, 8, 0)},
+		{name: "never opens", now: at(19, 12, 0), working: WorkingHours{Enabled: true, Days: []int{}, From: "10:00", To: "10:00"}, want: at(19, 12, 0)},
+	}
+
+	for _, tt := range tests {
+		companyLoc := tt.companyLoc
+		if companyLoc == nil {
+			companyLoc = msk
+		}
+
+		got := NextDelivery(tt.now.UTC(), msk, tt.quiet, companyLoc, tt.working)
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: NextDelivery(%v) = %v, want %v", tt.name, tt.now, got.In(msk), tt.want)
+		}
+	}
+}
+
+func TestDeliveryUrgent(t *testing.T) {
+	w := WorkingHours{UrgentPriority: 20}
+
+	tests := []struct {
+		events   []string
+		priority int
+		working  WorkingHours
+		want     bool
+	}{
+		{events: []string{NotifyMentioned}, priority: 0, working: w, want: false},
+		{events: []string{NotifyMentioned}, priority: 0, working: WorkingHours{MentionsUrgent: true}, want: true},
+		{events: []string{NotifyComment}, priority: 30, working: w, want: true},
+		{events: []string{NotifyComment}, priority: 20, working: w, want: false},
+		{events: []string{NotifyAssigned}, priority: 100, working: WorkingHours{}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := DeliveryUrgent(tt.events, tt.priority, tt.working); got != tt.want {
+			t.Errorf("DeliveryUrgent(%v, %d) = %v, want %v", tt.events, tt.priority, got, tt.want)
+		}
+	}
+}
+
+func TestQuietHoursValidate(t *testing.T) {
+	tests := []struct {
+		quiet   QuietHours
+		wantErr bool
+	}{
+		{quiet: QuietHours{}, wantErr: false},
+		{quiet: QuietHours{Enabled: true, From: "22:00", To: "07:30"}, wantErr: false},
+		{quiet: QuietHours{Enabled: true, From: "25:00", To: "07:30"}, wantErr: true},
+		{quiet: QuietHours{Enabled: true, From: "22:00"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.quiet.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%+v) error = %v, wantErr %v", tt.quiet, err, tt.wantErr)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,208 @@ type Reminder struct {
 	UserUUID      *uuid.UUID `validate:"uuid"  ru:"пользователь (uuid)"`
 	Status        int        `validate:"gte=0,lte=10"  ru:"статус"`
 
+	Recurrence     *ReminderRecurrence
+	SnoozedUntil   *time.Time
+	DeadlineOffset *int // минуты относительно срока задачи, отрицательные - до срока
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// Статусы дела от 0 до ReminderStatusMax задаются клиентом. ReminderStatusSent - напоминание отправлено диспетчером,
+// вне клиентского диапазона, чтобы клиентский статус не останавливал отправку.
+const (
+	ReminderStatusMax  = 10
+	ReminderStatusSent = 100
+)
+
+var ErrReminderStatus = errors.New("статус дела должен быть от 0 до 10")
+
+// Типы дел, для которых напоминание дополнительно отправляется на почту или по sms.
+const (
+	ReminderTypeEmail = "email"
+	ReminderTypeSMS   = "sms"
+)
+
+// Статусы отправки напоминаний.
+const (
+	DispatchPending = "pending"
+	DispatchSending = "sending"
+	DispatchSent    = "sent"
+	DispatchDead    = "dead" // исчерпаны попытки, ждет ручного повтора
+
+	DispatchCancelled = "cancelled" // дело удалено или изменено до отправки
+)
+
+const DispatchMaxAttempts = 5
+
+// FireAt - когда напоминание должно сработать: отложенное время, начало дела, иначе срок.
+func (r Reminder) FireAt() *time.Time {
+	if r.SnoozedUntil != nil {
+		return r.SnoozedUntil
+	}
+
+	if r.DateFrom != nil {
+		return r.DateFrom
+	}
+
+	return r.DateTo
+}
+
+// Channels - каналы доставки напоминания по типу дела, в приложении всегда.
+func (r Reminder) Channels() []string {
+	switch r.Type {
+	case ReminderTypeEmail:
+		return []string{ChannelApp, ChannelEmail}
+	case ReminderTypeSMS:
+		return []string{ChannelApp, ChannelSMS}
+	}
+
+	return []string{ChannelApp}
+}
+
+// ReminderDispatch - срабатывание напоминания. Одно на напоминание и время срабатывания.
+type ReminderDispatch struct {
+	UUID         uuid.UUID
+	ReminderUUID uuid.UUID
+	FireAt       time.Time
+
+	Status        string
+	Attempts      int
+	Error         string
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RetryAt - следующая попытка с линейно растущей паузой.
+func (d ReminderDispatch) RetryAt(now time.Time) time.Time {
+	return now.Add(time.Duration(d.Attempts) * time.Minute)
+}
+
+func (r Reminder) Validate() error {
+	if r.Recurrence == nil {
+		return nil
+	}
+
+	if r.DeadlineOffset != nil {
+		return ErrRecurrenceDeadline
+	}
+
+	return r.Recurrence.Validate()
+}
+
+// AnchorMonthDay - запоминает день месяца первой даты ежемесячного повторения в часовом поясе loc,
+// пока дата дела не перенесена срабатыванием.
+func (r *Reminder) AnchorMonthDay(loc *time.Location) {
+	start := r.DateFrom
+	if start == nil {
+		start = r.DateTo
+	}
+
+	if r.Recurrence == nil || r.Recurrence.Freq != RecurrenceMonthly || r.Recurrence.MonthDay != 0 || start == nil {
+		return
+	}
+
+	rr := *r.Recurrence
+	rr.MonthDay = start.In(loc).Day()
+	r.Recurrence = &rr
+}
+
+// KeepMonthDay - день месяца повторения сохраняется из prev, пока пользователь не изменил дату дела, иначе считается заново.
+func (r *Reminder) KeepMonthDay(prev Reminder) {
+	if r.Recurrence == nil {
+		return
+	}
+
+	rr := *r.Recurrence
+	rr.MonthDay = 0
+
+	if prev.Recurrence != nil && sameTime(r.DateFrom, prev.DateFrom) && sameTime(r.DateTo, prev.DateTo) {
+		rr.MonthDay = prev.Recurrence.MonthDay
+	}
+
+	r.Recurrence = &rr
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// NextOccurrence - следующее повторение после after: новые начало и срок дела. false - дело не повторяется или повторения закончились.
+func (r Reminder) NextOccurrence(after time.Time, loc *time.Location) (from, to *time.Time, ok bool) {
+	start := r.DateFrom
+	if start == nil {
+		start = r.DateTo
+	}
+
+	if r.Recurrence == nil || start == nil {
+		return nil, nil, false
+	}
+
+	// сработало отложенное напоминание, а следующее повторение еще не наступило
+	if start.After(after) {
+		return r.DateFrom, r.DateTo, true
+	}
+
+	next, ok := r.Recurrence.Next(*start, after, loc)
+	if !ok {
+		return nil, nil, false
+	}
+
+	shift := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+
+		moved := t.Add(next.Sub(*start))
+		return &moved
+	}
+
+	return shift(r.DateFrom), shift(r.DateTo), true
+}
+
+// Occurrences - срабатывания дела в интервале [from, to), для повторяющихся - все повторения, но не больше limit.
+func (r Reminder) Occurrences(from, to time.Time, loc *time.Location, limit int) []time.Time {
+	fire := r.FireAt()
+	if fire == nil {
+		return nil
+	}
+
+	res := []time.Time{}
+	if !fire.Before(from) && fire.Before(to) {
+		res = append(res, *fire)
+	}
+
+	start := r.DateFrom
+	if start == nil {
+		start = r.DateTo
+	}
+
+	if r.Recurrence == nil || start == nil {
+		return res
+	}
+
+	after := *start
+	if from.After(after) {
+		after = from.Add(-time.Nanosecond)
+	}
+
+	for len(res) < limit {
+		next, ok := r.Recurrence.Next(*start, after, loc)
+		if !ok || !next.Before(to) {
+			break
+		}
+
+		res = append(res, next)
+		after = next
+	}
+
+	return res
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// Периодичность повторяющихся напоминаний.
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// Варианты отложить напоминание.
+const (
+	Snooze10Minutes = "10m"
+	SnoozeHour      = "1h"
+	SnoozeTomorrow  = "tomorrow" // завтра в ReminderMorningHour по часовому поясу пользователя
+)
+
+const ReminderMorningHour = 9
+
+var (
+	RecurrenceFreqs = []string{RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly}
+	SnoozeOptions   = []string{Snooze10Minutes, SnoozeHour, SnoozeTomorrow}
+
+	ErrRecurrenceFreq     = errors.New("неизвестная периодичность напоминания")
+	ErrRecurrenceInterval = errors.New("интервал повторения должен быть от 1 до 365")
+	ErrRecurrenceDeadline = errors.New("напоминание относительно срока задачи не может повторяться")
+	ErrRecurrenceMonthDay = errors.New("день месяца повторения должен быть от 1 до 31")
+	ErrSnoozeOption       = errors.New("неизвестный вариант отложить напоминание")
+)
+
+// ReminderRecurrence - правило повторения. Каждые Interval дней, недель или месяцев от первой даты,
+// для недель - в дни Weekdays (0 - воскресенье), если заданы. Время суток сохраняется в часовом поясе пользователя.
+// MonthDay - день месяца первой даты для ежемесячного повторения: дата дела переносится при каждом срабатывании
+// и в коротком месяце сдвигается на последний день, а следующие повторения возвращаются к MonthDay.
+type ReminderRecurrence struct {
+	Freq     string     `json:"freq"`
+	Interval int        `json:"interval,omitempty"`
+	Weekdays []int      `json:"weekdays,omitempty"`
+	Until    *time.Time `json:"until,omitempty"`
+	MonthDay int        `json:"month_day,omitempty"`
+}
+
+func (rr ReminderRecurrence) Validate() error {
+	if !lo.Contains(RecurrenceFreqs, rr.Freq) {
+		return fmt.Errorf("%w: %s", ErrRecurrenceFreq, rr.Freq)
+	}
+
+	if rr.Interval < 0 || rr.Interval > 365 {
+		return ErrRecurrenceInterval
+	}
+
+	for _, d := range rr.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("%w: %d", ErrWeekday, d)
+		}
+	}
+
+	if rr.MonthDay < 0 || rr.MonthDay > 31 {
+		return ErrRecurrenceMonthDay
+	}
+
+	return nil
+}
+
+func (rr ReminderRecurrence) interval() int {
+	return lo.Max([]int{rr.Interval, 1})
+}
+
+// Next - первое повторение после after, начиная от первой даты start. false - повторения закончились.
+func (rr ReminderRecurrence) Next(start, after time.Time, loc *time.Location) (time.Time, bool) {
+	local := start.In(loc)
+	at := func(days, months int) time.Time {
+		return time.Date(local.Year(), local.Month()+time.Month(months), local.Day()+days, local.Hour(), local.Minute(), local.Second(), 0, loc)
+	}
+
+	// через months месяцев в день MonthDay, в коротком месяце - в последний день
+	monthAt := func(months int) time.Time {
+		day := rr.MonthDay
+		if day == 0 {
+			day = local.Day()
+		}
+
+		last := time.Date(local.Year(), local.Month()+time.Month(months)+1, 0, 0, 0, 0, 0, loc).Day()
+
+		return time.Date(local.Year(), local.Month()+time.Month(months), lo.Min([]int{day, last}), local.Hour(), local.Minute(), local.Second(), 0, loc)
+	}
+
+	var next time.Time
+
+	switch rr.Freq {
+	case RecurrenceMonthly:
+		k := rr.interval()
+		if after.After(start) {
+			months := (after.Year()-local.Year())*12 + int(after.Month()-local.Month())
+			k = lo.Max([]int{k, (months/k - 1) * k})
+		}
+
+		for next = monthAt(k); !next.After(after); next = monthAt(k) {
+			k += rr.interval()
+		}
+	case RecurrenceDaily, RecurrenceWeekly:
+		day := 1
+		if after.After(start) {
+			day = lo.Max([]int{1, int(after.Sub(start).Hours()/24) - 1})
+		}
+
+		// длинные периоды ищутся прыжком, дальше перебор не больше двух периодов
+		for limit := day + 14*rr.interval() + 2; day <= limit; day++ {
+			t := at(day, 0)
+			if t.After(after) && rr.matches(day, t, local) {
+				next = t
+				break
+			}
+		}
+	}
+
+	if next.IsZero() || (rr.Until != nil && next.After(*rr.Until)) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// RRule - правило повторения в формате iCalendar (RFC 5545).
+func (rr ReminderRecurrence) RRule() string {
+	days := []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+	parts := []string{
+		"FREQ=" + strings.ToUpper(rr.Freq),
+		fmt.Sprintf("INTERVAL=%d", rr.interval()),
+	}
+
+	if rr.Freq == RecurrenceWeekly && len(rr.Weekdays) > 0 {
+		parts = append(parts, "BYDAY="+strings.Join(lo.Map(rr.Weekdays, func(d int, _ int) string {
+			return days[d]
+		}), ","))
+	}
+
+	if rr.Until != nil {
+		parts = append(parts, "UNTIL="+rr.Until.UTC().Format(icsTimeLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (rr ReminderRecurrence) matches(day int, t, start time.Time) bool {
+	if rr.Freq == RecurrenceDaily {
+		return day%rr.interval() == 0
+	}
+
+	weekdays := rr.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []int{int(start.Weekday())}
+	}
+
+	if !lo.Contains(weekdays, int(t.Weekday())) {
+		return false
+	}
+
+	// номер недели от недели первой даты, неделя начинается с понедельника
+	fromMonday := int(start.Weekday()+6) % 7
+	week := (day + fromMonday) / 7
+
+	return week%rr.interval() == 0
+}
+
+// SnoozeUntil - до какого времени отложить напоминание.
+func SnoozeUntil(option string, now time.Time, loc *time.Location) (time.Time, error) {
+	switch option {
+	case Snooze10Minutes:
+		return now.Add(10 * time.Minute), nil
+	case SnoozeHour:
+		return now.Add(time.Hour), nil
+	case SnoozeTomorrow:
+		local := now.In(loc)
+		return time.Date(local.Year(), local.Month(), local.Day()+1, ReminderMorningHour, 0, 0, 0, loc), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s", ErrSnoozeOption, option)
+}
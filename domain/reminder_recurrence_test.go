@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminderRecurrenceNext(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, msk)
+	}
+
+	// понедельник 2026-10-19 10:00
+	start := at(10, 19, 10)
+	until := at(11, 1, 0)
+
+	tests := []struct {
+		name   string
+		rule   ReminderRecurrence
+		after  time.Time
+		want   time.Time
+		wantOk bool
+	}{
+		{name: "daily", rule: ReminderRecurrence{Freq: RecurrenceDaily}, after: start, want: at(10, 20, 10), wantOk: true},
+		{name: "every 3 days", rule: ReminderRecurrence{Freq: RecurrenceDaily, Interval: 3}, after: at(10, 23, 0), want: at(10, 25, 10), wantOk: true},
+		{name: "every monday", rule: ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1}}, after: start, want: at(10, 26, 10), wantOk: true},
+		{name: "monday and thursday", rule: ReminderRecurrence{Freq: RecurrenceWeekly, Weekdays: []int{1, 4}}, after: start, want: at(10, 22, 10), wantOk: true},
+		{name: "every other week", rule: ReminderRecurrence{Freq: RecurrenceWeekly, Interval: 2}, after: start, want: at(11, 2, 10), wantOk: true},
+		{name: "missed weeks", rule: ReminderRecurrence{Freq: RecurrenceWeekly}, after: at(12, 1, 0), want: at(12, 7, 10), wantOk: true},
+		{name: "monthly", rule: ReminderRecurrence{Freq: RecurrenceMonthly}, after: start, want: at(11, 19, 10), wantOk: true},
+		{name: "quarterly missed", rule: ReminderRecurrence{Freq: RecurrenceMonthly, Interval: 3}, after: at(12, 25, 0), want: time.Date(2027, 1, 19, 10, 0, 0, 0, msk), wantOk: true},
+		{name: "until", rule: ReminderRecurrence{Freq: RecurrenceWeekly, Until: &until}, after: at(10, 26, 10), wantOk: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.rule.Next(start, tt.after, msk)
+		if ok != tt.wantOk || (ok && !got.Equal(tt.want)) {
+			t.Errorf("%s: Next() = %v, %v, want %v, %v", tt.name, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestReminderRecurrenceNextMonthEnd(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 10, 0, 0, 0, msk)
+	}
+
+	tests := []struct {
+		name  string
+		rule  ReminderRecurrence
+		start time.Time
+		want  time.Time
+	}{
+		{name: "jan 31", rule: ReminderRecurrence{Freq: RecurrenceMonthly}, start: at(2026, 1, 31), want: at(2026, 2, 28)},
+		{name: "after short month", rule: ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31}, start: at(2026, 2, 28), want: at(2026, 3, 31)},
+		{name: "to 30 days", rule: ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31}, start: at(2026, 3, 31), want: at(2026, 4, 30)},
+		{name: "feb 29 leap", rule: ReminderRecurrence{Freq: RecurrenceMonthly, Interval: 12}, start: at(2028, 2, 29), want: at(2029, 2, 28)},
+		{name: "feb 29 every 4 years", rule: ReminderRecurrence{Freq: RecurrenceMonthly, Interval: 12, MonthDay: 29}, start: at(2031, 2, 28), want: at(2032, 2, 29)},
+		{name: "jan 29 to feb", rule: ReminderRecurrence{Freq: RecurrenceMonthly}, start: at(2028, 1, 29), want: at(2028, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.rule.Next(tt.start, tt.start, msk)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: Next() = %v, %v, want %v", tt.name, got, ok, tt.want)
+		}
+	}
+}
+
+func TestSnoozeUntil(t *testing.T) {
+	msk, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// 2026-10-19 23:30 MSK
+	now := time.Date(2026, 10, 19, 20, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		option  string
+		want    time.Time
+		wantErr bool
+	}{
+		{option: Snooze10Minutes, want: now.Add(10 * time.Minute)},
+		{option: SnoozeHour, want: now.Add(time.Hour)},
+		{option: SnoozeTomorrow, want: time.Date(2026, 10, 20, 9, 0, 0, 0, msk)},
+		{option: "week", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := SnoozeUntil(tt.option, now, msk)
+		if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+			t.Errorf("SnoozeUntil(%s) = %v, %v, want %v", tt.option, got, err, tt.want)
+		}
+	}
+}
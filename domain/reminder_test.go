@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReminderFireAt(t *testing.T) {
+	from := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		reminder Reminder
+		want     *time.Time
+	}{
+		{reminder: Reminder{DateFrom: &from, DateTo: &to}, want: &from},
+		{reminder: Reminder{DateTo: &to}, want: &to},
+		{reminder: Reminder{}, want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reminder.FireAt(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FireAt() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestReminderChannels(t *testing.T) {
+	tests := []struct {
+		kind string
+		want []string
+	}{
+		{kind: ReminderTypeEmail, want: []string{ChannelApp, ChannelEmail}},
+		{kind: ReminderTypeSMS, want: []string{ChannelApp, ChannelSMS}},
+		{kind: "call", want: []string{ChannelApp}},
+	}
+
+	for _, tt := range tests {
+		if got := (Reminder{Type: tt.kind}).Channels(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Channels(%s) = %v, want %v", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestReminderOccurrences(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC)
+
+	at := func(day int) time.Time {
+		return time.Date(2026, 10, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		reminder Reminder
+		limit    int
+		want     []time.Time
+	}{
+		{name: "single outside", reminder: Reminder{DateFrom: &start}, limit: 10, want: []time.Time{}},
+		{name: "daily", reminder: Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily}}, limit: 10, want: []time.Time{at(25), at(26), at(27)}},
+		{name: "limit", reminder: Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceDaily}}, limit: 2, want: []time.Time{at(25), at(26)}},
+		{name: "weekly", reminder: Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceWeekly}}, limit: 10, want: []time.Time{at(26)}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.reminder.Occurrences(from, to, time.UTC, tt.limit); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Occurrences() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReminderNextOccurrenceMonthEnd(t *testing.T) {
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	start := at(1, 31)
+	r := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceMonthly}}
+
+	// каждое срабатывание переносит дату дела, как MarkSent
+	var got []time.Time
+	for i := 0; i < 3; i++ {
+		r.AnchorMonthDay(time.UTC)
+
+		from, _, ok := r.NextOccurrence(*r.DateFrom, time.UTC)
+		if !ok {
+			t.Fatalf("NextOccurrence() ok = false")
+		}
+
+		r.DateFrom = from
+		got = append(got, *from)
+	}
+
+	want := []time.Time{at(2, 28), at(3, 31), at(4, 30)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NextOccurrence() = %v, want %v", got, want)
+	}
+}
+
+func TestReminderKeepMonthDay(t *testing.T) {
+	start := time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC)
+	moved := start.Add(24 * time.Hour)
+	prev := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31}}
+
+	same := Reminder{DateFrom: &start, Recurrence: &ReminderRecurrence{Freq: RecurrenceMonthly}}
+	same.KeepMonthDay(prev)
+
+	if same.Recurrence.MonthDay != 31 {
+		t.Errorf("KeepMonthDay() same date = %d, want 31", same.Recurrence.MonthDay)
+	}
+
+	changed := Reminder{DateFrom: &moved, Recurrence: &ReminderRecurrence{Freq: RecurrenceMonthly, MonthDay: 31}}
+	changed.KeepMonthDay(prev)
+
+	if changed.Recurrence.MonthDay != 0 {
+		t.Errorf("KeepMonthDay() moved date = %d, want 0", changed.Recurrence.MonthDay)
+	}
+}
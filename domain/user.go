@@ -35,7 +35,9 @@ type User struct {
 }
 
 type ProfilePreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone      *string               `json:"timezone,omitempty"`
+	Language      *string               `json:"language,omitempty"`
+	Notifications *NotificationSettings `json:"notifications,omitempty"`
 }
 
 type ProfilePhotoDTO struct {
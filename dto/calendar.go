@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/helpers"
+)
+
+// CalendarSearchDTO - события календаря пользователя или, если указан ProjectUUID, проекта за интервал [From, To).
+type CalendarSearchDTO struct {
+	UserUUID  uuid.UUID
+	UserEmail string
+
+	ProjectUUID *uuid.UUID
+	From        time.Time
+	To          time.Time
+
+	Types  []string
+	People []string // email участников
+}
+
+type CalendarEventDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Type        string     `json:"type"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Start       time.Time  `json:"start"`
+	End         *time.Time `json:"end,omitempty"`
+	TaskUUID    *uuid.UUID `json:"task_uuid,omitempty"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+	People      []string   `json:"people"`
+}
+
+func NewCalendarEventDTO(e domain.CalendarEvent) CalendarEventDTO {
+	return CalendarEventDTO{
+		UUID:        e.UUID,
+		Type:        e.Type,
+		Title:       e.Title,
+		Description: e.Description,
+		URL:         e.URL,
+		Start:       e.Start,
+		End:         e.End,
+		TaskUUID:    helpers.If(e.TaskUUID != uuid.Nil, &e.TaskUUID, nil),
+		ProjectUUID: helpers.If(e.ProjectUUID != uuid.Nil, &e.ProjectUUID, nil),
+		People:      e.People,
+	}
+}
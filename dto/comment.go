@@ -12,16 +12,26 @@ type CommentDTO struct {
 	UUID uuid.UUID `json:"uuid"`
 
 	Comment      string     `json:"comment"`
+	CommentHTML  *string    `json:"comment_html,omitempty"`
 	ReplyUUID    *uuid.UUID `json:"reply_uuid,omitempty"`
 	ReplyComment *string    `json:"reply_comment,omitempty"`
 
+	ThreadUUID   *uuid.UUID `json:"thread_uuid,omitempty"`
+	Depth        int        `json:"depth"`
+	RepliesCount int        `json:"replies_count"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	Edited   bool       `json:"edited"`
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+
 	CreatedBy *UserDTO      `json:"created_by"`
 	People    []UserLikeDTO `json:"people,omitempty"`
 	Likes     []UserLikeDTO `json:"likes,omitempty"`
 
+	Reactions []CommentReactionDTO `json:"reactions,omitempty"`
+
 	Uploads []UploadDTO `json:"files,omitempty"`
 
 	Pin bool `json:"pin"`
@@ -41,6 +51,10 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		}
 	})
 
+	reactions := lo.Map(dm.UserReactions, func(r domain.CommentReaction, _ int) CommentReactionDTO {
+		return NewCommentReactionDTO(r, s3)
+	})
+
 	people := lo.Map(dm.PeopleAdded, func(user domain.UserLike, _ int) UserLikeDTO {
 		return UserLikeDTO{
 			UnixAt: user.CreatedAt,
@@ -55,19 +69,55 @@ func NewCommentDTO(dm domain.Comment, dict IDict, s3 IStorage) CommentDTO {
 		ReplyUUID:    dm.ReplyUUID,
 		ReplyComment: dm.ReplyComment,
 
+		ThreadUUID:   dm.ThreadUUID,
+		Depth:        dm.Depth,
+		RepliesCount: dm.RepliesCount,
+
 		CreatedAt: dm.CreatedAt,
 		UpdatedAt: dm.UpdatedAt,
 
+		Edited:   dm.IsEdited(),
+		EditedAt: dm.EditedAt,
+
 		Uploads: uploads,
 
 		Likes: likes,
 
+		Reactions: reactions,
+
 		People: people,
 
 		Pin: dm.Pin,
 	}
 }
 
+type CommentReactionDTO struct {
+	Emoji string        `json:"emoji"`
+	Count int           `json:"count"`
+	Users []UserLikeDTO `json:"users"`
+}
+
+func NewCommentReactionDTO(dm domain.CommentReaction, s3 IStorage) CommentReactionDTO {
+	users := lo.Map(dm.Users, func(user domain.UserLike, _ int) UserLikeDTO {
+		return UserLikeDTO{
+			UnixAt: user.CreatedAt,
+			User:   NewUserShotDto(user.User, s3),
+		}
+	})
+
+	return CommentReactionDTO{
+		Emoji: dm.Emoji,
+		Count: len(users),
+		Users: users,
+	}
+}
+
+// WithHTML - добавляет безопасный HTML текста комментария.
+func (d *CommentDTO) WithHTML() {
+	comment := domain.RenderMarkdown(d.Comment)
+	d.CommentHTML = &comment
+}
+
 func (d *CommentDTO) InPeople(userUUID uuid.UUID) (UserLikeDTO, bool) {
 	f, ok := lo.Find(d.People, func(p UserLikeDTO) bool {
 		return p.User.UUID == userUUID
@@ -75,3 +125,51 @@ func (d *CommentDTO) InPeople(userUUID uuid.UUID) (UserLikeDTO, bool) {
 
 	return f, ok
 }
+
+type MentionDTO struct {
+	Comment     CommentDTO `json:"comment"`
+	TaskUUID    uuid.UUID  `json:"task_uuid"`
+	TaskID      int        `json:"task_id"`
+	TaskName    string     `json:"task_name"`
+	MentionedAt int64      `json:"mentioned_at"`
+}
+
+func NewMentionDTO(dm domain.CommentMention, dict IDict, s3 IStorage) MentionDTO {
+	return MentionDTO{
+		Comment:     NewCommentDTO(dm.Comment, dict, s3),
+		TaskUUID:    dm.Comment.TaskUUID,
+		TaskID:      dm.TaskID,
+		TaskName:    dm.TaskName,
+		MentionedAt: dm.MentionedAt,
+	}
+}
+
+type CommentVersionDTO struct {
+	Version   int       `json:"version"`
+	Comment   string    `json:"comment"`
+	EditedBy  *UserDTO  `json:"edited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewCommentVersionDTO(dm domain.CommentVersion, dict IDict) CommentVersionDTO {
+	editedBy, _ := dict.FindUser(dm.EditedBy)
+
+	return CommentVersionDTO{
+		Version:   dm.Version,
+		Comment:   dm.Comment,
+		EditedBy:  editedBy,
+		CreatedAt: dm.CreatedAt,
+	}
+}
+
+type TextDiffDTO struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+func NewTextDiffDTO(dm domain.TextDiff) TextDiffDTO {
+	return TextDiffDTO{
+		Op:   dm.Op,
+		Text: dm.Text,
+	}
+}
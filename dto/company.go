@@ -41,3 +41,14 @@ type CompanyPriorityDTO struct {
 	Number int       `json:"priority"`
 	Color  string    `json:"color"`
 }
+
+// WorkingHoursDTO - рабочее время компании для доставки уведомлений. Days - дни недели, 0 - воскресенье.
+type WorkingHoursDTO struct {
+	Enabled        bool   `json:"enabled"`
+	From           string `json:"from"`
+	To             string `json:"to"`
+	Days           []int  `json:"days"`
+	UrgentPriority int    `json:"urgent_priority"`
+	MentionsUrgent bool   `json:"mentions_urgent"`
+	Timezone       string `json:"timezone"`
+}
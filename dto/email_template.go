@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// EmailTemplateDTO - сохраненный шаблон письма, company_uuid - шаблон компании федерации.
+type EmailTemplateDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Type        string     `json:"type"`
+	Lang        string     `json:"lang"`
+	CompanyUUID *uuid.UUID `json:"company_uuid,omitempty"`
+
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewEmailTemplateDTO(dm domain.EmailTemplate) EmailTemplateDTO {
+	var companyUUID *uuid.UUID
+	if dm.CompanyUUID != uuid.Nil {
+		companyUUID = &dm.CompanyUUID
+	}
+
+	return EmailTemplateDTO{
+		UUID:        dm.UUID,
+		Type:        dm.Type,
+		Lang:        dm.Lang,
+		CompanyUUID: companyUUID,
+
+		Subject: dm.Subject,
+		Body:    dm.Body,
+
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
+
+// EmailBrandingDTO - оформление писем федерации.
+type EmailBrandingDTO struct {
+	LogoURL         string `json:"logo_url" validate:"omitempty,url,max=500"`
+	PrimaryColor    string `json:"primary_color" validate:"omitempty,hexcolor"`
+	BackgroundColor string `json:"background_color" validate:"omitempty,hexcolor"`
+	SenderName      string `json:"sender_name" validate:"omitempty,max=50"`
+}
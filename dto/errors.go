@@ -22,3 +22,32 @@ func NotFoundErr(msg string) NotFoundError {
 func NotFoundErrf(msg string, a ...interface{}) NotFoundError {
 	return NotFoundError{Err: fmt.Errorf(msg, a...)}
 }
+
+// FieldViolation - нарушение правила кастомного поля задачи.
+type FieldViolation struct {
+	Hash    string `json:"hash"`
+	Name    string `json:"name"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type FieldValidationError struct {
+	Fields []FieldViolation
+}
+
+func (e FieldValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return "field validation error"
+	}
+
+	return fmt.Sprintf("field %s (%s): %s", e.Fields[0].Name, e.Fields[0].Hash, e.Fields[0].Message)
+}
+
+func (e FieldValidationError) Messages() []string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, fmt.Sprintf("field %s (%s): %s", f.Name, f.Hash, f.Message))
+	}
+
+	return msgs
+}
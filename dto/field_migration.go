@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
+type FieldMigrationDTO struct {
+	UUID       uuid.UUID   `json:"uuid"`
+	Target     string      `json:"target"`
+	FieldUUID  uuid.UUID   `json:"field_uuid"`
+	Hash       string      `json:"hash"`
+	FromType   int         `json:"from_type"`
+	ToType     int         `json:"to_type"`
+	Strategy   string      `json:"strategy"`
+	Default    interface{} `json:"default,omitempty"`
+	Status     string      `json:"status"`
+	Total      int         `json:"total"`
+	Processed  int         `json:"processed"`
+	Converted  int         `json:"converted"`
+	Cleared    int         `json:"cleared"`
+	Defaulted  int         `json:"defaulted"`
+	Progress   float64     `json:"progress"`
+	Error      string      `json:"error,omitempty"`
+	CreatedBy  string      `json:"created_by"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+	FinishedAt *time.Time  `json:"finished_at,omitempty"`
+}
+
+func NewFieldMigrationDTO(m domain.FieldMigration) FieldMigrationDTO {
+	progress := 1.0
+	if m.Total > 0 && m.Processed < m.Total {
+		progress = float64(m.Processed) / float64(m.Total)
+	}
+
+	return FieldMigrationDTO{
+		UUID:       m.UUID,
+		Target:     m.Target,
+		FieldUUID:  m.FieldUUID,
+		Hash:       m.Hash,
+		FromType:   int(m.FromType),
+		ToType:     int(m.ToType),
+		Strategy:   m.Strategy,
+		Default:    m.Default,
+		Status:     m.Status,
+		Total:      m.Total,
+		Processed:  m.Processed,
+		Converted:  m.Converted,
+		Cleared:    m.Cleared,
+		Defaulted:  m.Defaulted,
+		Progress:   progress,
+		Error:      m.Error,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+		FinishedAt: m.FinishedAt,
+	}
+}
+
+type FieldMigrationFailureDTO struct {
+	UUID  uuid.UUID   `json:"uuid"`
+	Value interface{} `json:"value"`
+	Error string      `json:"error"`
+}
+
+type FieldMigrationReportDTO struct {
+	Total       int                        `json:"total"`
+	Convertible int                        `json:"convertible"`
+	Failed      int                        `json:"failed"`
+	Samples     []FieldMigrationFailureDTO `json:"samples"`
+}
+
+func NewFieldMigrationReportDTO(r domain.FieldMigrationReport) FieldMigrationReportDTO {
+	return FieldMigrationReportDTO{
+		Total:       r.Total,
+		Convertible: r.Convertible,
+		Failed:      r.Failed,
+		Samples: lo.Map(r.Samples, func(item domain.FieldMigrationFailure, _ int) FieldMigrationFailureDTO {
+			return FieldMigrationFailureDTO{
+				UUID:  item.UUID,
+				Value: item.Value,
+				Error: item.Error,
+			}
+		}),
+	}
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// IntakeAddressDTO - адрес проекта для заявок по почте.
+type IntakeAddressDTO struct {
+	UUID          uuid.UUID `json:"uuid"`
+	ProjectUUID   uuid.UUID `json:"project_uuid"`
+	Email         string    `json:"email"`
+	CreatedBy     string    `json:"created_by"`
+	ResponsibleBy string    `json:"responsible_by,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewIntakeAddressDTO(dm domain.IntakeAddress) IntakeAddressDTO {
+	return IntakeAddressDTO{
+		UUID:          dm.UUID,
+		ProjectUUID:   dm.ProjectUUID,
+		Email:         dm.Email,
+		CreatedBy:     dm.CreatedBy,
+		ResponsibleBy: dm.ResponsibleBy,
+
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
@@ -4,14 +4,32 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
 )
 
+// UserEmailDTO - подключенный почтовый ящик пользователя, пароль не отдается.
 type UserEmailDTO struct {
 	UUID          uuid.UUID  `json:"uuid"`
 	Email         string     `json:"email"`
-	Password      string     `json:"password"`
+	Password      string     `json:"-"`
 	Domain        string     `json:"domain"`
+	Status        int        `json:"status"`
 	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func NewUserEmailDTO(dm domain.Mailbox) UserEmailDTO {
+	return UserEmailDTO{
+		UUID:          dm.UUID,
+		Email:         dm.Email,
+		Domain:        dm.Domain,
+		Status:        dm.Status,
+		LastFetchedAt: dm.LastFetchedAt,
+		LastError:     dm.LastError,
+		CreatedAt:     dm.CreatedAt,
+	}
 }
 
 type CreateUserDTO struct {
@@ -20,17 +38,51 @@ type CreateUserDTO struct {
 	Domain   string `json:"domain" binding:"required"`
 }
 
+// EmailDTO - письмо из почтового ящика пользователя.
 type EmailDTO struct {
-	ID         uint      `json:"id"`
-	UserUUID   uuid.UUID `json:"user_uuid"`
-	Email      string    `json:"email"`
-	Sender     string    `json:"sender"`
-	Subject    string    `json:"subject"`
-	BodyText   string    `json:"body_text"`
-	ReceivedAt time.Time `json:"received_at"`
-	BodyHash   string    `json:"body_hash"`
+	ID          uint        `json:"id"`
+	UserUUID    uuid.UUID   `json:"user_uuid"`
+	MailboxUUID uuid.UUID   `json:"mailbox_uuid"`
+	Email       string      `json:"email"`
+	Sender      string      `json:"sender"`
+	Recipients  []string    `json:"recipients"`
+	Subject     string      `json:"subject"`
+	BodyText    string      `json:"body_text"`
+	ReceivedAt  time.Time   `json:"received_at"`
+	BodyHash    string      `json:"body_hash"`
+	AgentUUIDs  []uuid.UUID `json:"agent_uuids"`
+	TaskUUIDs   []uuid.UUID `json:"task_uuids"`
 }
 
+func NewEmailDTO(dm domain.MailboxEmail) EmailDTO {
+	return EmailDTO{
+		ID:          dm.ID,
+		UserUUID:    dm.UserUUID,
+		MailboxUUID: dm.MailboxUUID,
+		Email:       dm.Email,
+		Sender:      dm.Sender,
+		Recipients:  lo.Ternary(dm.Recipients == nil, []string{}, dm.Recipients),
+		Subject:     dm.Subject,
+		BodyText:    dm.BodyText,
+		ReceivedAt:  dm.ReceivedAt,
+		BodyHash:    dm.BodyHash,
+		AgentUUIDs:  lo.Ternary(dm.AgentUUIDs == nil, []uuid.UUID{}, dm.AgentUUIDs),
+		TaskUUIDs:   lo.Ternary(dm.TaskUUIDs == nil, []uuid.UUID{}, dm.TaskUUIDs),
+	}
+}
+
+// EmailSearchDTO - письма ящиков пользователя, в том числе по агенту или задаче.
+type EmailSearchDTO struct {
+	UserUUID    uuid.UUID
+	MailboxUUID *uuid.UUID
+	AgentUUID   *uuid.UUID
+	TaskUUID    *uuid.UUID
+
+	Offset int
+	Limit  int
+}
+
+// SendEmailDTO - письмо для отправки через SMTP ящика пользователя.
 type SendEmailDTO struct {
 	SenderEmail    string `json:"sender_email" validate:"email,required"`
 	Password       string `json:"password" validate:"required"`
@@ -45,10 +97,29 @@ type UserEmailDTOSettings struct {
 }
 
 type SentEmailDTO struct {
-	SenderEmailUUID uuid.UUID `json:"sender_email_uuid"`
-	SenderEmail     string    `json:"sender_email"`
-	RecipientEmail  string    `json:"recipient_email"`
-	Subject         string    `json:"subject"`
-	Body            string    `json:"body"`
-	CreatedAt       time.Time `json:"created_at"`
+	UUID            uuid.UUID  `json:"uuid"`
+	SenderEmailUUID uuid.UUID  `json:"sender_email_uuid"`
+	SenderEmail     string     `json:"sender_email"`
+	RecipientEmail  string     `json:"recipient_email"`
+	Subject         string     `json:"subject"`
+	Body            string     `json:"body"`
+	Status          string     `json:"status"`
+	Error           string     `json:"error,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	SentAt          *time.Time `json:"sent_at,omitempty"`
+}
+
+func NewSentEmailDTO(dm domain.SentEmail) SentEmailDTO {
+	return SentEmailDTO{
+		UUID:            dm.UUID,
+		SenderEmailUUID: dm.MailboxUUID,
+		SenderEmail:     dm.SenderEmail,
+		RecipientEmail:  dm.RecipientEmail,
+		Subject:         dm.Subject,
+		Body:            dm.Body,
+		Status:          dm.Status,
+		Error:           dm.Error,
+		CreatedAt:       dm.CreatedAt,
+		SentAt:          dm.SentAt,
+	}
 }
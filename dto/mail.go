@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+type MailSearchDTO struct {
+	Status *string
+	To     *string
+
+	Offset int
+	Limit  int
+}
+
+// MailDTO - письмо в очереди отправки.
+type MailDTO struct {
+	UUID    uuid.UUID `json:"uuid"`
+	From    string    `json:"from"`
+	To      []string  `json:"to"`
+	Subject string    `json:"subject"`
+
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	Error         string     `json:"error,omitempty"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewMailDTO(dm domain.Mail) MailDTO {
+	return MailDTO{
+		UUID:    dm.UUID,
+		From:    dm.From,
+		To:      dm.To,
+		Subject: dm.Subject,
+
+		Status:        dm.Status,
+		Attempts:      dm.Attempts,
+		Error:         dm.Error,
+		NextAttemptAt: dm.NextAttemptAt,
+		SentAt:        dm.SentAt,
+
+		CreatedAt: dm.CreatedAt,
+		UpdatedAt: dm.UpdatedAt,
+	}
+}
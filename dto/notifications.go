@@ -1,5 +1,13 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+)
+
 type NotificationDTO struct {
 	UUID     string `json:"uuid"`
 	Type     string `json:"type"`
@@ -39,3 +47,143 @@ type NotificationReminderDTO struct {
 	Opened bool    `json:"opened"`
 	Group  int     `json:"group"`
 }
+
+type NotificationSearchDTO struct {
+	Email string
+
+	Type        *string
+	Status      *string // unread, read
+	Starred     *bool
+	Hidden      *bool
+	ProjectUUID *uuid.UUID
+	Search      *string
+
+	Offset int
+	Limit  int
+}
+
+// NotificationHistoryDTO - уведомление из постоянной истории (Postgres).
+type NotificationHistoryDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Type        string     `json:"type"`
+	EntityUUID  uuid.UUID  `json:"entity_uuid"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+	TaskID      int        `json:"task_id,omitempty"`
+	Name        string     `json:"name"`
+
+	Events []string               `json:"events"`
+	Count  map[string]interface{} `json:"count"`
+
+	Starred  bool       `json:"starred"`
+	Read     bool       `json:"read"`
+	Hidden   bool       `json:"hidden"`
+	ReadAt   *time.Time `json:"read_at,omitempty"`
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NotificationMatrixDTO map[string]map[string]bool
+
+type ProjectNotificationSettingsDTO struct {
+	Muted  bool                  `json:"muted"`
+	Events NotificationMatrixDTO `json:"events"`
+}
+
+type NotificationSettingsDTO struct {
+	Events   NotificationMatrixDTO                        `json:"events"`
+	Projects map[uuid.UUID]ProjectNotificationSettingsDTO `json:"projects"`
+	Digest   string                                       `json:"digest"`
+
+	QuietHours QuietHoursDTO `json:"quiet_hours"`
+
+	AvailableEvents   []string `json:"available_events"`
+	AvailableChannels []string `json:"available_channels"`
+}
+
+// NewNotificationSettingsDTO - общие настройки с учетом значений по умолчанию, проектные как заданы пользователем.
+func NewNotificationSettingsDTO(dm domain.NotificationSettings) NotificationSettingsDTO {
+	projects := make(map[uuid.UUID]ProjectNotificationSettingsDTO, len(dm.Projects))
+	for uid, p := range dm.Projects {
+		projects[uid] = ProjectNotificationSettingsDTO{
+			Muted:  p.Muted,
+			Events: NotificationMatrixDTO(p.Events),
+		}
+	}
+
+	return NotificationSettingsDTO{
+		Events:   NotificationMatrixDTO(dm.Effective()),
+		Projects: projects,
+		Digest:   dm.DigestPeriod(),
+
+		QuietHours: QuietHoursDTO(lo.FromPtr(dm.QuietHours)),
+
+		AvailableEvents:   domain.NotifyEvents,
+		AvailableChannels: domain.NotifyChannels,
+	}
+}
+
+type QuietHoursDTO struct {
+	Enabled bool   `json:"enabled"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+type NotificationDeliverySearchDTO struct {
+	Email       *string
+	CompanyUUID *uuid.UUID
+
+	Status  *string
+	Channel *string
+
+	Offset int
+	Limit  int
+}
+
+// NotificationDeliveryDTO - элемент очереди доставки email и sms уведомлений.
+type NotificationDeliveryDTO struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	UserEmail   string     `json:"user_email"`
+	Channel     string     `json:"channel"`
+	Type        string     `json:"type"`
+	EntityUUID  uuid.UUID  `json:"entity_uuid"`
+	ProjectUUID *uuid.UUID `json:"project_uuid,omitempty"`
+
+	Events []string `json:"events"`
+	Text   string   `json:"text"`
+	URL    string   `json:"url"`
+	Urgent bool     `json:"urgent"`
+
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	Error    string `json:"error,omitempty"`
+
+	DeliverAt time.Time  `json:"deliver_at"`
+	SentAt    *time.Time `json:"sent_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func NewNotificationDeliveryDTO(dm domain.NotificationDelivery) NotificationDeliveryDTO {
+	return NotificationDeliveryDTO{
+		UUID:        dm.UUID,
+		UserEmail:   dm.UserEmail,
+		Channel:     dm.Channel,
+		Type:        dm.Type,
+		EntityUUID:  dm.EntityUUID,
+		ProjectUUID: dm.ProjectUUID,
+
+		Events: dm.Events,
+		Text:   dm.Text(),
+		URL:    dm.URL,
+		Urgent: dm.Urgent,
+
+		Status:   dm.Status,
+		Attempts: dm.Attempts,
+		Error:    dm.Error,
+
+		DeliverAt: dm.DeliverAt,
+		SentAt:    dm.SentAt,
+		CreatedAt: dm.CreatedAt,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
 )
 
 type ProjectDTO struct {
@@ -56,6 +58,34 @@ type FieldStatistics struct {
 	Filled float64 `json:"filled"`
 	Count  int     `json:"count"`
 	Total  int     `json:"total"`
+
+	Options   []FieldOptionStatistics `json:"options,omitempty"`
+	Sums      []FieldMoneyStatistics  `json:"sums,omitempty"`
+	Converted []FieldMoneyStatistics  `json:"converted,omitempty"`
+}
+
+type FieldMoneyStatistics struct {
+	Currency string `json:"currency"`
+	Sum      string `json:"sum"`
+	Count    int    `json:"count"`
+}
+
+type CurrencyRateDTO struct {
+	UUID      uuid.UUID `json:"uuid"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      string    `json:"rate"`
+	Date      string    `json:"date"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FieldOptionStatistics struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Label  string    `json:"label"`
+	Color  string    `json:"color"`
+	Count  int       `json:"count"`
+	Filled float64   `json:"filled"`
 }
 
 type ProjectOptionsDTO struct {
@@ -63,6 +93,8 @@ type ProjectOptionsDTO struct {
 	RequireDoneComment        *bool   `json:"require_done_comment"`
 	StatusEnable              *bool   `json:"status_enable"`
 	Color                     *string `json:"color"`
+
+	CommentEditMinutes *int `json:"comment_edit_minutes,omitempty"`
 }
 
 type ProjectDTOs struct {
@@ -100,6 +132,11 @@ type CompanyFieldDTO struct {
 	DataType    int       `json:"data_type"`
 	DataDesc    string    `json:"data_desc"`
 
+	Options []CompanyFieldOptionDTO `json:"options,omitempty"`
+	Rules   domain.FieldRules       `json:"rules"`
+
+	Relation *domain.FieldRelation `json:"relation,omitempty"`
+
 	ProjectsUUID      []uuid.UUID `json:"project_uuids"`
 	TasksTotal        int         `json:"tasks_total"`
 	TasksFilled       int         `json:"tasks_filled"`
@@ -117,9 +154,36 @@ type ProjectFieldDTO struct {
 	RequiredOnStatuses []int     `json:"required_on_statuses"`
 	Style              string    `json:"style"`
 
+	Options []CompanyFieldOptionDTO `json:"options,omitempty"`
+	Rules   domain.FieldRules       `json:"rules"`
+
+	Relation *domain.FieldRelation `json:"relation,omitempty"`
+
 	ProjectUUID uuid.UUID `json:"project_uuid"`
 }
 
+type CompanyFieldOptionDTO struct {
+	UUID  uuid.UUID `json:"uuid"`
+	Label string    `json:"label"`
+	Color string    `json:"color"`
+	Order int       `json:"order"`
+}
+
+func NewCompanyFieldOptionDTOs(options domain.CompanyFieldOptions) []CompanyFieldOptionDTO {
+	if len(options) == 0 {
+		return nil
+	}
+
+	return lo.Map(options, func(o domain.CompanyFieldOption, _ int) CompanyFieldOptionDTO {
+		return CompanyFieldOptionDTO{
+			UUID:  o.UUID,
+			Label: o.Label,
+			Color: o.Color,
+			Order: o.Order,
+		}
+	})
+}
+
 type ProjectUserDto struct {
 	UUID    uuid.UUID `json:"uuid"`
 	User    UserDTO   `json:"user"`
@@ -20,6 +20,33 @@ type ReminderDTO struct {
 
 	Status int `json:"status"`
 
+	Recurrence     *ReminderRecurrenceDTO `json:"recurrence,omitempty"`
+	SnoozedUntil   *time.Time             `json:"snoozed_until,omitempty"`
+	DeadlineOffset *int                   `json:"deadline_offset,omitempty"`
+
 	User      *UserDTO `json:"user,omitempty"`
 	CreatedBy *UserDTO `json:"created_by,omitempty"`
 }
+
+// ReminderRecurrenceDTO - правило повторения: каждые interval дней, недель или месяцев, для недель - в дни weekdays (0 - воскресенье).
+// month_day задается сервером по первой дате ежемесячного повторения.
+type ReminderRecurrenceDTO struct {
+	Freq     string     `json:"freq" validate:"oneof=daily weekly monthly"`
+	Interval int        `json:"interval,omitempty" validate:"omitempty,min=1,max=365"`
+	Weekdays []int      `json:"weekdays,omitempty" validate:"omitempty,dive,min=0,max=6"`
+	Until    *time.Time `json:"until,omitempty"`
+	MonthDay int        `json:"month_day,omitempty" validate:"omitempty,min=1,max=31"`
+}
+
+// ReminderDispatchDTO - срабатывание напоминания, в списке недоставленных.
+type ReminderDispatchDTO struct {
+	UUID         uuid.UUID `json:"uuid"`
+	ReminderUUID uuid.UUID `json:"reminder_uuid"`
+	FireAt       time.Time `json:"fire_at"`
+
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	Error    string `json:"error"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
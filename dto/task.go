@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,6 +42,8 @@ type TaskDTO struct {
 	ImplementBy   *UserDTO  `json:"implement_by,omitempty"`
 	ManagedBy     *UserDTO  `json:"managed_by,omitempty"`
 
+	DescriptionHTML *string `json:"description_html,omitempty"`
+
 	IsEpic bool `json:"is_epic"`
 
 	CoWorkersBy []UserDTO `json:"co_workers_by"`
@@ -92,6 +95,20 @@ type TaskDTO struct {
 	Activities Pagination[ActivityDTO] `json:"activities"`
 }
 
+// WithHTML - добавляет безопасный HTML описания и комментариев.
+func (d *TaskDTO) WithHTML() {
+	description := domain.RenderMarkdown(d.Description)
+	d.DescriptionHTML = &description
+
+	comments := make([]CommentDTO, len(d.Comments))
+	for i, comment := range d.Comments {
+		comment.WithHTML()
+		comments[i] = comment
+	}
+
+	d.Comments = comments
+}
+
 type Pagination[T any] struct {
 	Items []T   `json:"items"`
 	Total int64 `json:"total"`
@@ -142,6 +159,24 @@ type TaskFieldDTO struct {
 	Value    interface{} `json:"value"`
 }
 
+// RelationDTO - сущность, на которую ссылается поле relation (в LinkedFieldsData).
+type RelationDTO struct {
+	UUID   uuid.UUID `json:"uuid"`
+	Target string    `json:"target"`
+	Name   string    `json:"name"`
+	Hash   []string  `json:"hash"`
+}
+
+// RelationTaskDTO - задача, ссылающаяся на сущность через поле relation.
+type RelationTaskDTO struct {
+	UUID        uuid.UUID `json:"uuid"`
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	ProjectUUID uuid.UUID `json:"project_uuid"`
+	Status      int       `json:"status"`
+	Hash        []string  `json:"hash"`
+}
+
 func NewTaskDTO(dm domain.Task, comments []domain.Comment, files []domain.File, reminders []domain.Reminder, linkedFieldsData map[uuid.UUID]interface{}, dict IDict, s3 IStorage) TaskDTO {
 	createdBy, _ := dict.FindUser(dm.CreatedBy)
 	implementBy, fi := dict.FindUser(dm.ImplementBy)
@@ -426,6 +461,56 @@ func NewFilterDTO(filter *string) (dtos []FilterDTO, err error) {
 	return dtos, err
 }
 
+const MoneyFilterOperator = "money"
+
+// MoneyFilterDTO - фильтр по полю типа money.
+type MoneyFilterDTO struct {
+	From     *string
+	To       *string
+	Currency *string
+}
+
+func NewMoneyFilterDTO(value string) (f MoneyFilterDTO, err error) {
+	for _, part := range strings.Fields(value) {
+		switch {
+		case domain.IsCurrency(strings.ToUpper(part)):
+			f.Currency = helpers.Ptr(strings.ToUpper(part))
+		case strings.HasPrefix(part, ">="):
+			f.From, err = moneyFilterAmount(strings.TrimPrefix(part, ">="))
+		case strings.HasPrefix(part, "<="):
+			f.To, err = moneyFilterAmount(strings.TrimPrefix(part, "<="))
+		case strings.Contains(part, ".."):
+			bounds := strings.SplitN(part, "..", 2)
+			f.From, err = moneyFilterAmount(bounds[0])
+			if err == nil {
+				f.To, err = moneyFilterAmount(bounds[1])
+			}
+		default:
+			f.From, err = moneyFilterAmount(part)
+			f.To = f.From
+		}
+
+		if err != nil {
+			return f, err
+		}
+	}
+
+	if f.From == nil && f.To == nil && f.Currency == nil {
+		return f, errors.New("пустой фильтр по сумме")
+	}
+
+	return f, nil
+}
+
+func moneyFilterAmount(s string) (*string, error) {
+	r, err := domain.ParseAmount(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return helpers.Ptr(r.FloatString(domain.MoneyMaxScale)), nil
+}
+
 type TaskSearchDTO struct {
 	MyEmail *string `json:"my_email"`
 
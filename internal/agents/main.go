@@ -30,3 +30,17 @@ func (s *Service) Delete(_ context.Context, uid uuid.UUID) error {
 func (s *Service) Update(_ context.Context, a *domain.Agent) error {
 	return s.repo.Update(a)
 }
+
+// FindByEmail - агент компании с контактом email, без учета регистра.
+func (s *Service) FindByEmail(_ context.Context, companyUUID uuid.UUID, email string) (domain.Agent, bool, error) {
+	return s.repo.FindByEmail(companyUUID, email)
+}
+
+// FindByEmails - агенты федераций по адресам переписки.
+func (s *Service) FindByEmails(_ context.Context, federationUUIDs []uuid.UUID, emails []string) ([]domain.Agent, error) {
+	if len(federationUUIDs) == 0 || len(emails) == 0 {
+		return []domain.Agent{}, nil
+	}
+
+	return s.repo.FindByEmails(federationUUIDs, emails)
+}
@@ -3,6 +3,7 @@ package agents
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -121,6 +122,66 @@ func (r *Repository) Get(_ context.Context, filter domain.AgentFilter) (dms []do
 	return dms, total, nil
 }
 
+func (r *Repository) FindByEmail(companyUUID uuid.UUID, email string) (domain.Agent, bool, error) {
+	orm := Agent{}
+
+	err := r.gorm.DB.
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Where("exists (select 1 from jsonb_array_elements(contacts) c where lower(c->>'type') = 'email' and lower(trim(c->>'val')) = lower(?))", email).
+		Order("created_at").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.Agent{}, false, nil
+	}
+
+	if err != nil {
+		return domain.Agent{}, false, err
+	}
+
+	return agentToDomain(orm), true, nil
+}
+
+// FindByEmails - агенты федераций, у которых есть контакт email из списка (без учета регистра).
+func (r *Repository) FindByEmails(federationUUIDs []uuid.UUID, emails []string) ([]domain.Agent, error) {
+	orms := []Agent{}
+
+	err := r.gorm.DB.
+		Where("federation_uuid in ?", federationUUIDs).
+		Where("deleted_at is null").
+		Where("exists (select 1 from jsonb_array_elements(contacts) c where lower(c->>'type') = 'email' and lower(trim(c->>'val')) in ?)", lo.Map(emails, func(e string, _ int) string {
+			return strings.ToLower(e)
+		})).
+		Order("created_at").
+		Find(&orms).
+		Error
+
+	return lo.Map(orms, func(orm Agent, _ int) domain.Agent {
+		return agentToDomain(orm)
+	}), err
+}
+
+func agentToDomain(orm Agent) domain.Agent {
+	return domain.Agent{
+		UUID:           orm.UUID,
+		FederationUUID: orm.FederationUUID,
+		CompanyUUID:    orm.CompanyUUID,
+		CreatedBy:      orm.CreatedBy,
+		CreatedByUUID:  orm.CreatedByUUID,
+		Name:           orm.Name,
+		Contacts: lo.Map(orm.Contacts, func(c Contacts, _ int) domain.AgentContacts {
+			return domain.AgentContacts{
+				Type: c.Type,
+				Val:  c.Val,
+			}
+		}),
+		CreatedAt: orm.CreatedAt,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
 func (r *Repository) Update(s *domain.Agent) error {
 	return r.gorm.DB.Model(&Agent{}).
 		Where("uuid = ?", s.UUID).
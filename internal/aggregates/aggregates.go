@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/catalogs"
 	"github.com/krisch/crm-backend/internal/comments"
 	"github.com/krisch/crm-backend/internal/dictionary"
 	"github.com/krisch/crm-backend/internal/federation"
@@ -24,6 +25,7 @@ type Service struct {
 	s3ps              *s3.ServicePrivate
 	rm                *reminders.Service
 	federationService *federation.Service
+	catalogsService   *catalogs.Service
 }
 
 func New(
@@ -34,6 +36,7 @@ func New(
 	s3ps *s3.ServicePrivate,
 	rm *reminders.Service,
 	federationService *federation.Service,
+	catalogsService *catalogs.Service,
 ) *Service {
 	return &Service{
 		dictionaryService: dictionaryService,
@@ -43,6 +46,7 @@ func New(
 		s3ps:              s3ps,
 		rm:                rm,
 		federationService: federationService,
+		catalogsService:   catalogsService,
 	}
 }
 
@@ -67,7 +71,12 @@ func (s *Service) GetTaskWithFields(ctx context.Context, taskUUID uuid.UUID) (dt
 		return dt, err
 	}
 
-	taskDto := dto.NewTaskDTO(dm, taskComments, files, taskReminders, make(map[uuid.UUID]interface{}), s.dictionaryService, s.ps)
+	relationsData, err := s.ts.RelationFieldsData(dm)
+	if err != nil {
+		return dt, err
+	}
+
+	taskDto := dto.NewTaskDTO(dm, taskComments, files, taskReminders, relationsData, s.dictionaryService, s.ps)
 
 	return taskDto, nil
 }
@@ -75,6 +84,7 @@ func (s *Service) GetTaskWithFields(ctx context.Context, taskUUID uuid.UUID) (dt
 type StateDiff struct {
 	NewComments  []dto.CommentDTO  `json:"new_comments"`
 	NewLikes     int               `json:"new_likes"`
+	NewReactions int               `json:"new_reactions"`
 	NewMentions  int               `json:"new_mentions"`
 	NewUploads   []dto.FileDTOs    `json:"new_uploads"`
 	NewReminders []dto.ReminderDTO `json:"new_reminders"`
@@ -85,6 +95,7 @@ type StateDiff struct {
 func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) StateDiff {
 	newMensions := 0
 	newLikes := 0
+	newReactions := 0
 	newComments := []dto.CommentDTO{}
 	newUploads := []dto.FileDTOs{}
 	newReminders := []dto.ReminderDTO{}
@@ -116,6 +127,17 @@ func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) S
 
 				return false
 			})
+
+			// реакции других пользователей на комментарии пользователя
+			if c.CreatedBy != nil && c.CreatedBy.UUID == userUUID {
+				for _, r := range c.Reactions {
+					for _, u := range r.Users {
+						if u.User.UUID != userUUID && time.UnixMicro(u.UnixAt).After(fromTime) {
+							newReactions++
+						}
+					}
+				}
+			}
 		}
 	}
 
@@ -146,6 +168,7 @@ func CompareState(taskDto dto.TaskDTO, userUUID uuid.UUID, fromTime time.Time) S
 		NewComments:  newComments,
 		NewMentions:  newMensions,
 		NewLikes:     newLikes,
+		NewReactions: newReactions,
 		NewUploads:   newUploads,
 		NewReminders: newReminders,
 		UpdatedAt:    score,
@@ -0,0 +1,379 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const fieldMigrationSamples = 20
+
+// fieldMigrationSource - откуда читать и куда писать значения поля (задачи или записи справочника).
+type fieldMigrationSource struct {
+	options domain.CompanyFieldOptions
+	get     func(after uuid.UUID, since *time.Time, limit int) ([]domain.FieldValue, error)
+	set     func(values []domain.FieldValue) error
+	apply   func() error
+	revert  func() error
+}
+
+func (s *Service) fieldMigrationSource(m *domain.FieldMigration, options domain.CompanyFieldOptions) (src fieldMigrationSource, err error) {
+	switch m.Target {
+	case domain.FieldMigrationTargetCompany:
+		cf, err := s.federationService.GetCompanyField(m.FieldUUID)
+		if err != nil {
+			return src, err
+		}
+
+		if cf.CompanyUUID != m.CompanyUUID {
+			return src, dto.NotFoundErr("поле не найдено")
+		}
+
+		m.Hash = cf.Hash
+		m.FromType = cf.DataType
+
+		src.options = cf.Options
+		if options != nil {
+			if m.ToType != domain.Select && m.ToType != domain.MultiSelect {
+				return src, errors.New("варианты доступны только для полей select и multi_select")
+			}
+
+			src.options, err = cf.Options.Merge(options)
+			if err != nil {
+				return src, err
+			}
+		}
+
+		src.get = func(after uuid.UUID, since *time.Time, limit int) ([]domain.FieldValue, error) {
+			return s.ts.GetFieldValues(cf.CompanyUUID, cf.Hash, after, since, limit)
+		}
+		src.set = func(values []domain.FieldValue) error {
+			return s.ts.SetFieldValues(cf.Hash, values)
+		}
+		src.apply = func() error {
+			if options == nil {
+				return s.federationService.ChangeCompanyFieldType(cf.UUID, m.ToType, nil)
+			}
+
+			return s.federationService.ChangeCompanyFieldType(cf.UUID, m.ToType, src.options)
+		}
+		src.revert = func() error {
+			if options == nil {
+				return s.federationService.ChangeCompanyFieldType(cf.UUID, cf.DataType, nil)
+			}
+
+			return s.federationService.ChangeCompanyFieldType(cf.UUID, cf.DataType, append(domain.CompanyFieldOptions{}, cf.Options...))
+		}
+	case domain.FieldMigrationTargetCatalog:
+		pf, err := s.catalogsService.GetCatalogField(m.FieldUUID)
+		if err != nil {
+			return src, err
+		}
+
+		catalog, err := s.catalogsService.GetCatalog(pf.CatalogUUID)
+		if err != nil {
+			return src, err
+		}
+
+		if catalog.CompanyUUID != m.CompanyUUID {
+			return src, dto.NotFoundErr("поле справочника не найдено")
+		}
+
+		if m.ToType > domain.Link || m.ToType == domain.Data || m.ToType == domain.DataArray || options != nil {
+			return src, errors.New("тип недоступен для полей справочника")
+		}
+
+		m.Hash = pf.Hash
+		m.FromType = pf.DataType
+
+		src.get = func(after uuid.UUID, since *time.Time, limit int) ([]domain.FieldValue, error) {
+			return s.catalogsService.GetFieldValues(pf.CatalogUUID, pf.Hash, after, since, limit)
+		}
+		src.set = func(values []domain.FieldValue) error {
+			return s.catalogsService.SetFieldValues(pf.Hash, values)
+		}
+		src.apply = func() error {
+			return s.catalogsService.ChangeCatalogFieldType(pf, m.ToType)
+		}
+		src.revert = func() error {
+			return s.catalogsService.ChangeCatalogFieldType(pf, pf.DataType)
+		}
+	default:
+		return src, errors.New("неизвестный тип поля для миграции")
+	}
+
+	if m.FromType == m.ToType && options == nil {
+		return src, errors.New("тип поля не изменился")
+	}
+
+	if (m.ToType == domain.Select || m.ToType == domain.MultiSelect) && len(src.options) == 0 {
+		return src, errors.New("для select и multi_select нужны варианты")
+	}
+
+	return src, nil
+}
+
+// DryRunFieldMigration - пробная конвертация всех значений поля без записи.
+func (s *Service) DryRunFieldMigration(m domain.FieldMigration, options domain.CompanyFieldOptions) (report domain.FieldMigrationReport, err error) {
+	src, err := s.fieldMigrationSource(&m, options)
+	if err != nil {
+		return report, err
+	}
+
+	return s.dryRunFieldMigration(m, src)
+}
+
+func (s *Service) dryRunFieldMigration(m domain.FieldMigration, src fieldMigrationSource) (report domain.FieldMigrationReport, err error) {
+	report.Samples = []domain.FieldMigrationFailure{}
+
+	after := uuid.Nil
+	for {
+		values, err := src.get(after, nil, domain.FieldMigrationBatchSize)
+		if err != nil {
+			return report, err
+		}
+
+		for _, v := range values {
+			report.Total++
+
+			if _, err := domain.ConvertFieldValue(v.Value, m.ToType, src.options); err != nil {
+				report.Failed++
+				if len(report.Samples) < fieldMigrationSamples {
+					report.Samples = append(report.Samples, domain.FieldMigrationFailure{UUID: v.UUID, Value: v.Value, Error: err.Error()})
+				}
+				continue
+			}
+
+			report.Convertible++
+		}
+
+		if len(values) < domain.FieldMigrationBatchSize {
+			return report, nil
+		}
+
+		after = values[len(values)-1].UUID
+	}
+}
+
+// StartFieldMigration - проверяет конвертацию и запускает миграцию в фоне.
+// Прогресс сохраняется после каждого батча и доступен через GetFieldMigration.
+func (s *Service) StartFieldMigration(m domain.FieldMigration, options domain.CompanyFieldOptions) (domain.FieldMigration, error) {
+	src, err := s.fieldMigrationSource(&m, options)
+	if err != nil {
+		return m, err
+	}
+
+	running, err := s.federationService.HasRunningFieldMigration(m.FieldUUID)
+	if err != nil {
+		return m, err
+	}
+
+	if running {
+		return m, errors.New("миграция поля уже запущена")
+	}
+
+	switch m.Strategy {
+	case "", domain.FieldMigrationStrategyFail:
+		m.Strategy = domain.FieldMigrationStrategyFail
+		m.Default = nil
+	case domain.FieldMigrationStrategyClear:
+		m.Default = nil
+	case domain.FieldMigrationStrategyDefault:
+		m.Default, err = domain.ConvertFieldValue(m.Default, m.ToType, src.options)
+		if err != nil || m.Default == nil {
+			return m, fmt.Errorf("значение по умолчанию не подходит для нового типа: %v", err)
+		}
+	default:
+		return m, errors.New("strategy: fail|default|clear")
+	}
+
+	report, err := s.dryRunFieldMigration(m, src)
+	if err != nil {
+		return m, err
+	}
+
+	if report.Failed > 0 && m.Strategy == domain.FieldMigrationStrategyFail {
+		return m, fmt.Errorf("нельзя конвертировать %d из %d значений, выберите default или clear", report.Failed, report.Total)
+	}
+
+	m.UUID = uuid.New()
+	m.Status = domain.FieldMigrationStatusRunning
+	m.Total = report.Total
+	m.CreatedAt = time.Now()
+	m.UpdatedAt = m.CreatedAt
+
+	err = s.federationService.CreateFieldMigration(m)
+	if err != nil {
+		return m, err
+	}
+
+	go s.runFieldMigration(m, src)
+
+	return m, nil
+}
+
+func (s *Service) GetFieldMigration(companyUUID, uid uuid.UUID) (m domain.FieldMigration, err error) {
+	m, err = s.federationService.GetFieldMigration(uid)
+	if err != nil {
+		return m, err
+	}
+
+	if m.CompanyUUID != companyUUID {
+		return m, dto.NotFoundErr("миграция не найдена")
+	}
+
+	return m, nil
+}
+
+// FailStaleFieldMigrations - миграции, прерванные перезапуском сервиса, помечаются упавшими,
+// иначе HasRunningFieldMigration не даст запустить новую миграцию поля.
+func (s *Service) FailStaleFieldMigrations(now time.Time) (int64, error) {
+	return s.federationService.FailStaleFieldMigrations(now.Add(-domain.FieldMigrationStaleAfter), "миграция прервана перезапуском сервиса")
+}
+
+// runFieldMigration - конвертация батчами, смена типа поля и догоняющий проход
+// по записям, измененным во время миграции.
+// При стратегии fail ошибка отменяет миграцию: исходные значения и тип поля возвращаются.
+func (s *Service) runFieldMigration(m domain.FieldMigration, src fieldMigrationSource) {
+	l := logrus.WithField("migration", m.UUID).WithField("hash", m.Hash)
+
+	var backup *fieldMigrationBackup
+	if m.Strategy == domain.FieldMigrationStrategyFail {
+		backup = &fieldMigrationBackup{seen: map[uuid.UUID]bool{}}
+	}
+
+	applied := false
+
+	err := s.migrateFieldValues(&m, src, nil, backup)
+	if err == nil {
+		err = src.apply()
+		applied = err == nil
+	}
+
+	if err == nil {
+		err = s.migrateFieldValues(&m, src, &m.CreatedAt, backup)
+	}
+
+	if err != nil && backup != nil {
+		if rerr := backup.restore(src, applied); rerr != nil {
+			l.Error("field migration rollback failed: ", rerr)
+			err = fmt.Errorf("%w; откат не завершен: %v", err, rerr)
+		} else {
+			err = fmt.Errorf("%w; изменения отменены", err)
+		}
+	}
+
+	now := time.Now()
+	m.FinishedAt = &now
+	m.Status = domain.FieldMigrationStatusDone
+
+	if err != nil {
+		l.Error("field migration failed: ", err)
+		m.Status = domain.FieldMigrationStatusFailed
+		m.Error = err.Error()
+	}
+
+	if err := s.federationService.SaveFieldMigrationProgress(m); err != nil {
+		l.Error("field migration progress: ", err)
+	}
+
+	l.Infof("field migration %s: processed %d/%d", m.Status, m.Processed, m.Total)
+}
+
+// fieldMigrationBackup - исходные значения записей, измененных миграцией со стратегией fail.
+type fieldMigrationBackup struct {
+	seen   map[uuid.UUID]bool
+	values []domain.FieldValue
+}
+
+// add - сохраняется только первое (исходное) значение записи.
+func (b *fieldMigrationBackup) add(values []domain.FieldValue) {
+	if b == nil {
+		return
+	}
+
+	for _, v := range values {
+		if !b.seen[v.UUID] {
+			b.seen[v.UUID] = true
+			b.values = append(b.values, v)
+		}
+	}
+}
+
+func (b *fieldMigrationBackup) restore(src fieldMigrationSource, applied bool) error {
+	if applied {
+		if err := src.revert(); err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range lo.Chunk(b.values, domain.FieldMigrationBatchSize) {
+		if err := src.set(batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) migrateFieldValues(m *domain.FieldMigration, src fieldMigrationSource, since *time.Time, backup *fieldMigrationBackup) error {
+	after := uuid.Nil
+	for {
+		values, err := src.get(after, since, domain.FieldMigrationBatchSize)
+		if err != nil {
+			return err
+		}
+
+		converted := make([]domain.FieldValue, 0, len(values))
+		for _, v := range values {
+			// догоняющий проход не меняет счетчики, чтобы они сходились с total
+			count := func(counter *int) {
+				if since == nil {
+					*counter++
+				}
+			}
+
+			value, err := domain.ConvertFieldValue(v.Value, m.ToType, src.options)
+			switch {
+			case err == nil:
+				count(&m.Converted)
+			case m.Strategy == domain.FieldMigrationStrategyDefault:
+				value = m.Default
+				count(&m.Defaulted)
+			case m.Strategy == domain.FieldMigrationStrategyClear:
+				value = nil
+				count(&m.Cleared)
+			default:
+				return fmt.Errorf("%s: %w", v.UUID, err)
+			}
+
+			converted = append(converted, domain.FieldValue{UUID: v.UUID, Value: value})
+		}
+
+		if err := src.set(converted); err != nil {
+			return err
+		}
+
+		backup.add(values)
+
+		if since == nil {
+			m.Processed += len(values)
+		}
+
+		if err := s.federationService.SaveFieldMigrationProgress(*m); err != nil {
+			return err
+		}
+
+		if len(values) < domain.FieldMigrationBatchSize {
+			return nil
+		}
+
+		after = values[len(values)-1].UUID
+	}
+}
@@ -0,0 +1,48 @@
+package aggregates
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func TestFieldMigrationBackupRestore(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	stored := map[uuid.UUID]interface{}{}
+	reverted := false
+
+	src := fieldMigrationSource{
+		set: func(values []domain.FieldValue) error {
+			for _, v := range values {
+				stored[v.UUID] = v.Value
+			}
+			return nil
+		},
+		revert: func() error {
+			reverted = true
+			return nil
+		},
+	}
+
+	backup := &fieldMigrationBackup{seen: map[uuid.UUID]bool{}}
+	backup.add([]domain.FieldValue{{UUID: a, Value: "1"}, {UUID: b, Value: "2"}})
+	// догоняющий проход видит уже сконвертированное значение a
+	backup.add([]domain.FieldValue{{UUID: a, Value: float64(1)}})
+
+	if err := backup.restore(src, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reverted {
+		t.Error("restore() did not revert field type")
+	}
+
+	if stored[a] != "1" || stored[b] != "2" {
+		t.Errorf("restore() values = %v", stored)
+	}
+
+	var nilBackup *fieldMigrationBackup
+	nilBackup.add([]domain.FieldValue{{UUID: a}})
+}
@@ -82,6 +82,9 @@ func (s *Service) GetProject(_ context.Context, uid uuid.UUID) (dto.ProjectDTO,
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
 				DataDesc:           item.FieldTypeDesc(),
+				Options:            dto.NewCompanyFieldOptionDTOs(item.Options),
+				Rules:              lo.FromPtr(item.Rules),
+				Relation:           item.Relation,
 			}
 		}),
 
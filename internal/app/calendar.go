@@ -0,0 +1,317 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+const (
+	calendarMaxRange = 93 * 24 * time.Hour
+	// calendarMaxOccurrences - повторений одного дела в выдаче календаря
+	calendarMaxOccurrences = 100
+)
+
+var ErrCalendarRange = errors.New("интервал календаря должен быть от 1 минуты до 93 дней")
+
+// CalendarFeedURL - ссылка на ics календарь пользователя, для календаря проекта добавляется project_uuid.
+func (a *App) CalendarFeedURL(uid uuid.UUID, reset bool) (string, error) {
+	token, err := a.ProfileService.CalendarToken(uid)
+	if reset {
+		token, err = a.ProfileService.ResetCalendarToken(uid)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("token", token)
+
+	return a.Options.URL_BACKEND + "/profile/calendar/feed?" + q.Encode(), nil
+}
+
+// CalendarFeed - ics календарь по секретной ссылке: дела пользователя и сроки задач, где он исполнитель или ответственный,
+// либо, если указан проект, сроки всех задач проекта и дела пользователя по ним. Завершенные и отмененные задачи не попадают.
+func (a *App) CalendarFeed(ctx context.Context, token string, projectUUID *uuid.UUID) ([]byte, error) {
+	user, err := a.ProfileService.UserByCalendarToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "Задачи " + user.Name
+
+	var tasks []domain.Task
+
+	if projectUUID != nil {
+		project, err := a.calendarProject(*projectUUID, user.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		name = project.Name
+		tasks, err = a.TaskService.GetDeadlineTasks(ctx, "", project.UUID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tasks, err = a.TaskService.GetDeadlineTasks(ctx, user.Email, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := lo.Map(tasks, func(t domain.Task, _ int) domain.CalendarEvent {
+		return a.deadlineEvent(t)
+	})
+
+	reminders, err := a.RemindersService.GetByUser(user.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderTasks, err := a.reminderTasks(ctx, reminders)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range reminders {
+		task, ok := reminderTasks[r.TaskUUID]
+		if !ok || r.FireAt() == nil || task.Status == domain.StatusDone || task.Status == domain.StatusCancel {
+			continue
+		}
+
+		if projectUUID != nil && task.ProjectUUID != *projectUUID {
+			continue
+		}
+
+		e := a.reminderEvent(r, task, *r.FireAt())
+		if r.Recurrence != nil {
+			e.RRule = r.Recurrence.RRule()
+		}
+
+		events = append(events, e)
+	}
+
+	return domain.ICS(name, events), nil
+}
+
+// CalendarEvents - события календаря пользователя или проекта за интервал: дела (с повторениями), сроки и завершение задач,
+// отложенные sms пользователя. Время событий в часовом поясе пользователя.
+func (a *App) CalendarEvents(ctx context.Context, filter dto.CalendarSearchDTO) ([]domain.CalendarEvent, *time.Location, error) {
+	if d := filter.To.Sub(filter.From); d < time.Minute || d > calendarMaxRange {
+		return nil, nil, ErrCalendarRange
+	}
+
+	prefs, err := a.ProfileService.UsersPreferences([]uuid.UUID{filter.UserUUID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc := prefs[filter.UserUUID].Location(a.Options.TIME_ZONE)
+
+	var (
+		tasks     []domain.Task
+		reminders []domain.Reminder
+		smss      []domain.Sms
+	)
+
+	if filter.ProjectUUID != nil {
+		project, err := a.calendarProject(*filter.ProjectUUID, filter.UserUUID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tasks, err = a.TaskService.GetCalendarTasks(ctx, "", project.UUID, filter.From, filter.To)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reminders, err = a.RemindersService.GetByProject(project.UUID, filter.From, filter.To)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// sms не привязаны к проекту, в календаре проекта видны только свои sms в компании проекта
+		smss, err = a.SMSService.GetScheduled(project.CompanyUUID, filter.UserEmail, filter.From, filter.To)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		tasks, err = a.TaskService.GetCalendarTasks(ctx, filter.UserEmail, uuid.Nil, filter.From, filter.To)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reminders, err = a.RemindersService.GetByUser(filter.UserUUID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		smss, err = a.SMSService.GetScheduled(uuid.Nil, filter.UserEmail, filter.From, filter.To)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	events := []domain.CalendarEvent{}
+
+	for _, t := range tasks {
+		if t.FinishTo != nil && !t.FinishTo.Before(filter.From) && t.FinishTo.Before(filter.To) &&
+			t.Status != domain.StatusDone && t.Status != domain.StatusCancel {
+			events = append(events, a.deadlineEvent(t))
+		}
+
+		if t.FinishedAt != nil && !t.FinishedAt.Before(filter.From) && t.FinishedAt.Before(filter.To) {
+			events = append(events, domain.CalendarEvent{
+				UUID:        t.UUID,
+				Type:        domain.CalendarFinished,
+				Title:       fmt.Sprintf("Завершена: %s %s", a.taskRef(t.ProjectUUID, t.ID), t.Name),
+				URL:         a.taskURL(t.UUID),
+				Start:       *t.FinishedAt,
+				UpdatedAt:   t.UpdatedAt,
+				TaskUUID:    t.UUID,
+				ProjectUUID: t.ProjectUUID,
+				People:      lo.Compact(lo.Uniq([]string{t.FinishedBy, t.ImplementBy, t.ResponsibleBy})),
+			})
+		}
+	}
+
+	reminderTasks, err := a.reminderTasks(ctx, reminders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, r := range reminders {
+		task, ok := reminderTasks[r.TaskUUID]
+		if !ok {
+			continue
+		}
+
+		for _, at := range r.Occurrences(filter.From, filter.To, loc, calendarMaxOccurrences) {
+			events = append(events, a.reminderEvent(r, task, at))
+		}
+	}
+
+	for _, s := range smss {
+		events = append(events, domain.CalendarEvent{
+			UUID:        s.UUID,
+			Type:        domain.CalendarSms,
+			Title:       "SMS на " + s.To,
+			Description: s.Text,
+			Start:       s.Time,
+			UpdatedAt:   s.UpdatedAt,
+			People:      []string{s.CreatedBy},
+		})
+	}
+
+	events = domain.FilterCalendar(events, filter.Types, filter.People)
+
+	for i := range events {
+		events[i].Start = events[i].Start.In(loc)
+		if events[i].End != nil {
+			events[i].End = helpers.Ptr(events[i].End.In(loc))
+		}
+	}
+
+	return events, loc, nil
+}
+
+// calendarProject - календарь проекта доступен тем же, кто видит проект и его задачи.
+func (a *App) calendarProject(projectUUID, userUUID uuid.UUID) (*dto.ProjectDTO, error) {
+	project, ok := a.DictionaryService.FindProject(projectUUID)
+	if !ok {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	err := a.GateService.ProjectView(domain.Project{
+		UUID:           project.UUID,
+		FederationUUID: project.FederationUUID,
+		CompanyUUID:    project.CompanyUUID,
+	}, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// reminderTasks - неудаленные задачи дел.
+func (a *App) reminderTasks(ctx context.Context, reminders []domain.Reminder) (map[uuid.UUID]domain.Task, error) {
+	tasks, err := a.TaskService.GetTasksNames(ctx, lo.Uniq(lo.Map(reminders, func(r domain.Reminder, _ int) uuid.UUID {
+		return r.TaskUUID
+	})))
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.KeyBy(tasks, func(t domain.Task) uuid.UUID {
+		return t.UUID
+	}), nil
+}
+
+func (a *App) deadlineEvent(t domain.Task) domain.CalendarEvent {
+	return domain.CalendarEvent{
+		UUID:        t.UUID,
+		Type:        domain.CalendarDeadline,
+		Title:       fmt.Sprintf("Срок: %s %s", a.taskRef(t.ProjectUUID, t.ID), t.Name),
+		URL:         a.taskURL(t.UUID),
+		Start:       *t.FinishTo,
+		UpdatedAt:   t.UpdatedAt,
+		TaskUUID:    t.UUID,
+		ProjectUUID: t.ProjectUUID,
+		People:      lo.Compact(lo.Uniq([]string{t.ImplementBy, t.ResponsibleBy})),
+	}
+}
+
+// reminderEvent - срабатывание дела в at, длительность дела сохраняется.
+func (a *App) reminderEvent(r domain.Reminder, task domain.Task, at time.Time) domain.CalendarEvent {
+	people := []string{r.CreatedBy}
+	if r.UserUUID != nil {
+		if user, ok := a.DictionaryService.FindUserByUUID(*r.UserUUID); ok {
+			people = append(people, user.Email)
+		}
+	}
+
+	e := domain.CalendarEvent{
+		UUID:        r.UUID,
+		Type:        domain.CalendarReminder,
+		Title:       helpers.If(r.Description != "", r.Description, "Напоминание"),
+		Description: strings.TrimSpace(fmt.Sprintf("Задача %s «%s»\n%s", a.taskRef(task.ProjectUUID, task.ID), task.Name, r.Comment)),
+		URL:         a.taskURL(task.UUID),
+		Start:       at,
+		UpdatedAt:   r.UpdatedAt,
+		TaskUUID:    task.UUID,
+		ProjectUUID: task.ProjectUUID,
+		People:      lo.Uniq(people),
+	}
+
+	if r.DateFrom != nil && r.DateTo != nil && (r.SnoozedUntil == nil || !at.Equal(*r.SnoozedUntil)) {
+		e.End = helpers.Ptr(at.Add(r.DateTo.Sub(*r.DateFrom)))
+	}
+
+	return e
+}
+
+// taskRef - номер задачи в общем формате ссылок на задачи (см. domain.NewTaskRef).
+func (a *App) taskRef(projectUUID uuid.UUID, id int) string {
+	project, ok := a.DictionaryService.FindProject(projectUUID)
+	if !ok {
+		return fmt.Sprintf("#%d", id)
+	}
+
+	return domain.NewTaskRef(project.Name, id).String()
+}
+
+func (a *App) taskURL(uid uuid.UUID) string {
+	return fmt.Sprintf("%s/task/%s", a.Options.URL_FRONTEND, uid)
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/sms"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	deliveryInterval = 30 * time.Second
+	deliveryBatch    = 50
+)
+
+var errNoPhone = errors.New("у пользователя не указан телефон")
+
+// EnqueueTaskDeliveries - ставит в очередь email и sms уведомления о событиях задачи.
+// Несрочные откладываются до окончания тихих часов пользователя и начала рабочего времени компании.
+func (a *App) EnqueueTaskDeliveries(email string, task dto.TaskDTO, events []string) error {
+	// напоминания по почте и sms отправляются при наступлении, а не при изменении дела
+	events = lo.Without(events, domain.NotifyReminder)
+	if len(events) == 0 {
+		return nil
+	}
+
+	user, ok := a.DictionaryService.FindUser(email)
+	if !ok {
+		return nil
+	}
+
+	prefs, err := a.ProfileService.UsersPreferences([]uuid.UUID{user.UUID})
+	if err != nil {
+		return err
+	}
+
+	p := prefs[user.UUID]
+	settings := lo.FromPtr(p.Notifications)
+
+	channels := lo.Filter([]string{domain.ChannelEmail, domain.ChannelSMS}, func(channel string, _ int) bool {
+		if channel == domain.ChannelSMS && user.Phone == 0 {
+			return false
+		}
+
+		return settings.AllowedAny(task.Project.UUID, events, channel)
+	})
+	if len(channels) == 0 {
+		return nil
+	}
+
+	hours, err := a.CompanyService.GetWorkingHours(task.Project.CompanyUUID)
+	if err != nil {
+		logrus.WithField("company", task.Project.CompanyUUID).Error("working hours error: ", err)
+	}
+
+	now := time.Now()
+	urgent := domain.DeliveryUrgent(events, task.Priority, hours)
+	deliverAt := now
+	if !urgent {
+		deliverAt = domain.NextDelivery(now, p.Location(a.Options.TIME_ZONE), lo.FromPtr(settings.QuietHours), hours.Location(a.Options.TIME_ZONE), hours)
+	}
+
+	for _, channel := range channels {
+		err = a.NotificationsService.EnqueueDelivery(domain.NotificationDelivery{
+			UserEmail:   email,
+			Channel:     channel,
+			Type:        "task",
+			EntityUUID:  task.UUID,
+			ProjectUUID: &task.Project.UUID,
+			CompanyUUID: &task.Project.CompanyUUID,
+			Events:      events,
+			Subject:     fmt.Sprintf("Задача %s «%s»", domain.NewTaskRef(task.Project.Name, task.ID), task.Name),
+			URL:         fmt.Sprintf("%s/task/%s", a.Options.URL_FRONTEND, task.UUID),
+			Urgent:      urgent,
+			DeliverAt:   deliverAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *App) DeliverNotificationsByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(deliveryInterval)
+				a.DeliverNotificationsByTimeout(ctx)
+			}
+		}()
+
+		ticker := time.NewTicker(deliveryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.DeliverNotifications(time.Now())
+			}
+		}
+	}()
+}
+
+// DeliverNotifications - отправляет уведомления из очереди, срок доставки которых наступил.
+func (a *App) DeliverNotifications(now time.Time) {
+	deliveries, err := a.NotificationsService.ClaimDueDeliveries(now, deliveryBatch)
+	if err != nil {
+		logrus.Error("claim deliveries error: ", err)
+		return
+	}
+
+	for _, d := range deliveries {
+		err = a.deliver(d)
+		if err == nil {
+			err = a.NotificationsService.MarkDeliverySent(d.UUID)
+			if err != nil {
+				logrus.Error("mark delivery sent error: ", err)
+			}
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"email":   d.UserEmail,
+			"channel": d.Channel,
+		}).Error("delivery error: ", err)
+
+		err = a.NotificationsService.MarkDeliveryFailed(d, err, now)
+		if err != nil {
+			logrus.Error("mark delivery failed error: ", err)
+		}
+	}
+}
+
+func (a *App) deliver(d domain.NotificationDelivery) error {
+	user, ok := a.DictionaryService.FindUser(d.UserEmail)
+	if !ok {
+		return fmt.Errorf("user not found: %s", d.UserEmail)
+	}
+
+	switch d.Channel {
+	case domain.ChannelEmail:
+		return a.sendTemplateEmail(d.UserEmail, a.replyAddress(d), domain.EmailTemplateNotification, a.emailScope(d.CompanyUUID), &emails.NotificationData{
+			Subject: d.Subject,
+			Name:    user.Name,
+			Text:    d.Text(),
+			URL:     d.URL,
+		})
+	case domain.ChannelSMS:
+		if user.Phone == 0 {
+			return errNoPhone
+		}
+
+		api, from := a.smsCreds(d.CompanyUUID)
+		s := sms.NewSms(strconv.Itoa(user.Phone), d.Text())
+		s.From = from
+
+		_, err := a.SMSService.SmsSend(api, s)
+
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", domain.ErrNotifyChannel, d.Channel)
+}
+
+// smsCreds - sms отправляются от имени компании, если у нее настроен sms.ru, иначе от имени сервиса.
+func (a *App) smsCreds(companyUUID *uuid.UUID) (api, from string) {
+	if companyUUID != nil {
+		so, err := a.CompanyService.GetSmsOptions(*companyUUID)
+		if err == nil && so.API != "" {
+			return so.API, so.From
+		}
+	}
+
+	return a.Options.SMS_API_ID, a.Options.SMS_FROM
+}
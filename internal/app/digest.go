@@ -0,0 +1,207 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const digestInterval = 5 * time.Minute
+
+var digestPeriodNames = map[string]string{
+	domain.DigestHourly: "за час",
+	domain.DigestDaily:  "за день",
+	domain.DigestWeekly: "за неделю",
+}
+
+func (a *App) SendDigestsByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(digestInterval)
+				a.SendDigestsByTimeout(ctx)
+			}
+		}()
+
+		ticker := time.NewTicker(digestInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.SendDigests(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// SendDigests - email-дайджест непрочитанных уведомлений пользователям, у которых наступил период рассылки.
+func (a *App) SendDigests(ctx context.Context, now time.Time) {
+	users := lo.Filter(a.DictionaryService.Users(), func(u dto.UserDTO, _ int) bool {
+		return a.NotificationsService.HasNotifications(u.Email)
+	})
+
+	prefs, err := a.ProfileService.UsersPreferences(lo.Map(users, func(u dto.UserDTO, _ int) uuid.UUID {
+		return u.UUID
+	}))
+	if err != nil {
+		logrus.Error("digest preferences error: ", err)
+		return
+	}
+
+	for _, user := range users {
+		p, ok := prefs[user.UUID]
+		if !ok {
+			continue
+		}
+
+		settings := lo.FromPtr(p.Notifications)
+		period := settings.DigestPeriod()
+		loc := p.Location(a.Options.TIME_ZONE)
+
+		key := domain.DigestPeriodKey(period, now, loc)
+		if key == "" {
+			continue
+		}
+
+		locked, err := a.NotificationsService.LockDigest(user.Email, key)
+		if err != nil {
+			logrus.Error("digest lock error: ", err)
+			continue
+		}
+
+		if !locked {
+			continue
+		}
+
+		sent, err := a.sendDigest(ctx, user, settings, period, loc, now)
+		if err != nil {
+			logrus.WithField("email", user.Email).Error("digest error: ", err)
+
+			// письмо не ушло - блокировка снимается, следующий тик повторит отправку за этот период
+			if !sent {
+				if err := a.NotificationsService.UnlockDigest(user.Email, key); err != nil {
+					logrus.WithField("email", user.Email).Error("digest unlock error: ", err)
+				}
+			}
+		}
+	}
+}
+
+// sendDigest - sent означает, что письмо отправлено, даже если после этого произошла ошибка.
+func (a *App) sendDigest(ctx context.Context, user dto.UserDTO, settings domain.NotificationSettings, period string, loc *time.Location, now time.Time) (sent bool, err error) {
+	since, found, err := a.NotificationsService.LastDigest(user.Email)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		since = domain.DigestSince(period, now)
+	}
+
+	states, err := a.NotificationsService.UnreadTaskStates(user.Email)
+	if err != nil {
+		return false, err
+	}
+
+	tasks, err := a.TaskService.GetTasksNames(ctx, lo.Keys(states))
+	if err != nil {
+		return false, err
+	}
+
+	data := emails.DigestData{
+		Name:           user.Name,
+		Period:         digestPeriodNames[period],
+		UnsubscribeURL: a.digestUnsubscribeURL(user.Email),
+	}
+
+	for _, task := range tasks {
+		state := states[task.UUID]
+		allowed := func(event string) bool {
+			return settings.Allowed(task.ProjectUUID, event, domain.ChannelEmail)
+		}
+
+		item := emails.DigestTask{
+			ID:   task.ID,
+			Name: task.Name,
+			URL:  fmt.Sprintf("%s/task/%s", a.Options.URL_FRONTEND, task.UUID),
+		}
+
+		for _, c := range state.NewComments {
+			if !c.CreatedAt.After(since) {
+				continue
+			}
+
+			_, mention := c.InPeople(user.UUID)
+			if !allowed(helpers.If(mention, domain.NotifyMentioned, domain.NotifyComment)) {
+				continue
+			}
+
+			author := ""
+			if c.CreatedBy != nil {
+				author = c.CreatedBy.Name + " " + c.CreatedBy.Lname
+			}
+
+			item.Comments = append(item.Comments, emails.NewDigestComment(author, c.CreatedAt.In(loc).Format("02.01 15:04"), mention, c.Comment))
+		}
+
+		if allowed(domain.NotifyFile) {
+			for _, f := range state.NewUploads {
+				if f.CreatedAt.After(since) {
+					item.Uploads = append(item.Uploads, f.Name)
+				}
+			}
+		}
+
+		if allowed(domain.NotifyReminder) {
+			for _, r := range state.NewReminders {
+				if !r.UpdatedAt.After(since) {
+					continue
+				}
+
+				text := helpers.If(r.Description != "", r.Description, r.Comment)
+				if r.DateTo != nil {
+					text += " (до " + r.DateTo.In(loc).Format("02.01.2006 15:04") + ")"
+				}
+
+				item.Reminders = append(item.Reminders, text)
+			}
+		}
+
+		if len(item.Comments)+len(item.Uploads)+len(item.Reminders) > 0 {
+			data.Tasks = append(data.Tasks, item)
+		}
+	}
+
+	if len(data.Tasks) == 0 {
+		return false, nil
+	}
+
+	err = a.SendTemplateEmail(user.Email, domain.EmailTemplateDigest, domain.EmailScope{}, &data)
+	if err != nil {
+		return false, err
+	}
+
+	return true, a.NotificationsService.StoreDigestTime(user.Email, now)
+}
+
+func (a *App) digestUnsubscribeURL(email string) string {
+	q := url.Values{}
+	q.Set("email", email)
+	q.Set("token", a.ProfileService.DigestUnsubscribeToken(email))
+
+	return a.Options.URL_BACKEND + "/profile/digest/unsubscribe?" + q.Encode()
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FailStaleFieldMigrationsByTimeout - миграции типа поля выполняются в горутине процесса;
+// после перезапуска их статус running больше никто не обновит.
+func (a *App) FailStaleFieldMigrationsByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Minute)
+				a.FailStaleFieldMigrationsByTimeout(ctx)
+			}
+		}()
+
+		for {
+			n, err := a.AgregateService.FailStaleFieldMigrations(time.Now())
+			if err != nil {
+				logrus.Error("stale field migrations error: ", err)
+			} else if n > 0 {
+				logrus.Warnf("stale field migrations marked as failed: %d", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Minute):
+			}
+		}
+	}()
+}
@@ -0,0 +1,244 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	inboundInterval = 30 * time.Second
+
+	// inboundMaxComment и inboundMaxDescription - длина комментария и описания задачи, см. domain.Comment и domain.Task
+	inboundMaxComment     = 5000
+	inboundMaxDescription = 5000
+)
+
+// ReceiveMails - ответы на уведомления по почте: SMTP сервер на INBOUND_SMTP_ADDR и каталог INBOUND_MAILDIR.
+func (a *App) ReceiveMails(ctx context.Context) {
+	if a.Options.INBOUND_SMTP_ADDR != "" {
+		a.listenInboundSMTP(ctx)
+	}
+
+	if a.Options.INBOUND_MAILDIR != "" {
+		a.ReceiveMaildirByTimeout(ctx)
+	}
+}
+
+func (a *App) listenInboundSMTP(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(inboundInterval)
+				a.listenInboundSMTP(ctx)
+			}
+		}()
+
+		server := &emails.InboundSMTP{
+			Addr:    a.Options.INBOUND_SMTP_ADDR,
+			Handler: a.ProcessInboundMail,
+		}
+
+		for ctx.Err() == nil {
+			err := server.ListenAndServe(ctx)
+			if err != nil {
+				logrus.Error("inbound smtp error: ", err)
+				time.Sleep(inboundInterval)
+			}
+		}
+	}()
+}
+
+func (a *App) ReceiveMaildirByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(inboundInterval)
+				a.ReceiveMaildirByTimeout(ctx)
+			}
+		}()
+
+		maildir := &emails.InboundMaildir{
+			Dir:     a.Options.INBOUND_MAILDIR,
+			Handler: a.ProcessInboundMail,
+		}
+
+		ticker := time.NewTicker(inboundInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, err := maildir.Process()
+				if err != nil {
+					logrus.Error("inbound maildir error: ", err)
+				}
+			}
+		}
+	}()
+}
+
+// ProcessInboundMail - входящее письмо: ответ на уведомление о задаче, продолжение переписки по заявке
+// или новая заявка на адрес проекта (см. processIntakeMail). Остальные письма отклоняются.
+func (a *App) ProcessInboundMail(raw []byte, rcpt []string) error {
+	m, err := emails.ParseInbound(raw)
+	if err != nil {
+		return err
+	}
+
+	m.Recipients = append(m.Recipients, rcpt...)
+
+	if taskUUID, ok := m.ReplyTask(a.Options.SOLT, time.Now()); ok {
+		return a.processReplyMail(m, taskUUID)
+	}
+
+	processed, err := a.processIntakeMail(m)
+	if err != nil || processed {
+		return err
+	}
+
+	if _, ok := a.DictionaryService.FindUser(m.From); !ok {
+		return fmt.Errorf("%w: неизвестный отправитель %s", emails.ErrInboundRejected, m.From)
+	}
+
+	return fmt.Errorf("%w: не найдена задача для ответа от %s", emails.ErrInboundRejected, m.From)
+}
+
+// processReplyMail - ответ на уведомление становится комментарием отправителя с вложениями.
+// Задача ищется по подписанному адресу для ответа (см. emails.ReplyAddress), подпись сверяется с отправителем:
+// письма незнакомых отправителей, с чужих адресов, не прошедшие SPF или DKIM и без доступа к задаче отклоняются.
+func (a *App) processReplyMail(m emails.InboundMail, taskUUID uuid.UUID) error {
+	err := m.Authenticated(a.Options.INBOUND_AUTHSERV_ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s от %s", emails.ErrInboundRejected, err, m.From)
+	}
+
+	user, ok := a.DictionaryService.FindUser(m.From)
+	if !ok {
+		return fmt.Errorf("%w: неизвестный отправитель %s", emails.ErrInboundRejected, m.From)
+	}
+
+	task, err := a.inboundTask(taskUUID)
+	if err != nil {
+		return err
+	}
+
+	// доступ мог быть отозван после отправки уведомления
+	err = a.GateService.TaskView(task, user.UUID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", emails.ErrInboundRejected, err)
+	}
+
+	_, err = a.commentFromMail(task, user.Email, user.UUID, m, "")
+
+	return err
+}
+
+// inboundTask - задача письма, удаленная задача - письмо отклоняется.
+func (a *App) inboundTask(taskUUID uuid.UUID) (domain.Task, error) {
+	task, err := a.TaskService.GetTask(context.Background(), taskUUID, []string{})
+
+	var notFoundErr dto.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return task, fmt.Errorf("%w: %s", emails.ErrInboundRejected, err)
+	}
+
+	return task, err
+}
+
+// commentFromMail - комментарий из текста письма без цитаты, вложения загружаются от имени uploader.
+// Непустой sender - письмо не от автора комментария, отправитель указывается в начале текста.
+func (a *App) commentFromMail(task domain.Task, author string, uploader uuid.UUID, m emails.InboundMail, sender string) (domain.Comment, error) {
+	text := m.Text
+	if len([]rune(strings.TrimSpace(text))) < 2 {
+		if len(m.Attachments) == 0 {
+			return domain.Comment{}, fmt.Errorf("%w: пустой ответ от %s", emails.ErrInboundRejected, m.From)
+		}
+
+		text = "Файлы из письма"
+	}
+
+	if sender != "" {
+		text = fmt.Sprintf("От: %s\n\n%s", sender, text)
+	}
+
+	if r := []rune(text); len(r) > inboundMaxComment {
+		text = string(r[:inboundMaxComment-1]) + "…"
+	}
+
+	cm, err := a.TaskService.CreateComment(context.Background(), task.UUID, *domain.NewComment(author, task.UUID, uuid.Nil, []string{}, text))
+	if err != nil {
+		return cm, err
+	}
+
+	// комментарий уже создан: ошибка вложения не должна приводить к повтору письма и дублю комментария
+	for _, att := range m.Attachments {
+		_, err = a.uploadInboundAttachment(att, func(name, path string) (s3.File, error) {
+			return a.S3PrivateService.UploadTaskCommentFile(task.FederationUUID, task.UUID, cm.UUID, name, path, uploader)
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"task": task.UUID,
+				"file": att.Name,
+			}).Error("inbound attachment error: ", err)
+		}
+	}
+
+	if len(m.Attachments) > 0 {
+		a.TaskService.ResetCache(task.UUID)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"task":    task.UUID,
+		"comment": cm.UUID,
+		"from":    m.From,
+	}).Info("comment from email")
+
+	return cm, nil
+}
+
+func (a *App) uploadInboundAttachment(att emails.InboundAttachment, upload func(name, path string) (s3.File, error)) (s3.File, error) {
+	f, err := os.CreateTemp("", "inbound-*"+helpers.FileExt(att.Name))
+	if err != nil {
+		return s3.File{}, err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(att.Data)
+	if err != nil {
+		f.Close()
+		return s3.File{}, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return s3.File{}, err
+	}
+
+	return upload(att.Name, f.Name())
+}
+
+// replyAddress - адрес для ответа на уведомление о задаче, если прием ответов включен.
+func (a *App) replyAddress(d domain.NotificationDelivery) string {
+	if a.Options.INBOUND_EMAIL == "" || d.Type != "task" {
+		return ""
+	}
+
+	return emails.ReplyAddress(a.Options.INBOUND_EMAIL, a.Options.SOLT, d.EntityUUID, d.UserEmail, time.Now().Add(emails.ReplyTTL))
+}
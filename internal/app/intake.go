@@ -0,0 +1,299 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/s3"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrIntakeDisabled = errors.New("прием почты не настроен")
+	ErrIntakeDomain   = errors.New("адрес для заявок должен быть в домене")
+	ErrIntakeInbox    = errors.New("адрес для ответов на уведомления нельзя использовать для заявок")
+)
+
+// IntakeProject - адрес для заявок проекта настраивают пользователи его федерации.
+func (a *App) IntakeProject(projectUUID, userUUID uuid.UUID) (*dto.ProjectDTO, error) {
+	project, ok := a.DictionaryService.FindProject(projectUUID)
+	if !ok || !a.DictionaryService.InFederation(project.FederationUUID, userUUID) {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	return project, nil
+}
+
+// SaveIntakeAddress - задачи из писем создаются от имени настроившего адрес, ответственный должен состоять в федерации.
+// Адрес должен быть в домене INBOUND_EMAIL: почту чужих доменов мы не принимаем, а их адреса нельзя занимать.
+func (a *App) SaveIntakeAddress(project *dto.ProjectDTO, intake domain.IntakeAddress) (domain.IntakeAddress, error) {
+	_, inboxHost, ok := strings.Cut(a.Options.INBOUND_EMAIL, "@")
+	if !ok {
+		return intake, ErrIntakeDisabled
+	}
+
+	email := strings.ToLower(strings.TrimSpace(intake.Email))
+	if _, host, _ := strings.Cut(email, "@"); !strings.EqualFold(host, inboxHost) {
+		return intake, fmt.Errorf("%w: %s", ErrIntakeDomain, inboxHost)
+	}
+
+	if strings.EqualFold(email, a.Options.INBOUND_EMAIL) {
+		return intake, ErrIntakeInbox
+	}
+
+	if intake.ResponsibleBy != "" {
+		user, ok := a.DictionaryService.FindUser(intake.ResponsibleBy)
+		if !ok || !a.DictionaryService.InFederation(project.FederationUUID, user.UUID) {
+			return intake, dto.NotFoundErr("ответственный не найден")
+		}
+	}
+
+	intake.ProjectUUID = project.UUID
+
+	return a.EmailService.SaveIntakeAddress(intake)
+}
+
+// processIntakeMail - письмо на адрес проекта для заявок становится задачей, письма той же переписки - комментариями.
+// false - письмо не относится к заявкам.
+func (a *App) processIntakeMail(m emails.InboundMail) (bool, error) {
+	thread, inThread, err := a.EmailService.FindIntakeThread(m)
+	if err != nil {
+		return true, err
+	}
+
+	intake, toIntake := domain.IntakeAddress{}, false
+	if !inThread {
+		intake, toIntake, err = a.EmailService.FindIntakeAddress(m)
+		if err != nil {
+			return true, err
+		}
+	}
+
+	if !inThread && !toIntake {
+		return false, nil
+	}
+
+	// повторная доставка того же письма MTA или после сбоя обработки maildir
+	done, err := a.EmailService.IsIntakeMessage(m.MessageID)
+	if err != nil || done {
+		return true, err
+	}
+
+	if m.AutoReply {
+		logrus.WithField("from", m.From).Info("intake auto reply skipped")
+		return true, nil
+	}
+
+	if err = helpers.ValidateEmail(m.From); err != nil || len(m.From) > 100 {
+		return true, fmt.Errorf("%w: некорректный отправитель %s", emails.ErrInboundRejected, m.From)
+	}
+
+	if inThread {
+		return true, a.intakeFollowUp(m, thread)
+	}
+
+	return true, a.intakeTask(m, intake)
+}
+
+// intakeFollowUp - письмо в переписке по заявке становится комментарием от имени автора задачи (настроившего адрес)
+// с отправителем в тексте: From письма не подтвержден, комментарий не должен выглядеть написанным пользователем CRM.
+func (a *App) intakeFollowUp(m emails.InboundMail, thread domain.IntakeMessage) error {
+	task, err := a.inboundTask(thread.TaskUUID)
+	if err != nil {
+		return err
+	}
+
+	author, ok := a.DictionaryService.FindUser(task.CreatedBy)
+	if !ok {
+		return fmt.Errorf("%w: автор задачи %s не найден", emails.ErrInboundRejected, task.CreatedBy)
+	}
+
+	_, err = a.commentFromMail(task, author.Email, author.UUID, m, intakeSender(m))
+	if err != nil {
+		return err
+	}
+
+	return a.EmailService.StoreIntakeMessage(domain.IntakeMessage{
+		MessageID: m.MessageID,
+		TaskUUID:  task.UUID,
+		AgentUUID: thread.AgentUUID,
+	})
+}
+
+// intakeTask - новая задача проекта: тема - название, текст - описание, вложения - файлы задачи.
+// Отправитель - агент компании с таким email, если его нет - агент создается.
+func (a *App) intakeTask(m emails.InboundMail, intake domain.IntakeAddress) error {
+	project, ok := a.DictionaryService.FindProject(intake.ProjectUUID)
+	if !ok {
+		return fmt.Errorf("%w: проект адреса %s не найден", emails.ErrInboundRejected, intake.Email)
+	}
+
+	agent, err := a.intakeAgent(project, intake, m)
+	if err != nil {
+		return err
+	}
+
+	responsible := intake.ResponsibleBy
+	if responsible == "" && project.ResponsibleBy != nil {
+		responsible = project.ResponsibleBy.Email
+	}
+
+	task, err := domain.NewTask(
+		domain.IntakeTaskName(m.Subject, m.From),
+		project.FederationUUID,
+		project.CompanyUUID,
+		project.UUID,
+		intake.CreatedBy,
+		a.intakeAgentFields(project.UUID, agent.UUID),
+		[]string{},
+
+		intakeDescription(m),
+		[]string{},
+		[]string{},
+		"",
+		responsible,
+
+		0,
+
+		nil,
+		"",
+		"",
+
+		map[uuid.UUID][]string{},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %s", emails.ErrInboundRejected, err)
+	}
+
+	// uuid задачи выводится из Message-ID: если письмо не удалось отметить обработанным,
+	// повторная доставка найдет созданную задачу и только отметит письмо
+	if m.MessageID != "" {
+		task.UUID = domain.IntakeTaskUUID(project.UUID, m.MessageID)
+
+		_, err = a.TaskService.GetTask(context.Background(), task.UUID, []string{})
+		if err == nil {
+			return a.storeIntakeTask(m, task.UUID, agent.UUID)
+		}
+
+		if !errors.As(err, &dto.NotFoundError{}) {
+			return err
+		}
+	}
+
+	_, err = a.TaskService.CreateTask(task)
+	if err != nil {
+		return err
+	}
+
+	err = a.storeIntakeTask(m, task.UUID, agent.UUID)
+	if err != nil {
+		return err
+	}
+
+	for _, att := range m.Attachments {
+		_, err = a.uploadInboundAttachment(att, func(name, path string) (s3.File, error) {
+			return a.S3PrivateService.UploadTaskFile(task.FederationUUID, task.UUID, name, path, intake.CreatedByUUID)
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"task": task.UUID,
+				"file": att.Name,
+			}).Error("intake attachment error: ", err)
+		}
+	}
+
+	if len(m.Attachments) > 0 {
+		a.TaskService.ResetCache(task.UUID)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"task":  task.UUID,
+		"agent": agent.UUID,
+		"from":  m.From,
+	}).Info("task from intake email")
+
+	return nil
+}
+
+func (a *App) storeIntakeTask(m emails.InboundMail, taskUUID, agentUUID uuid.UUID) error {
+	return a.EmailService.StoreIntakeMessage(domain.IntakeMessage{
+		MessageID: m.MessageID,
+		TaskUUID:  taskUUID,
+		AgentUUID: &agentUUID,
+	})
+}
+
+// intakeAgent - агент компании проекта по email отправителя, новый - с именем из письма.
+func (a *App) intakeAgent(project *dto.ProjectDTO, intake domain.IntakeAddress, m emails.InboundMail) (domain.Agent, error) {
+	ctx := context.Background()
+
+	agent, ok, err := a.AgentsService.FindByEmail(ctx, project.CompanyUUID, m.From)
+	if err != nil || ok {
+		return agent, err
+	}
+
+	name := strings.TrimSpace(m.FromName)
+	if len([]rune(name)) < 3 {
+		name = m.From
+	}
+
+	if r := []rune(name); len(r) > 100 {
+		name = string(r[:100])
+	}
+
+	dm := domain.NewAgent(project.FederationUUID, &project.CompanyUUID, domain.Me{
+		Email: intake.CreatedBy,
+		UUID:  intake.CreatedByUUID,
+	}, name, []domain.AgentContacts{{Type: "email", Val: m.From}})
+
+	err = a.AgentsService.Create(ctx, dm)
+
+	return *dm, err
+}
+
+// intakeAgentFields - агент в первом поле проекта, ссылающемся на агентов, если такое поле есть.
+func (a *App) intakeAgentFields(projectUUID, agentUUID uuid.UUID) map[string]interface{} {
+	fields, err := a.FederationService.GetProjectFields(projectUUID)
+	if err != nil {
+		logrus.WithField("project", projectUUID).Error("intake project fields error: ", err)
+		return map[string]interface{}{}
+	}
+
+	for _, f := range fields {
+		if f.DataType != domain.Relation || f.Relation == nil || f.Relation.Target != domain.RelationTargetAgent {
+			continue
+		}
+
+		return map[string]interface{}{
+			f.Hash: helpers.If[interface{}](f.Relation.Multiple, []interface{}{agentUUID.String()}, agentUUID.String()),
+		}
+	}
+
+	return map[string]interface{}{}
+}
+
+// intakeSender - отправитель письма с именем.
+func intakeSender(m emails.InboundMail) string {
+	if m.FromName != "" {
+		return fmt.Sprintf("%s <%s>", m.FromName, m.From)
+	}
+
+	return m.From
+}
+
+// intakeDescription - отправитель и текст письма целиком, в пределах длины описания задачи.
+func intakeDescription(m emails.InboundMail) string {
+	description := fmt.Sprintf("От: %s\n\n%s", intakeSender(m), m.Body)
+	if r := []rune(description); len(r) > inboundMaxDescription {
+		description = string(r[:inboundMaxDescription-1]) + "…"
+	}
+
+	return description
+}
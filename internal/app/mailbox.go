@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// SyncMailboxes - почтовые ящики пользователей (EMAILS_INTEGRATION_ENABLED): расписание загрузки почты
+// и обработка задач очереди (загрузка и отправка писем).
+func (a *App) SyncMailboxes(ctx context.Context) {
+	if !a.MailboxService.Enabled() {
+		return
+	}
+
+	a.ScheduleMailboxesByTimeout(ctx)
+	a.ConsumeMailboxJobs(ctx)
+}
+
+func (a *App) ScheduleMailboxesByTimeout(ctx context.Context) {
+	interval := time.Second * time.Duration(max(a.Options.MAILBOX_FETCH_INTERVAL, 30))
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(interval)
+				a.ScheduleMailboxesByTimeout(ctx)
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, err := a.MailboxService.ScheduleFetch(ctx, interval)
+				if err != nil {
+					logrus.Error("mailbox schedule error: ", err)
+				}
+			}
+		}
+	}()
+}
+
+func (a *App) ConsumeMailboxJobs(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(inboundInterval)
+				a.ConsumeMailboxJobs(ctx)
+			}
+		}()
+
+		for ctx.Err() == nil {
+			err := a.MailboxService.Consume(ctx, a.linkMailboxEmail)
+			if err != nil && ctx.Err() == nil {
+				logrus.Error("mailbox jobs error: ", err)
+				time.Sleep(inboundInterval)
+			}
+		}
+	}()
+}
+
+// linkMailboxEmail - агенты федераций пользователя с адресами переписки, задачи - по уведомлениям,
+// отправленным пользователю (см. emails.ReplyAddress), и по переписке заявок на адрес проекта.
+func (a *App) linkMailboxEmail(mb domain.Mailbox, m emails.InboundMail) ([]uuid.UUID, []uuid.UUID) {
+	ctx := context.Background()
+
+	agents, tasks := []uuid.UUID{}, []uuid.UUID{}
+
+	addresses := lo.Without(lo.Uniq(append(m.RecipientAddresses(), strings.ToLower(m.From))), mb.Email, "")
+	federations := a.DictionaryService.UserFederations(mb.UserUUID)
+
+	found, err := a.AgentsService.FindByEmails(ctx, federations, addresses)
+	if err != nil {
+		logrus.WithField("mailbox", mb.UUID).Error("mailbox agents error: ", err)
+	}
+
+	for _, agent := range found {
+		agents = append(agents, agent.UUID)
+	}
+
+	recipients := []string{mb.Email}
+	if user, ok := a.DictionaryService.FindUserByUUID(mb.UserUUID); ok {
+		recipients = append(recipients, user.Email)
+	}
+
+	for _, email := range lo.Uniq(recipients) {
+		if taskUUID, ok := m.ReplyTaskFor(a.Options.SOLT, email); ok {
+			tasks = append(tasks, taskUUID)
+		}
+	}
+
+	thread, ok, err := a.EmailService.FindIntakeThread(m)
+	if err != nil {
+		logrus.WithField("mailbox", mb.UUID).Error("mailbox intake thread error: ", err)
+	}
+
+	if ok {
+		task, err := a.TaskService.GetTask(ctx, thread.TaskUUID, []string{})
+		if err == nil && a.DictionaryService.InFederation(task.FederationUUID, mb.UserUUID) {
+			tasks = append(tasks, task.UUID)
+
+			if thread.AgentUUID != nil {
+				agents = append(agents, *thread.AgentUUID)
+			}
+		}
+	}
+
+	return lo.Uniq(agents), lo.Uniq(tasks)
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	mailSendInterval = 5 * time.Second
+	mailSendBatch    = 10
+)
+
+// SendMailsByTimeout - SMTP_WORKERS воркеров отправляют письма из очереди, письма разбираются без пересечений.
+func (a *App) SendMailsByTimeout(ctx context.Context) {
+	for i := 0; i < max(a.Options.SMTP_WORKERS, 1); i++ {
+		a.sendMailsWorker(ctx)
+	}
+}
+
+func (a *App) sendMailsWorker(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(mailSendInterval)
+				a.sendMailsWorker(ctx)
+			}
+		}()
+
+		ticker := time.NewTicker(mailSendInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// полная пачка - в очереди есть еще письма, следующая берется сразу
+				for n := mailSendBatch; n == mailSendBatch; {
+					n = a.SendMails(time.Now())
+				}
+			}
+		}
+	}()
+}
+
+// SendMails - отправляет пачку писем из очереди, возвращает сколько писем было взято.
+func (a *App) SendMails(now time.Time) int {
+	mails, err := a.EmailService.ClaimDueMails(now, mailSendBatch)
+	if err != nil {
+		logrus.Error("claim mails error: ", err)
+		return 0
+	}
+
+	for _, m := range mails {
+		err = a.EmailService.DeliverMail(m)
+		if err == nil {
+			continue
+		}
+
+		logrus.WithField("mail", m.UUID).Error("mail send error: ", err)
+
+		err = a.EmailService.MarkMailFailed(m, err, now)
+		if err != nil {
+			logrus.Error("mark mail failed error: ", err)
+		}
+	}
+
+	return len(mails)
+}
+
+// SendTemplateEmail - ставит в очередь письмо по шаблону типа на языке получателя.
+func (a *App) SendTemplateEmail(email, templateType string, scope domain.EmailScope, data emails.TemplateData) error {
+	return a.sendTemplateEmail(email, "", templateType, scope, data)
+}
+
+func (a *App) sendTemplateEmail(email, replyTo, templateType string, scope domain.EmailScope, data emails.TemplateData) error {
+	msg, err := a.EmailService.NewMessage(templateType, scope, a.emailLang(email), data)
+	if err != nil {
+		return err
+	}
+
+	return a.EmailService.SendEmailReplyTo([]string{email}, replyTo, msg)
+}
+
+// emailLang - язык писем пользователя из настроек профиля.
+func (a *App) emailLang(email string) string {
+	user, ok := a.DictionaryService.FindUser(email)
+	if !ok {
+		return domain.LangDefault
+	}
+
+	prefs, err := a.ProfileService.UsersPreferences([]uuid.UUID{user.UUID})
+	if err != nil {
+		logrus.Error("email language error: ", err)
+		return domain.LangDefault
+	}
+
+	return lo.FromPtrOr(prefs[user.UUID].Language, domain.LangDefault)
+}
+
+// emailScope - шаблоны и оформление писем компании и ее федерации.
+func (a *App) emailScope(companyUUID *uuid.UUID) domain.EmailScope {
+	if companyUUID == nil {
+		return domain.EmailScope{}
+	}
+
+	company, ok := a.DictionaryService.FindCompany(*companyUUID)
+	if !ok {
+		return domain.EmailScope{}
+	}
+
+	return domain.EmailScope{FederationUUID: company.FederationUUID, CompanyUUID: company.UUID}
+}
+
+// IsAdmin - пользователь из ADMIN_EMAILS.
+func (a *App) IsAdmin(email string) bool {
+	return lo.ContainsBy(a.Options.ADMIN_EMAILS, func(admin string) bool {
+		return strings.EqualFold(strings.TrimSpace(admin), email)
+	})
+}
+
+// EmailScope - область шаблонов писем федерации (и ее компании), изменять которую может создатель федерации или администратор.
+func (a *App) EmailScope(federationUUID uuid.UUID, companyUUID *uuid.UUID, userUUID uuid.UUID, email string) (domain.EmailScope, error) {
+	federation, ok := a.DictionaryService.FindFederation(federationUUID)
+	if !ok || (lo.FromPtr(federation.CreatedByUUID) != userUUID && !a.IsAdmin(email)) {
+		return domain.EmailScope{}, dto.NotFoundErr("федерация не найдена")
+	}
+
+	scope := domain.EmailScope{FederationUUID: federation.UUID}
+
+	if companyUUID != nil {
+		company, ok := a.DictionaryService.FindCompany(*companyUUID)
+		if !ok || company.FederationUUID != federation.UUID {
+			return scope, dto.NotFoundErr("компания не найдена")
+		}
+
+		scope.CompanyUUID = company.UUID
+	}
+
+	return scope, nil
+}
@@ -23,14 +23,17 @@ import (
 	"github.com/krisch/crm-backend/internal/legalentities"
 
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/mailbox"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
 	"github.com/krisch/crm-backend/internal/task"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -64,6 +67,8 @@ type App struct {
 	AgentsService        *agents.Service
 	PermissionsService   *permissions.Service
 	LegalEntitiesService *legalentities.Service
+	RealtimeService      *realtime.Service
+	MailboxService       *mailbox.Service
 
 	MetricsCounters *helpers.MetricsCounters
 }
@@ -155,26 +160,85 @@ func (a *App) Work(ctx context.Context, rds *redis.RDS) {
 	}()
 
 	a.RedisSubscribe(ctx, rds, "update")
+	a.RealtimeService.Listen(ctx)
 	a.SyncDictionariesByTimeout()
 	a.SyncDictionariesByHook()
+	a.SendDigestsByTimeout(ctx)
+	a.RebuildNotificationsCacheByTimeout(ctx)
+	a.DeliverNotificationsByTimeout(ctx)
+	a.DispatchRemindersByTimeout(ctx)
+	a.FailStaleFieldMigrationsByTimeout(ctx)
+	a.SendMailsByTimeout(ctx)
+	a.ReceiveMails(ctx)
+	a.SyncMailboxes(ctx)
+}
+
+// RebuildNotificationsCacheByTimeout - если redis был очищен, непрочитанные уведомления восстанавливаются из Postgres.
+func (a *App) RebuildNotificationsCacheByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Minute)
+				a.RebuildNotificationsCacheByTimeout(ctx)
+			}
+		}()
+
+		for {
+			err := a.NotificationsService.RebuildCache(ctx, false)
+			if err != nil {
+				logrus.Error("notifications cache rebuild error: ", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Minute):
+			}
+		}
+	}()
 }
 
 func (a *App) Subscribe(_ context.Context) {
 	a.TaskService.OnTaskUpdatedOrCreated(func(uid uuid.UUID, people []string) error {
 		logrus.Info("task updated or created")
 		err := a.NotificationsService.CreateTaskState(uid, people)
+
+		if err := a.RemindersService.SyncDeadline(uid); err != nil {
+			logrus.Error("sync reminders deadline error: ", err)
+		}
+
+		a.publishTaskUpdated(uid, people)
+		a.PublishNotificationsCount(people...)
+
 		return err
 	})
 
 	a.TaskService.OnOpenTask(func(uid uuid.UUID, email string) error {
 		logrus.Info("task was open")
 		err := a.NotificationsService.RemoveNotification(email, "task", uid)
+
+		a.PublishNotificationsCount(email)
+
 		return err
 	})
 
 	a.RemindersService.OnReminderWasUpdatedOrCreated(func(uid, taskUUID uuid.UUID, people []string) error {
 		logrus.Info("reminder updated or created: ", uid)
 		err := a.NotificationsService.CreateTaskState(taskUUID, people)
+
+		a.publish(realtime.EventReminderUpdated, map[string]interface{}{
+			"reminder_uuid": uid,
+			"task_uuid":     taskUUID,
+		}, append([]string{realtime.TaskTopic(taskUUID)}, lo.Map(people, func(email string, _ int) string {
+			return realtime.UserTopic(email)
+		})...)...)
+		a.PublishNotificationsCount(people...)
+
 		return err
 	})
+
+	a.NotificationsService.OnTaskEvents(a.EnqueueTaskDeliveries)
+
+	a.subscribeRealtime()
 }
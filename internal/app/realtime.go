@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/internal/realtime"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func (a *App) publish(kind string, data interface{}, topics ...string) {
+	e, err := realtime.NewEvent(kind, data, topics...)
+	if err == nil {
+		err = a.RealtimeService.Publish(context.Background(), e)
+	}
+
+	if err != nil {
+		logrus.WithField("event", kind).Error("realtime publish error: ", err)
+	}
+}
+
+func taskTopics(task domain.Task, people []string) []string {
+	topics := []string{realtime.TaskTopic(task.UUID), realtime.ProjectTopic(task.ProjectUUID)}
+
+	return append(topics, lo.Map(people, func(email string, _ int) string {
+		return realtime.UserTopic(email)
+	})...)
+}
+
+func (a *App) publishTaskUpdated(uid uuid.UUID, people []string) {
+	task, err := a.TaskService.GetTask(context.Background(), uid, []string{})
+	if err != nil {
+		logrus.Error("realtime task not found: ", err)
+		return
+	}
+
+	a.publish(realtime.EventTaskUpdated, map[string]interface{}{
+		"task_uuid":    task.UUID,
+		"project_uuid": task.ProjectUUID,
+		"deleted":      task.DeletedAt != nil,
+	}, taskTopics(task, people)...)
+}
+
+// PublishNotificationsCount - новое число уведомлений пользователя, чтобы клиенты не опрашивали GetProfileNotifications.
+func (a *App) PublishNotificationsCount(emails ...string) {
+	for _, email := range lo.Uniq(emails) {
+		count, err := a.NotificationsService.Count(context.Background(), email)
+		if err != nil {
+			logrus.Error("realtime notifications count error: ", err)
+			continue
+		}
+
+		a.publish(realtime.EventNotifications, map[string]interface{}{
+			"count": count,
+		}, realtime.UserTopic(email))
+	}
+}
+
+func (a *App) subscribeRealtime() {
+	a.TaskService.OnCommentCreated(func(task domain.Task, cm domain.Comment) error {
+		a.publish(realtime.EventCommentCreated, map[string]interface{}{
+			"task_uuid":    task.UUID,
+			"project_uuid": task.ProjectUUID,
+			"comment_uuid": cm.UUID,
+			"reply_uuid":   cm.ReplyUUID,
+			"created_by":   cm.CreatedBy,
+		}, taskTopics(task, lo.Keys(cm.People))...)
+
+		return nil
+	})
+
+	a.TaskService.OnStatusChanged(func(task domain.Task) error {
+		a.publish(realtime.EventTaskStatus, map[string]interface{}{
+			"task_uuid":    task.UUID,
+			"project_uuid": task.ProjectUUID,
+			"status":       task.Status,
+			"prev_status":  task.Dirty["status"],
+		}, taskTopics(task, task.People)...)
+
+		return nil
+	})
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	reminderDispatchInterval = 15 * time.Second
+	reminderDispatchBatch    = 100
+)
+
+var errReminderTaskNotFound = errors.New("задача напоминания не найдена")
+
+func (a *App) DispatchRemindersByTimeout(ctx context.Context) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(reminderDispatchInterval)
+				a.DispatchRemindersByTimeout(ctx)
+			}
+		}()
+
+		ticker := time.NewTicker(reminderDispatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.DispatchReminders(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// DispatchReminders - отправляет наступившие напоминания. Ошибки повторяются, после исчерпания попыток
+// срабатывание остается в списке недоставленных.
+func (a *App) DispatchReminders(ctx context.Context, now time.Time) {
+	dispatches, err := a.RemindersService.ClaimDueDispatches(now, reminderDispatchBatch)
+	if err != nil {
+		logrus.Error("claim reminders error: ", err)
+		return
+	}
+
+	for _, d := range dispatches {
+		err = a.dispatchReminder(ctx, d)
+		if err == nil {
+			continue
+		}
+
+		logrus.WithField("reminder", d.ReminderUUID).Error("reminder dispatch error: ", err)
+
+		err = a.RemindersService.MarkDispatchFailed(d, err, now)
+		if err != nil {
+			logrus.Error("mark reminder dispatch failed error: ", err)
+		}
+	}
+}
+
+func (a *App) dispatchReminder(ctx context.Context, d domain.ReminderDispatch) error {
+	r, err := a.RemindersService.Get(d.ReminderUUID)
+	if err != nil {
+		return err
+	}
+
+	if r.UUID == uuid.Nil {
+		return a.RemindersService.CancelDispatch(d, "дело удалено")
+	}
+
+	if fireAt := r.FireAt(); fireAt == nil || !fireAt.Equal(d.FireAt) {
+		return a.RemindersService.CancelDispatch(d, "дата дела изменена")
+	}
+
+	tasks, err := a.TaskService.GetTasksNames(ctx, []uuid.UUID{r.TaskUUID})
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		return a.RemindersService.CancelDispatch(d, errReminderTaskNotFound.Error())
+	}
+
+	task := tasks[0]
+	recipient := lo.FromPtr(r.UserUUID)
+	if recipient == uuid.Nil {
+		recipient = r.CreatedByUUID
+	}
+
+	user, ok := a.DictionaryService.FindUserByUUID(recipient)
+	if !ok {
+		return fmt.Errorf("reminder user not found by uuid: %s", recipient)
+	}
+
+	var companyUUID *uuid.UUID
+
+	ref := fmt.Sprintf("#%d", task.ID)
+	if project, ok := a.DictionaryService.FindProject(task.ProjectUUID); ok {
+		companyUUID = &project.CompanyUUID
+		ref = domain.NewTaskRef(project.Name, task.ID).String()
+	}
+
+	var deliveries []domain.NotificationDelivery
+
+	for _, channel := range r.Channels() {
+		if channel == domain.ChannelApp {
+			continue
+		}
+
+		if !a.NotificationsService.Allowed(user.Email, task.ProjectUUID, channel, domain.NotifyReminder) {
+			continue
+		}
+
+		deliveries = append(deliveries, domain.NotificationDelivery{
+			UserEmail:   user.Email,
+			Channel:     channel,
+			Type:        "reminder",
+			EntityUUID:  r.UUID,
+			ProjectUUID: &task.ProjectUUID,
+			CompanyUUID: companyUUID,
+			Events:      []string{domain.NotifyReminder},
+			Subject:     fmt.Sprintf("Задача %s «%s», %s", ref, task.Name, r.Description),
+			URL:         fmt.Sprintf("%s/task/%s", a.Options.URL_FRONTEND, task.UUID),
+			// время напоминания выбрано пользователем, тихие часы не применяются
+			Urgent:    true,
+			DeliverAt: time.Now(),
+		})
+	}
+
+	prefs, err := a.ProfileService.UsersPreferences([]uuid.UUID{user.UUID})
+	if err != nil {
+		return err
+	}
+
+	return a.RemindersService.MarkSent(d, r, prefs[user.UUID].Location(a.Options.TIME_ZONE), time.Now(), func(tx *gorm.DB) error {
+		return a.NotificationsService.EnqueueDeliveries(tx, deliveries)
+	})
+}
@@ -464,7 +464,7 @@ func (a *App) SeedTasks(ctx context.Context, total int, projectUUID uuid.UUID, c
 				strings.Trim(helpers.FakeSentence(500), " "),
 			)
 
-			err := a.CommentService.CreateComment(ctx, *comment)
+			_, err := a.CommentService.CreateComment(ctx, *comment)
 			if err != nil {
 				logrus.Error(err)
 			}
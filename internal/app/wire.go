@@ -21,9 +21,11 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/mailbox"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
@@ -134,6 +136,11 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 		legalentities.NewRepository, //добавил
 		legalentities.NewService, //добавил
 
+		realtime.New,
+
+		mailbox.NewRepository,
+		mailbox.New,
+
 		NewApp,
 	)
 
@@ -167,6 +174,8 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
 	legalentitiesService *legalentities.Service, //здесь
+	realtimeService *realtime.Service,
+	mailboxService *mailbox.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -212,6 +221,8 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
 	w.LegalEntitiesService = legalentitiesService // добавил
+	w.RealtimeService = realtimeService
+	w.MailboxService = mailboxService
 
 	return w
 }
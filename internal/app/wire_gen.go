@@ -23,9 +23,11 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	"github.com/krisch/crm-backend/internal/logs"
+	"github.com/krisch/crm-backend/internal/mailbox"
 	"github.com/krisch/crm-backend/internal/notifications"
 	"github.com/krisch/crm-backend/internal/permissions"
 	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/krisch/crm-backend/internal/realtime"
 	"github.com/krisch/crm-backend/internal/reminders"
 	"github.com/krisch/crm-backend/internal/s3"
 	"github.com/krisch/crm-backend/internal/sms"
@@ -47,7 +49,7 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 		return nil, err
 	}
 	service := health.NewHealthService(gdb, rds)
-	repository := notifications.NewRepository(rds)
+	repository := notifications.NewRepository(gdb, rds)
 	metricsCounters := helpers.NewMetricsCounters()
 	dictionaryRepository := dictionary.NewRepository(gdb, rds, metricsCounters)
 	conf := s3Conf(configsConfigs)
@@ -72,8 +74,8 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	catalogsRepository := catalogs.NewRepository(gdb, rds, metricsCounters)
 	catalogsService := catalogs.New(catalogsRepository, dictionaryService)
 	federationService := federation.NewUserService(federationRepository, dictionaryService, catalogsService)
-	aggregatesService := aggregates.New(dictionaryService, profileService, taskService, commentsService, servicePrivate, remindersService, federationService)
-	notificationsService := notifications.New(repository, aggregatesService, dictionaryService)
+	aggregatesService := aggregates.New(dictionaryService, profileService, taskService, commentsService, servicePrivate, remindersService, federationService, catalogsService)
+	notificationsService := notifications.New(repository, aggregatesService, dictionaryService, profileService)
 	iLogRepository := logs.NewLogRepository(gdb)
 	iLogService := logs.NewLogService(iLogRepository)
 	emailRepository := emails.NewRepository(gdb)
@@ -91,7 +93,13 @@ func InitApp(name string, creds postgres.Creds, metrics bool, rc redis.Creds) (*
 	agentsService := agents.New(agentsRepository)
 	permissionsRepository := permissions.NewRepository(gdb, rds)
 	permissionsService := permissions.New(permissionsRepository)
-	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService)
+	realtimeService := realtime.New(rds)
+	mailboxRepository := mailbox.NewRepository(gdb)
+	mailboxService, err := mailbox.New(configsConfigs, mailboxRepository)
+	if err != nil {
+		return nil, err
+	}
+	app := NewApp(name, configsConfigs, gdb, rds, service, notificationsService, iLogService, profileService, iEmailsService, federationService, taskService, commentsService, dictionaryService, s3Service, servicePrivate, gatesService, cacheService, metricsCounters, remindersService, catalogsService, aggregatesService, companyService, smsService, agentsService, permissionsService, realtimeService, mailboxService)
 	return app, nil
 }
 
@@ -148,7 +156,8 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	smsService *sms.Service,
 	agentsService *agents.Service,
 	permissionsService *permissions.Service,
-
+	realtimeService *realtime.Service,
+	mailboxService *mailbox.Service,
 ) *App {
 	w := &App{
 		Env:  conf.ENV,
@@ -191,6 +200,8 @@ func NewApp(name string, conf *configs.Configs, gdb *postgres.GDB, rds *redis.RD
 	w.SMSService = smsService
 	w.AgentsService = agentsService
 	w.PermissionsService = permissionsService
+	w.RealtimeService = realtimeService
+	w.MailboxService = mailboxService
 
 	return w
 }
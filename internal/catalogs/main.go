@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -283,3 +284,31 @@ func (s *Service) FilterCatalogFields(catalogData *domain.CatalogData) (err erro
 
 	return nil
 }
+
+func (s *Service) GetCatalogField(uid uuid.UUID) (df domain.CatalogFiled, err error) {
+	orm, err := s.repo.GetCatalogField(uid)
+	if err != nil {
+		return df, err
+	}
+
+	return domain.CatalogFiled{
+		UUID:            orm.UUID,
+		CatalogUUID:     orm.CatalogUUID,
+		Name:            orm.Name,
+		DataType:        domain.FieldDataType(orm.DataType),
+		DataCatalogUUID: orm.DataCatalogUUID,
+		Hash:            orm.Hash,
+	}, nil
+}
+
+func (s *Service) ChangeCatalogFieldType(pf domain.CatalogFiled, dataType domain.FieldDataType) error {
+	return s.repo.ChangeCatalogFieldType(pf.UUID, pf.CatalogUUID, dataType)
+}
+
+func (s *Service) GetFieldValues(catalogUUID uuid.UUID, hash string, after uuid.UUID, since *time.Time, limit int) ([]domain.FieldValue, error) {
+	return s.repo.GetFieldValues(catalogUUID, hash, after, since, limit)
+}
+
+func (s *Service) SetFieldValues(hash string, values []domain.FieldValue) error {
+	return s.repo.SetFieldValues(hash, values)
+}
@@ -2,10 +2,12 @@ package catalogs
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -498,3 +500,101 @@ func (r *Repository) GetSortFields() []string {
 
 	return allowSort
 }
+
+type fieldValueRow struct {
+	UUID  uuid.UUID
+	Value []byte
+}
+
+// GetFieldValues - значения поля hash в записях справочника, постранично по uuid (after).
+func (r *Repository) GetFieldValues(catalogUUID uuid.UUID, hash string, after uuid.UUID, since *time.Time, limit int) (items []domain.FieldValue, err error) {
+	defer r.storeTime("GetFieldValues", tm())
+
+	rows := []fieldValueRow{}
+	q := r.gorm.DB.
+		Model(&CatalogData{}).
+		Select("uuid, fields -> ? as value", hash).
+		Where("catalog_uuid = ?", catalogUUID).
+		Where("fields -> ? is not null", hash).
+		Where("uuid > ?", after)
+
+	if since != nil {
+		q = q.Where("updated_at >= ?", *since)
+	}
+
+	err = q.Order("uuid").Limit(limit).Scan(&rows).Error
+	if err != nil {
+		return items, err
+	}
+
+	for _, row := range rows {
+		var value interface{}
+		if err := json.Unmarshal(row.Value, &value); err != nil {
+			return items, err
+		}
+
+		items = append(items, domain.FieldValue{UUID: row.UUID, Value: value})
+	}
+
+	return items, nil
+}
+
+// SetFieldValues - запись сконвертированных значений поля, nil удаляет значение.
+func (r *Repository) SetFieldValues(hash string, values []domain.FieldValue) error {
+	defer r.storeTime("SetFieldValues", tm())
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range values {
+			if v.Value == nil {
+				if err := tx.Exec("update catalog_data set fields = fields - ? where uuid = ?", hash, v.UUID).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			b, err := json.Marshal(v.Value)
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec("update catalog_data set fields = jsonb_set(fields, ARRAY[?]::text[], ?::jsonb) where uuid = ?", hash, string(b), v.UUID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetCatalogField(uid uuid.UUID) (orm CatalogFields, err error) {
+	err = r.gorm.DB.Model(&orm).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("поле справочника не найдено")
+	}
+
+	return orm, err
+}
+
+func (r *Repository) ChangeCatalogFieldType(uid, catalogUUID uuid.UUID, dataType domain.FieldDataType) error {
+	err := r.gorm.DB.
+		Model(&CatalogFields{}).
+		Where("uuid = ?", uid).
+		Update("data_type", int(dataType)).
+		Update("updated_at", "now()").
+		Error
+	if err != nil {
+		return err
+	}
+
+	err = r.gorm.DB.Exec("update catalogs set updated_at = NOW() where uuid = ?", catalogUUID).Error
+	if err == nil {
+		r.PubUpdate()
+	}
+
+	return err
+}
@@ -2,6 +2,8 @@ package comments
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -90,9 +92,79 @@ func (s *Service) GetTaskComments(uid uuid.UUID, withFiles, withLikes bool) (dms
 		}
 	}
 
+	// Reactions
+	if withLikes {
+		for i, dm := range dms {
+			dms[i].UserReactions = s.userReactions(dm.Reactions)
+		}
+	}
+
 	return dms, err
 }
 
+// GetTaskCommentThreads - комментарии задачи с фильтром по треду.
+// threadUUID - корневой комментарий и все ответы в его треде,
+// collapsed - только корневые комментарии (ответы свернуты, есть RepliesCount).
+func (s *Service) GetTaskCommentThreads(uid uuid.UUID, threadUUID *uuid.UUID, collapsed bool) (dms []domain.Comment, err error) {
+	dms, err = s.GetTaskComments(uid, true, true)
+	if err != nil {
+		return dms, err
+	}
+
+	switch {
+	case threadUUID != nil:
+		dms = lo.Filter(dms, func(dm domain.Comment, _ int) bool {
+			return dm.RootUUID() == *threadUUID
+		})
+	case collapsed:
+		dms = lo.Filter(dms, func(dm domain.Comment, _ int) bool {
+			return dm.IsRoot()
+		})
+	}
+
+	return dms, nil
+}
+
+func (s *Service) userReactions(reactions domain.CommentReactions) []domain.CommentReaction {
+	usersDTO, _ := s.dict.FindUsers(reactions.Emails())
+
+	users := lo.KeyBy(usersDTO, func(u dto.UserDTO) string {
+		return u.Email
+	})
+
+	result := []domain.CommentReaction{}
+	for _, emoji := range reactions.Emojis() {
+		reaction := domain.CommentReaction{Emoji: emoji, Users: []domain.UserLike{}}
+
+		for email, at := range reactions[emoji] {
+			u, ok := users[email]
+			if !ok {
+				continue
+			}
+
+			reaction.Users = append(reaction.Users, domain.UserLike{
+				User: domain.User{
+					UUID:     u.UUID,
+					Email:    u.Email,
+					Name:     u.Name,
+					Lname:    u.Lname,
+					Pname:    u.Pname,
+					HasPhoto: u.HasPhoto,
+				},
+				CreatedAt: at,
+			})
+		}
+
+		sort.Slice(reaction.Users, func(i, j int) bool {
+			return reaction.Users[i].CreatedAt < reaction.Users[j].CreatedAt
+		})
+
+		result = append(result, reaction)
+	}
+
+	return result
+}
+
 func (s *Service) GetCommentsFiles(uid uuid.UUID) (files []domain.File, err error) {
 	files, err = s.storage.GetCommentFiles(uid, true)
 	if err != nil {
@@ -124,34 +196,90 @@ func (s *Service) CheckCommentText(uid uuid.UUID) (str string, err error) {
 	return s.repo.GetCommentText(uid)
 }
 
-func (s *Service) CreateComment(ctx context.Context, comment domain.Comment) (err error) {
+// CreateComment - сохраняет комментарий, People дополняется упоминаниями из текста.
+func (s *Service) CreateComment(ctx context.Context, comment domain.Comment) (domain.Comment, error) {
+	comment.Comment = domain.SanitizeMarkdown(comment.Comment)
+
 	foundUsers, _ := s.dict.FindUsers(lo.Keys(comment.People))
 
 	if len(foundUsers) != len(comment.People) {
-		return dto.NotFoundErr("один из пользователей не найден")
+		return comment, dto.NotFoundErr("один из пользователей не найден")
 	}
 
-	err = s.repo.CreateComment(ctx, comment)
+	if !comment.IsRoot() {
+		parent, err := s.repo.GetTaskComment(*comment.ReplyUUID)
+		if err != nil || parent.TaskUUID != comment.TaskUUID {
+			return comment, dto.NotFoundErr("комментарий для ответа не найден")
+		}
+
+		if parent.Depth+1 > domain.CommentMaxDepth {
+			return comment, fmt.Errorf("вложенность ответов не может быть больше %d", domain.CommentMaxDepth)
+		}
+
+		threadUUID := parent.RootUUID()
+		comment.ThreadUUID = &threadUUID
+		comment.Depth = parent.Depth + 1
+	}
+
+	err := s.resolveMentions(&comment, nil)
 	if err != nil {
-		return err
+		return comment, err
 	}
 
-	return err
+	err = s.repo.CreateComment(ctx, comment)
+
+	return comment, err
 }
 
-func (s *Service) UpdateComment(ctx context.Context, comment domain.Comment) (err error) {
+// UpdateComment - обновляет комментарий, у ранее упомянутых пользователей сохраняется время упоминания.
+func (s *Service) UpdateComment(ctx context.Context, comment domain.Comment) (domain.Comment, error) {
+	comment.Comment = domain.SanitizeMarkdown(comment.Comment)
+
 	foundUsers, _ := s.dict.FindUsers(lo.Keys(comment.People))
 
 	if len(foundUsers) != len(comment.People) {
-		return dto.NotFoundErr("один из пользователей не найден")
+		return comment, dto.NotFoundErr("один из пользователей не найден")
 	}
 
-	err = s.repo.UpdateComment(ctx, comment)
+	// ответ нельзя перенести в другой тред
+	current, err := s.repo.GetComment(comment.UUID)
 	if err != nil {
-		return err
+		return comment, err
 	}
 
-	return err
+	err = s.checkEditWindow(current)
+	if err != nil {
+		return comment, err
+	}
+
+	// CreatedBy приходит от редактирующего пользователя, автор и дата публикации не меняются
+	editor := comment.CreatedBy
+	now := time.Now()
+
+	comment.ReplyUUID = current.ReplyUUID
+	comment.CreatedBy = current.CreatedBy
+	comment.CreatedAt = current.CreatedAt
+	comment.EditedAt = current.EditedAt
+
+	var edit *domain.CommentVersion
+	if comment.Comment != current.Comment {
+		edit = &domain.CommentVersion{
+			Comment:   comment.Comment,
+			EditedBy:  editor,
+			CreatedAt: now,
+		}
+
+		comment.EditedAt = &now
+	}
+
+	err = s.resolveMentions(&comment, current.People)
+	if err != nil {
+		return comment, err
+	}
+
+	err = s.repo.UpdateComment(ctx, comment, edit)
+
+	return comment, err
 }
 
 func (s *Service) GetComment(_ context.Context, commentUUID uuid.UUID) (dt domain.Comment, err error) {
@@ -192,6 +320,23 @@ func (s *Service) LikeComment(_ context.Context, commentUUID uuid.UUID, userEmai
 	return dtos, liked, err
 }
 
+// ReactComment - ставит или снимает реакцию emoji пользователя на комментарий.
+func (s *Service) ReactComment(_ context.Context, commentUUID uuid.UUID, userEmail, emoji string) (reactions []domain.CommentReaction, reacted bool, err error) {
+	if err := domain.ValidateReaction(emoji); err != nil {
+		return reactions, reacted, err
+	}
+
+	stored, err := s.repo.ToggleCommentReaction(commentUUID, emoji, userEmail, time.Now().UnixMicro())
+	if err != nil {
+		return reactions, reacted, err
+	}
+
+	current := domain.CommentReactions(stored)
+	_, reacted = current[emoji][userEmail]
+
+	return s.userReactions(current), reacted, nil
+}
+
 func (s *Service) PinComment(_ context.Context, commentUUID uuid.UUID) (err error) {
 	err = s.repo.PatchCommentPin(commentUUID)
 
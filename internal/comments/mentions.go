@@ -0,0 +1,86 @@
+package comments
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// resolveMentions - добавляет в People пользователей, упомянутых в тексте:
+// @email и @имя участников федерации задачи и @группа компании (все участники).
+// previous - People до редактирования, чтобы не обновлять время старых упоминаний.
+func (s *Service) resolveMentions(comment *domain.Comment, previous map[string]int64) error {
+	if comment.People == nil {
+		comment.People = make(map[string]int64)
+	}
+
+	now := time.Now().UnixMicro()
+	add := func(email string) {
+		if email == "" || email == comment.CreatedBy {
+			return
+		}
+
+		if _, ok := comment.People[email]; !ok {
+			comment.People[email] = now
+		}
+	}
+
+	mentions := domain.ParseMentions(comment.Comment)
+	if len(mentions) > 0 {
+		scope, err := s.repo.GetTaskScope(comment.TaskUUID)
+		if err != nil {
+			return err
+		}
+
+		for _, mention := range mentions {
+			if domain.IsMentionEmail(mention) {
+				if user, ok := s.dict.FindUser(mention); ok && s.dict.InFederation(scope.FederationUUID, user.UUID) {
+					add(user.Email)
+				}
+				continue
+			}
+
+			if group, ok := s.dict.FindCompanyGroup(scope.CompanyUUID, mention); ok {
+				for _, uid := range group.UserUUIDS {
+					userUUID, err := uuid.Parse(uid)
+					if err != nil {
+						continue
+					}
+
+					if user, ok := s.dict.FindUserByUUID(userUUID); ok {
+						add(user.Email)
+					}
+				}
+				continue
+			}
+
+			if user, ok := s.dict.FindUserByName(scope.FederationUUID, mention); ok {
+				add(user.Email)
+			}
+		}
+	}
+
+	for email := range comment.People {
+		if at, ok := previous[email]; ok {
+			comment.People[email] = at
+		}
+	}
+
+	return nil
+}
+
+// GetUserMentions - комментарии во всех задачах, где упомянут пользователь, новые сверху.
+func (s *Service) GetUserMentions(email string, offset, limit int) (dms []domain.CommentMention, err error) {
+	dms, err = s.repo.GetUserMentions(email, offset, limit)
+	if err != nil {
+		return dms, err
+	}
+
+	for i, dm := range dms {
+		dms[i].MentionedAt = dm.Comment.People[email]
+		dms[i].Comment.UserReactions = s.userReactions(dm.Comment.Reactions)
+	}
+
+	return dms, nil
+}
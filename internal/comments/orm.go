@@ -22,10 +22,28 @@ type Comment struct {
 	ReplyUUID    *uuid.UUID `gorm:"type:uuid;"`
 	ReplyComment *string    `gorm:"->;type:varchar(500);omitempty"`
 
+	ThreadUUID   *uuid.UUID `gorm:"type:uuid;"`
+	Depth        int        `gorm:"type:int;default:0;not null;"`
+	RepliesCount int        `gorm:"->;type:int;"`
+
 	Likes  Persons `gorm:"type:jsonb;default:'{}';not null;"`
 	People Persons `gorm:"type:text[];default:'{}';not null;"`
 
+	Reactions Reactions `gorm:"type:jsonb;default:'{}';not null;"`
+
 	Pin bool `gorm:"type:boolean;default:false;not null;"`
+
+	EditedAt *time.Time `gorm:"type:timestamptz;"`
+}
+
+type CommentVersion struct {
+	UUID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null;primary_key:true"`
+	CommentUUID uuid.UUID `gorm:"type:uuid;not null"`
+	TaskUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	Version     int       `gorm:"type:int;not null"`
+	Comment     string    `gorm:"type:text;default:'';not null"`
+	EditedBy    string    `gorm:"type:varchar(100);default:'';not null;"`
+	CreatedAt   time.Time `gorm:"type:timestamptz;default:now();not null;"`
 }
 
 // JSONB Interface for JSONB Field of yourTableName Table.
@@ -44,3 +62,17 @@ func (a *Persons) Scan(value interface{}) error {
 	}
 	return json.Unmarshal(b, &a)
 }
+
+type Reactions map[string]map[string]int64
+
+func (a Reactions) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+func (a *Reactions) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, &a)
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -38,6 +40,8 @@ func tm() *helpers.Time {
 	return helpers.NewTime()
 }
 
+const repliesCountSQL = "(select count(*) from comments r where r.task_uuid = comments.task_uuid and r.thread_uuid = comments.uuid and r.deleted_at is null) as replies_count"
+
 func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (err error) {
 	defer r.storeTime("CreateComment", tm())
 
@@ -45,9 +49,11 @@ func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (er
 		orm := &Comment{
 			UUID: cmnt.UUID,
 
-			ReplyUUID: cmnt.ReplyUUID,
-			TaskUUID:  cmnt.TaskUUID,
-			Comment:   cmnt.Comment,
+			ReplyUUID:  cmnt.ReplyUUID,
+			ThreadUUID: cmnt.ThreadUUID,
+			Depth:      cmnt.Depth,
+			TaskUUID:   cmnt.TaskUUID,
+			Comment:    cmnt.Comment,
 
 			CreatedBy: cmnt.CreatedBy,
 
@@ -56,6 +62,8 @@ func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (er
 			People: cmnt.People,
 
 			Likes: Persons{},
+
+			Reactions: Reactions{},
 		}
 
 		err := tx.Create(&orm).Error
@@ -75,16 +83,45 @@ func (r *Repository) CreateComment(ctx context.Context, cmnt domain.Comment) (er
 	return err
 }
 
-func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (err error) {
+// UpdateComment - edit (nil, если текст не менялся) сохраняется версией в той же транзакции.
+// Строка комментария блокируется, чтобы параллельные правки получили разные номера версий.
+func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment, edit *domain.CommentVersion) (err error) {
 	defer r.storeTime("UpdateComment", tm())
 
 	err = r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		versions := []domain.CommentVersion{}
+		if edit != nil {
+			current := Comment{}
+			err := tx.
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("uuid = ?", cmnt.UUID).
+				First(&current).
+				Error
+			if err != nil {
+				return err
+			}
+
+			last := 0
+			err = tx.
+				Model(&CommentVersion{}).
+				Select("coalesce(max(version), 0)").
+				Where("comment_uuid = ?", cmnt.UUID).
+				Scan(&last).
+				Error
+			if err != nil {
+				return err
+			}
+
+			versions = newVersions(current, last, *edit)
+		}
+
 		orm := Comment{
 			UUID:      cmnt.UUID,
 			ReplyUUID: cmnt.ReplyUUID,
 			Comment:   cmnt.Comment,
 			People:    cmnt.People,
 			Likes:     cmnt.Likes,
+			EditedAt:  cmnt.EditedAt,
 		}
 
 		err := tx.
@@ -95,6 +132,20 @@ func (r *Repository) UpdateComment(ctx context.Context, cmnt domain.Comment) (er
 			return err
 		}
 
+		for _, v := range versions {
+			err = tx.Create(&CommentVersion{
+				CommentUUID: cmnt.UUID,
+				TaskUUID:    cmnt.TaskUUID,
+				Version:     v.Version,
+				Comment:     v.Comment,
+				EditedBy:    v.EditedBy,
+				CreatedAt:   v.CreatedAt,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
 		err = tx.Exec("update tasks set updated_at = now() where uuid = ?", cmnt.TaskUUID).Error
 
 		return err
@@ -153,7 +204,7 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 	orm := []Comment{}
 	q := r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.thread_uuid, comments.depth, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.reactions, comments.pin, comments.edited_at, c.comment as reply_comment, "+repliesCountSQL).
 		Where("comments.task_uuid = ?", uid).
 		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
@@ -173,12 +224,17 @@ func (r *Repository) GetTaskComments(uid uuid.UUID, commentUID *uuid.UUID) (dms
 			CreatedBy:    o.CreatedBy,
 			ReplyUUID:    o.ReplyUUID,
 			ReplyComment: o.ReplyComment,
+			ThreadUUID:   o.ThreadUUID,
+			Depth:        o.Depth,
+			RepliesCount: o.RepliesCount,
 			TaskUUID:     o.TaskUUID,
 			People:       o.People,
 			CreatedAt:    o.CreatedAt,
 			UpdatedAt:    o.UpdatedAt,
 			Likes:        o.Likes,
+			Reactions:    domain.CommentReactions(o.Reactions),
 			Pin:          o.Pin,
+			EditedAt:     o.EditedAt,
 		})
 	}
 
@@ -191,7 +247,7 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 	orm := Comment{}
 	err = r.gorm.DB.
 		Model(orm).
-		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.pin, c.comment as reply_comment").
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.thread_uuid, comments.depth, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.reactions, comments.pin, comments.edited_at, c.comment as reply_comment, "+repliesCountSQL).
 		Where("comments.deleted_at IS NULL").
 		Joins("LEFT JOIN comments c ON c.uuid = comments.reply_uuid").
 		Order("comments.pin DESC, comments.created_at DESC").
@@ -206,12 +262,17 @@ func (r *Repository) GetTaskComment(commentUID uuid.UUID) (dms domain.Comment, e
 		CreatedBy:    orm.CreatedBy,
 		ReplyUUID:    orm.ReplyUUID,
 		ReplyComment: orm.ReplyComment,
+		ThreadUUID:   orm.ThreadUUID,
+		Depth:        orm.Depth,
+		RepliesCount: orm.RepliesCount,
 		TaskUUID:     orm.TaskUUID,
 		People:       orm.People,
 		CreatedAt:    orm.CreatedAt,
 		UpdatedAt:    orm.UpdatedAt,
 		Likes:        orm.Likes,
+		Reactions:    domain.CommentReactions(orm.Reactions),
 		Pin:          orm.Pin,
+		EditedAt:     orm.EditedAt,
 	}, err
 }
 
@@ -286,3 +347,141 @@ func (r *Repository) PatchCommentPin(uid uuid.UUID) (err error) {
 
 	return res.Error
 }
+
+// ToggleCommentReaction - ставит или снимает реакцию пользователя одним запросом,
+// чтобы одновременные реакции не затирали друг друга.
+func (r *Repository) ToggleCommentReaction(uid uuid.UUID, emoji, email string, at int64) (reactions Reactions, err error) {
+	defer r.storeTime("ToggleCommentReaction", tm())
+
+	rows := []struct {
+		Reactions Reactions
+	}{}
+
+	err = r.gorm.DB.
+		Raw(`update comments c set
+			reactions = case
+				when not jsonb_exists(coalesce(c.reactions -> p.emoji, '{}'), p.email)
+					then jsonb_set(c.reactions, ARRAY[p.emoji], coalesce(c.reactions -> p.emoji, '{}') || jsonb_build_object(p.email, p.at))
+				when (c.reactions -> p.emoji) - p.email = '{}'::jsonb
+					then c.reactions - p.emoji
+				else jsonb_set(c.reactions, ARRAY[p.emoji], (c.reactions -> p.emoji) - p.email)
+			end,
+			updated_at = now()
+		from (select ?::text as emoji, ?::text as email, ?::bigint as at) p
+		where c.uuid = ? and c.deleted_at is null
+		returning c.reactions`, emoji, email, at, uid).
+		Scan(&rows).Error
+	if err != nil {
+		return reactions, err
+	}
+
+	if len(rows) == 0 {
+		return reactions, dto.NotFoundErr("комментарий не найден")
+	}
+
+	return rows[0].Reactions, nil
+}
+
+type taskScope struct {
+	FederationUUID uuid.UUID
+	CompanyUUID    uuid.UUID
+	ProjectUUID    uuid.UUID
+}
+
+func (r *Repository) GetTaskScope(taskUUID uuid.UUID) (scope taskScope, err error) {
+	defer r.storeTime("GetTaskScope", tm())
+
+	res := r.gorm.DB.
+		Raw("select federation_uuid, company_uuid, project_uuid from tasks where uuid = ?", taskUUID).
+		Scan(&scope)
+
+	if res.Error != nil {
+		return scope, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return scope, dto.NotFoundErr("задача не найдена")
+	}
+
+	return scope, nil
+}
+
+type mentionRow struct {
+	Comment
+
+	TaskID          int
+	TaskName        string
+	TaskCompanyUUID uuid.UUID
+}
+
+func (r *Repository) GetUserMentions(email string, offset, limit int) (dms []domain.CommentMention, err error) {
+	defer r.storeTime("GetUserMentions", tm())
+
+	// оператор ? использует GIN-индекс comments_people, но совпадает с плейсхолдером gorm,
+	// поэтому email подставляется экранированным литералом
+	mentioned := "comments.people ? " + pq.QuoteLiteral(email)
+
+	rows := []mentionRow{}
+	err = r.gorm.DB.
+		Model(&Comment{}).
+		Select("comments.uuid, comments.comment, comments.created_by, comments.reply_uuid, comments.thread_uuid, comments.depth, comments.task_uuid, comments.people, comments.created_at, comments.updated_at, comments.likes, comments.reactions, comments.pin, comments.edited_at, t.id as task_id, t.name as task_name, t.company_uuid as task_company_uuid, " + repliesCountSQL).
+		Joins("JOIN tasks t ON t.uuid = comments.task_uuid AND t.deleted_at IS NULL").
+		Where(mentioned).
+		Where("comments.people != '{}'").
+		Where("comments.deleted_at IS NULL").
+		Order("comments.created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&rows).
+		Error
+
+	for _, o := range rows {
+		dms = append(dms, domain.CommentMention{
+			Comment: domain.Comment{
+				UUID:         o.UUID,
+				Comment:      o.Comment.Comment,
+				CreatedBy:    o.CreatedBy,
+				ReplyUUID:    o.ReplyUUID,
+				ThreadUUID:   o.ThreadUUID,
+				Depth:        o.Depth,
+				RepliesCount: o.RepliesCount,
+				TaskUUID:     o.TaskUUID,
+				People:       o.People,
+				CreatedAt:    o.CreatedAt,
+				UpdatedAt:    o.UpdatedAt,
+				Likes:        o.Likes,
+				Reactions:    domain.CommentReactions(o.Reactions),
+				Pin:          o.Pin,
+				EditedAt:     o.EditedAt,
+			},
+			TaskID:          o.TaskID,
+			TaskName:        o.TaskName,
+			TaskCompanyUUID: o.TaskCompanyUUID,
+		})
+	}
+
+	return dms, err
+}
+
+func (r *Repository) GetCommentVersions(uid uuid.UUID) (dms []domain.CommentVersion, err error) {
+	defer r.storeTime("GetCommentVersions", tm())
+
+	orm := []CommentVersion{}
+	err = r.gorm.DB.
+		Model(orm).
+		Where("comment_uuid = ?", uid).
+		Order("version ASC").
+		Find(&orm).
+		Error
+
+	for _, o := range orm {
+		dms = append(dms, domain.CommentVersion{
+			Version:   o.Version,
+			Comment:   o.Comment,
+			EditedBy:  o.EditedBy,
+			CreatedAt: o.CreatedAt,
+		})
+	}
+
+	return dms, err
+}
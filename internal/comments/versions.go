@@ -0,0 +1,116 @@
+package comments
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+var ErrCommentEditExpired = errors.New("время редактирования комментария истекло")
+
+// checkEditWindow - ограничение на редактирование из настроек проекта (comment_edit_minutes).
+func (s *Service) checkEditWindow(current Comment) error {
+	scope, err := s.repo.GetTaskScope(current.TaskUUID)
+	if err != nil {
+		return err
+	}
+
+	project, ok := s.dict.FindProject(scope.ProjectUUID)
+	if !ok || project.Options == nil || project.Options.CommentEditMinutes == nil {
+		return nil
+	}
+
+	cm := domain.Comment{CreatedAt: current.CreatedAt}
+	if !cm.EditAllowed(*project.Options.CommentEditMinutes, time.Now()) {
+		return ErrCommentEditExpired
+	}
+
+	return nil
+}
+
+// newVersions - версии, которые нужно сохранить при изменении текста, last - номер последней сохраненной версии.
+// При первом редактировании сохраняется и исходный текст (версия 1).
+func newVersions(current Comment, last int, edit domain.CommentVersion) []domain.CommentVersion {
+	items := []domain.CommentVersion{}
+
+	if last == 0 {
+		items = append(items, domain.CommentVersion{
+			Version:   1,
+			Comment:   current.Comment,
+			EditedBy:  current.CreatedBy,
+			CreatedAt: current.CreatedAt,
+		})
+		last = 1
+	}
+
+	edit.Version = last + 1
+
+	return append(items, edit)
+}
+
+// GetCommentVersions - история текста комментария, от исходного к текущему.
+// Для комментария без правок возвращается одна версия.
+func (s *Service) GetCommentVersions(commentUUID uuid.UUID) ([]domain.CommentVersion, error) {
+	current, err := s.repo.GetComment(commentUUID)
+	if err != nil {
+		return []domain.CommentVersion{}, err
+	}
+
+	versions, err := s.repo.GetCommentVersions(commentUUID)
+	if err != nil {
+		return []domain.CommentVersion{}, err
+	}
+
+	if len(versions) == 0 {
+		versions = append(versions, domain.CommentVersion{
+			Version:   1,
+			Comment:   current.Comment,
+			EditedBy:  current.CreatedBy,
+			CreatedAt: current.CreatedAt,
+		})
+	}
+
+	return versions, nil
+}
+
+// DiffCommentVersions - разница между версиями from и to. По умолчанию to - текущая версия, from - предыдущая.
+func (s *Service) DiffCommentVersions(commentUUID uuid.UUID, from, to *int) (domain.CommentVersion, domain.CommentVersion, []domain.TextDiff, error) {
+	var fromVersion, toVersion domain.CommentVersion
+
+	versions, err := s.GetCommentVersions(commentUUID)
+	if err != nil {
+		return fromVersion, toVersion, nil, err
+	}
+
+	last := versions[len(versions)-1].Version
+
+	toNum := last
+	if to != nil {
+		toNum = *to
+	}
+
+	fromNum := toNum - 1
+	if from != nil {
+		fromNum = *from
+	}
+
+	if fromNum < 1 {
+		fromNum = 1
+	}
+
+	byNum := make(map[int]domain.CommentVersion, len(versions))
+	for _, v := range versions {
+		byNum[v.Version] = v
+	}
+
+	fromVersion, okFrom := byNum[fromNum]
+	toVersion, okTo := byNum[toNum]
+	if !okFrom || !okTo {
+		return fromVersion, toVersion, nil, dto.NotFoundErr("версия комментария не найдена")
+	}
+
+	return fromVersion, toVersion, domain.DiffText(fromVersion.Comment, toVersion.Comment), nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 //nolint:revive // it is table name
@@ -40,8 +41,9 @@ type CompanyPriority struct {
 }
 
 type Company struct {
-	UUID       uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
-	SmsOptions SmsOptions `gorm:"type:jsonb;default:'';not null"`
+	UUID         uuid.UUID    `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	SmsOptions   SmsOptions   `gorm:"type:jsonb;default:'';not null"`
+	WorkingHours WorkingHours `gorm:"type:jsonb;default:'{}';not null"`
 
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
@@ -68,3 +70,21 @@ func (j *SmsOptions) Scan(value interface{}) error {
 func (j SmsOptions) Value() (driver.Value, error) {
 	return json.Marshal(j)
 }
+
+type WorkingHours domain.WorkingHours
+
+func (j *WorkingHours) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := WorkingHours{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j WorkingHours) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
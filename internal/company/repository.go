@@ -254,3 +254,22 @@ func (r *Repository) GetSmsOptions(uid uuid.UUID) (orm Company, err error) {
 		Error
 	return orm, err
 }
+
+func (r *Repository) UpdateWorkingHours(uid uuid.UUID, wh WorkingHours) error {
+	return r.gorm.DB.
+		Model(&Company{
+			UUID: uid,
+		}).
+		Update("working_hours", wh).
+		Error
+}
+
+func (r *Repository) GetWorkingHours(uid uuid.UUID) (orm Company, err error) {
+	err = r.gorm.DB.
+		Select("uuid", "working_hours").
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).
+		Error
+	return orm, err
+}
@@ -0,0 +1,25 @@
+package company
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func (s *Service) UpdateWorkingHours(uid uuid.UUID, wh domain.WorkingHours) error {
+	err := wh.Validate()
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateWorkingHours(uid, WorkingHours(wh))
+}
+
+// GetWorkingHours - рабочее время компании, по умолчанию без ограничений.
+func (s *Service) GetWorkingHours(uid uuid.UUID) (wh domain.WorkingHours, err error) {
+	orm, err := s.repo.GetWorkingHours(uid)
+	if err != nil {
+		return wh, err
+	}
+
+	return domain.WorkingHours(orm.WorkingHours), nil
+}
@@ -31,8 +31,18 @@ type Configs struct {
 	REDIS_CREDS redis.Creds `env:"REDIS_CREDS" secured:"true"`
 
 	// SMTP
-	SMTP_ENABLE bool   `env:"SMTP_ENABLE" envDefault:"true"`
-	SMTP_CREDS  string `env:"SMTP_CREDS" secured:"true"`
+	SMTP_ENABLE  bool   `env:"SMTP_ENABLE" envDefault:"true"`
+	SMTP_CREDS   string `env:"SMTP_CREDS" secured:"true"`
+	SMTP_WORKERS int    `env:"SMTP_WORKERS" envDefault:"2"`
+
+	// INBOUND - ответы на уведомления о задачах становятся комментариями.
+	// INBOUND_EMAIL - ящик для ответов (reply@crm.example.com), пустой - ответ уходит отправителю;
+	// письма принимаются SMTP сервером на INBOUND_SMTP_ADDR (:2525) или из каталога Maildir INBOUND_MAILDIR;
+	// INBOUND_AUTHSERV_ID - имя MTA перед нами в Authentication-Results, ответы без пройденной у него проверки SPF или DKIM отклоняются
+	INBOUND_EMAIL       string `env:"INBOUND_EMAIL" envDefault:""`
+	INBOUND_SMTP_ADDR   string `env:"INBOUND_SMTP_ADDR" envDefault:""`
+	INBOUND_MAILDIR     string `env:"INBOUND_MAILDIR" envDefault:""`
+	INBOUND_AUTHSERV_ID string `env:"INBOUND_AUTHSERV_ID" envDefault:""`
 
 	// APP
 	GZIP                     int    `env:"GZIP" envDefault:"5"`
@@ -44,6 +54,9 @@ type Configs struct {
 	TIME_ZONE                string `env:"TIME_ZONE" envDefault:"UTC"`
 	DICTIONARY_SYNC_INTERVAL int    `env:"DICTIONARY_SYNC_INTERVAL" envDefault:"10"`
 	URL_BACKEND              string `env:"URL_BACKEND" envDefault:"http://localhost:8080"`
+	URL_FRONTEND             string `env:"URL_FRONTEND" envDefault:"http://localhost:3000"`
+	// ADMIN_EMAILS - пользователи с доступом к служебным разделам (очередь писем)
+	ADMIN_EMAILS []string `env:"ADMIN_EMAILS"`
 
 	// CDN
 	CDN_PUBLIC_REGION            string `env:"CDN_PUBLIC_REGION" envDefault:"us-east-1"`
@@ -98,6 +111,14 @@ type Configs struct {
 	EMAILS_INTEGRATION_ENABLED bool     `env:"EMAILS_INTEGRATION_ENABLED" envDefault:"false"`
 	KAFKA_BROKERS              []string `env:"KAFKA_BROKERS" envDefault:"kafka:9092"`
 	KAFKA_TOPIC                string   `env:"KAFKA_TOPIC" envDefault:"emails"`
+	KAFKA_GROUP                string   `env:"KAFKA_GROUP" envDefault:"crm-emails"`
+
+	// Почтовые ящики пользователей: по умолчанию imaps://imap.<домен>:993 и smtps://smtp.<домен>:465,
+	// для локальных стендов адреса задаются явно, например imap+insecure://localhost:1143 и smtp+insecure://localhost:1025.
+	// KAFKA_BROKERS=memory:// - очередь задач в памяти процесса.
+	MAILBOX_IMAP_ADDR      string `env:"MAILBOX_IMAP_ADDR" envDefault:""`
+	MAILBOX_SMTP_ADDR      string `env:"MAILBOX_SMTP_ADDR" envDefault:""`
+	MAILBOX_FETCH_INTERVAL int    `env:"MAILBOX_FETCH_INTERVAL" envDefault:"300"`
 }
 
 func (o *Configs) Debug() {
@@ -173,3 +194,14 @@ func (o *Configs) NewKafkaWriter() *kafka.Writer {
 		Topic:   o.KAFKA_TOPIC,
 	})
 }
+
+func (o *Configs) NewKafkaReader() *kafka.Reader {
+	if len(o.KAFKA_BROKERS) == 0 {
+		panic("Kafka brokers not configured")
+	}
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers: o.KAFKA_BROKERS,
+		Topic:   o.KAFKA_TOPIC,
+		GroupID: o.KAFKA_GROUP,
+	})
+}
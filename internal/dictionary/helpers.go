@@ -1,6 +1,9 @@
 package dictionary
 
 import (
+	"strings"
+
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -33,3 +36,78 @@ func (s *Service) FindUsers(emails []string) ([]dto.UserDTO, []string) {
 		return u.Email
 	})
 }
+
+// FindCompanyGroup - группа компании по названию без учета регистра ("_" считается пробелом).
+func (s *Service) FindCompanyGroup(companyUUID uuid.UUID, name string) (dto.GroupDTO, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	name = normalizeName(name)
+
+	return lo.Find(s.groupsByCompany[companyUUID], func(g dto.GroupDTO) bool {
+		return normalizeName(g.Name) == name
+	})
+}
+
+// FindUserByName - пользователь федерации по "имя фамилия", "фамилия имя", имени или фамилии.
+// Возвращает пользователя, только если совпадение однозначное.
+func (s *Service) FindUserByName(federationUUID uuid.UUID, name string) (*dto.UserDTO, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	name = normalizeName(name)
+	if name == "" {
+		return &dto.UserDTO{}, false
+	}
+
+	found := lo.Filter(s.federationUsers[federationUUID], func(u dto.UserDTO, _ int) bool {
+		first, last := normalizeName(u.Name), normalizeName(u.Lname)
+
+		return name == first+" "+last || name == last+" "+first || name == first || name == last
+	})
+
+	if len(found) != 1 {
+		return &dto.UserDTO{}, false
+	}
+
+	return &found[0], true
+}
+
+func normalizeName(name string) string {
+	name = strings.NewReplacer("_", " ", ".", " ").Replace(strings.ToLower(name))
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// InFederation - пользователь состоит в федерации.
+func (s *Service) InFederation(federationUUID, userUUID uuid.UUID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return lo.ContainsBy(s.federationUsers[federationUUID], func(u dto.UserDTO) bool {
+		return u.UUID == userUUID
+	})
+}
+
+// UserFederations - федерации, в которых состоит пользователь.
+func (s *Service) UserFederations(userUUID uuid.UUID) []uuid.UUID {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	federations := []uuid.UUID{}
+	for federationUUID, users := range s.federationUsers {
+		if lo.ContainsBy(users, func(u dto.UserDTO) bool { return u.UUID == userUUID }) {
+			federations = append(federations, federationUUID)
+		}
+	}
+
+	return federations
+}
+
+// Users - все пользователи, для рассылок.
+func (s *Service) Users() []dto.UserDTO {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return lo.Values(s.usersByEmail)
+}
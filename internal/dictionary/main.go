@@ -34,6 +34,7 @@ type Service struct {
 	usersFederations  map[uuid.UUID][]uuid.UUID
 	usersCompanies    map[uuid.UUID][]uuid.UUID
 	companyPriorities map[uuid.UUID][]dto.CompanyPriorityDTO
+	groupsByCompany   map[uuid.UUID][]dto.GroupDTO
 
 	federationUsers map[uuid.UUID][]dto.UserDTO
 
@@ -78,6 +79,7 @@ func New(repo *Repository, metrics *helpers.MetricsCounters, storage IStorage) *
 		catalogFieldsByCatalogUUID: make(map[uuid.UUID][]dto.CatalogFieldDTO),
 		tagsByUUID:                 make(map[uuid.UUID]dto.TagDTO),
 		companyPriorities:          make(map[uuid.UUID][]dto.CompanyPriorityDTO),
+		groupsByCompany:            make(map[uuid.UUID][]dto.GroupDTO),
 
 		usersFederations: make(map[uuid.UUID][]uuid.UUID),
 		usersCompanies:   make(map[uuid.UUID][]uuid.UUID),
@@ -167,6 +169,7 @@ func (s *Service) Info() {
 		WithField("usersFederations", len(s.usersFederations)).
 		WithField("usersCompanies", len(s.usersCompanies)).
 		WithField("companyPriorities", len(s.companyPriorities)).
+		WithField("companyGroups", len(s.groupsByCompany)).
 		Info("dict items")
 }
 
@@ -402,6 +405,7 @@ func (s *Service) SyncProjectFields() error {
 				DataType:           i.DataType,
 				RequiredOnStatuses: i.RequiredOnStatuses,
 				ProjectUUID:        i.ProjectUUID,
+				Options:            dto.NewCompanyFieldOptionDTOs(i.Options),
 			})
 
 			mp[i.ProjectUUID] = lo.UniqBy(mp[i.ProjectUUID], func(i dto.ProjectFieldDTO) interface{} {
@@ -921,9 +925,36 @@ func (s *Service) SyncCompanyTags() error {
 	return nil
 }
 
+// SyncCompanyGroups - группы обновляются целиком: у удаления участника нет updated_at.
+func (s *Service) SyncCompanyGroups() error {
+	items, err := s.repo.FetchGroups()
+	if err != nil {
+		return err
+	}
+
+	groups := make(map[uuid.UUID][]dto.GroupDTO)
+	for _, i := range items {
+		groups[i.CompanyUUID] = append(groups[i.CompanyUUID], dto.GroupDTO{
+			UUID:      i.UUID,
+			Name:      i.Name,
+			UpdatedAt: i.UpdatedAt,
+			UserUUIDS: lo.Map(i.UserUUIDs, func(uid uuid.UUID, _ int) string {
+				return uid.String()
+			}),
+		})
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.groupsByCompany = groups
+
+	return nil
+}
+
 func (s *Service) SyncAll() {
 	wg := sync.WaitGroup{}
-	wg.Add(11)
+	wg.Add(12)
 
 	go func() {
 		defer wg.Done()
@@ -1024,5 +1055,14 @@ func (s *Service) SyncAll() {
 		logrus.Debug("SyncCompanyPriorities done")
 	}()
 
+	go func() {
+		defer wg.Done()
+		err := s.SyncCompanyGroups()
+		if err != nil {
+			logrus.Error(err)
+		}
+		logrus.Debug("SyncCompanyGroups done")
+	}()
+
 	wg.Wait()
 }
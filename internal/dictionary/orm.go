@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type User struct {
@@ -118,6 +119,8 @@ type ProjectFields struct {
 	DeletedAt *time.Time `gorm:"type:timestamptz;"`
 
 	RequiredOnStatuses IntArray `gorm:"type:jsonb;default:'[]';not null;"`
+
+	Options domain.CompanyFieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
 }
 
 type UUIDArray []uuid.UUID
@@ -161,6 +164,15 @@ type CompanyPriority struct {
 	DeletedAt *time.Time `gorm:"type:timestamptz;"`
 }
 
+type Group struct {
+	UUID        uuid.UUID `gorm:"type:uuid;"`
+	Name        string    `gorm:"type:varchar(100);"`
+	CompanyUUID uuid.UUID `gorm:"type:uuid;"`
+	UpdatedAt   time.Time `gorm:"type:timestamptz;"`
+
+	UserUUIDs UUIDArray `gorm:"->"`
+}
+
 type IntArray []int
 
 // Scan scan value into Jsonb, implements sql.Scanner interface.
@@ -93,7 +93,7 @@ func (r *Repository) FetchCompanyFields(updatedAt time.Time) (items []CompanyFie
 
 func (r *Repository) FetchProjectFields(updatedAt time.Time) (items []ProjectFields, err error) {
 	err = r.gorm.DB.Table("project_fields").
-		Select("project_fields.project_uuid project_uuid, project_fields.uuid uuid, project_fields.company_uuid company_uuid, cf.name name, cf.hash hash, project_fields.style, project_fields.required_on_statuses required_on_statuses").
+		Select("project_fields.project_uuid project_uuid, project_fields.uuid uuid, project_fields.company_uuid company_uuid, cf.name name, cf.hash hash, cf.data_type data_type, cf.options options, project_fields.style, project_fields.required_on_statuses required_on_statuses").
 		Joins("LEFT JOIN company_fields cf ON project_fields.company_field_uuid = cf.uuid").
 		Where("project_fields.updated_at >= ? or cf.updated_at >= ?", updatedAt, updatedAt).
 		Find(&items).Error
@@ -150,3 +150,20 @@ func (r *Repository) FetchCompanyPriority(updatedAt time.Time) (items []CompanyP
 
 	return items, nil
 }
+
+// FetchGroups - все группы компаний с участниками (полная выборка, групп немного).
+func (r *Repository) FetchGroups() (items []Group, err error) {
+	err = r.gorm.DB.Table("groups").
+		Select("groups.uuid, groups.name, groups.company_uuid, groups.updated_at, coalesce(json_agg(gu.user_uuid) FILTER (WHERE gu.user_uuid is not null), '[]') as user_uuids").
+		Joins("LEFT JOIN group_users gu ON gu.group_uuid = groups.uuid and gu.deleted_at is null").
+		Where("groups.deleted_at is null").
+		Group("groups.uuid, groups.name, groups.company_uuid, groups.updated_at").
+		Find(&items).
+		Error
+
+	if err != nil {
+		return items, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,502 @@
+package emails
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+const (
+	replySignLen = 20
+
+	// ReplyTTL - сколько действует адрес для ответа на уведомление
+	ReplyTTL = 90 * 24 * time.Hour
+
+	// inboundMaxSize - больше письмо не принимается ни SMTP, ни из maildir
+	inboundMaxSize = 25 << 20
+)
+
+var (
+	// ErrInboundRejected - письмо не может стать комментарием, повторять не нужно.
+	ErrInboundRejected = errors.New("письмо отклонено")
+
+	// ErrInboundUnauthenticated - отправитель не прошел проверку SPF, DKIM или DMARC.
+	ErrInboundUnauthenticated = errors.New("отправитель не подтвержден SPF или DKIM")
+
+	// replyToken - задача, срок и подпись из адреса inbox+<задача>.<срок>.<подпись>@домен или Message-ID на его основе
+	replyToken = regexp.MustCompile(`\+([0-9a-f]{32})\.([0-9a-f]{1,8})\.([0-9a-f]{20})[.@]`)
+
+	authComment = regexp.MustCompile(`\([^()]*\)`)
+
+	// replyAttribution - строка перед цитатой: "On ... wrote:", "... пишет:", "..., Иван <ivan@mail.ru>:", пересылка и заголовки Outlook
+	replyAttribution = regexp.MustCompile(`(?i)^(.*(wrote|пишет|написал|написала):\s*$|.*<[^>\s]+@[^>\s]+>:\s*$|-{2,}\s*(original message|forwarded message|исходное сообщение|пересылаемое сообщение).*$|(from|от):\s.+@.+$)`)
+	replySignature   = regexp.MustCompile(`(?i)^(--\s?|__+|sent from my .+|отправлено (с|из) .+|с уважением,?.*|best regards,?.*)$`)
+
+	htmlQuote = regexp.MustCompile(`(?is)<(blockquote|div[^>]+class="[^"]*(gmail_quote|moz-cite-prefix)[^"]*")[^>]*>.*$`)
+	htmlBreak = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])>`)
+	htmlTag   = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlSkip  = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+
+	messageIDRe = regexp.MustCompile(`<([^<>\s]+)>`)
+
+	wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+)
+
+// InboundMail - разобранное входящее письмо.
+type InboundMail struct {
+	From      string // адрес отправителя в нижнем регистре
+	FromName  string
+	Subject   string
+	MessageID string // без угловых скобок
+	// Body - текст письма целиком, Text - текст ответа без цитаты исходного письма и подписи
+	Body string
+	Text string
+	// AutoReply - автоответ, рассылка или уведомление о недоставке: на них не заводятся задачи
+	AutoReply bool
+
+	// AuthResults - результаты проверок из заголовков Authentication-Results (RFC 8601)
+	AuthResults []AuthResult
+
+	// Recipients - To, Cc, Delivered-To, X-Original-To и получатели из конверта SMTP
+	Recipients []string
+	// References - In-Reply-To и References
+	References []string
+
+	Attachments []InboundAttachment
+}
+
+// AuthResult - результат проверки отправителя почтовым сервером servID: метод spf, dkim или dmarc и результат pass, fail...
+type AuthResult struct {
+	ServID string
+	Method string
+	Result string
+}
+
+type InboundAttachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// ReplyAddress - адрес для ответа на уведомление о задаче: inbox+<задача>.<срок>.<подпись>@домен, срок - день по unix времени.
+// Подпись - HMAC задачи, срока и получателя, поэтому ответ с этого адреса принимается только от него и до expires.
+func ReplyAddress(inbox, secret string, taskUUID uuid.UUID, email string, expires time.Time) string {
+	local, host, ok := strings.Cut(inbox, "@")
+	if !ok {
+		return ""
+	}
+
+	exp := strconv.FormatInt(expires.Unix()/86400, 16)
+
+	return fmt.Sprintf("%s+%s.%s.%s@%s", local, hex.EncodeToString(taskUUID[:]), exp, replySign(secret, taskUUID, exp, email), host)
+}
+
+func replySign(secret string, taskUUID uuid.UUID, exp, email string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("reply:" + taskUUID.String() + ":" + exp + ":" + strings.ToLower(email)))
+
+	return hex.EncodeToString(mac.Sum(nil))[:replySignLen]
+}
+
+// ReplyTask - задача, на уведомление о которой отвечает отправитель: по адресу получателя или In-Reply-To и References.
+// Адрес с истекшим сроком не принимается.
+func (m InboundMail) ReplyTask(secret string, now time.Time) (uuid.UUID, bool) {
+	uid, expires, ok := m.replyToken(secret, m.From)
+	if !ok || now.After(expires) {
+		return uuid.Nil, false
+	}
+
+	return uid, true
+}
+
+// ReplyTaskFor - задача уведомления, отправленного на email: само уведомление или ответ в его переписке.
+// Срок не проверяется: письмо уже лежит в ящике получателя.
+func (m InboundMail) ReplyTaskFor(secret, email string) (uuid.UUID, bool) {
+	uid, _, ok := m.replyToken(secret, email)
+
+	return uid, ok
+}
+
+// replyToken - задача и срок действия из подписанного для email адреса.
+func (m InboundMail) replyToken(secret, email string) (uuid.UUID, time.Time, bool) {
+	for _, s := range append(append([]string{m.MessageID}, m.Recipients...), m.References...) {
+		for _, sub := range replyToken.FindAllStringSubmatch(strings.ToLower(s), -1) {
+			uid, err := uuid.Parse(sub[1])
+			if err != nil {
+				continue
+			}
+
+			day, err := strconv.ParseInt(sub[2], 16, 64)
+			if err != nil {
+				continue
+			}
+
+			if hmac.Equal([]byte(sub[3]), []byte(replySign(secret, uid, sub[2], email))) {
+				return uid, time.Unix((day+1)*86400, 0), true
+			}
+		}
+	}
+
+	return uuid.Nil, time.Time{}, false
+}
+
+// Authenticated - отправитель подтвержден почтовым сервером servID по Authentication-Results:
+// DMARC, если проверялся, иначе SPF или DKIM. Результаты других серверов не учитываются, их может добавить сам отправитель.
+// Пустой servID - письма принимаются без MTA перед нами, отклоняются только с явной ошибкой проверки.
+func (m InboundMail) Authenticated(servID string) error {
+	results := lo.Filter(m.AuthResults, func(r AuthResult, _ int) bool {
+		return servID == "" || strings.EqualFold(r.ServID, servID)
+	})
+
+	if len(results) == 0 {
+		if servID == "" {
+			return nil
+		}
+
+		return fmt.Errorf("%w: нет результатов проверки от %s", ErrInboundUnauthenticated, servID)
+	}
+
+	// несколько подписей DKIM: достаточно одной прошедшей проверку
+	result := func(method string) (string, bool) {
+		found := lo.Filter(results, func(r AuthResult, _ int) bool {
+			return r.Method == method
+		})
+
+		if len(found) == 0 {
+			return "", false
+		}
+
+		if lo.ContainsBy(found, func(r AuthResult) bool { return r.Result == "pass" }) {
+			return "pass", true
+		}
+
+		return found[0].Result, true
+	}
+
+	if dmarc, ok := result("dmarc"); ok {
+		if dmarc == "pass" {
+			return nil
+		}
+
+		return fmt.Errorf("%w: dmarc=%s", ErrInboundUnauthenticated, dmarc)
+	}
+
+	spf, _ := result("spf")
+	dkim, _ := result("dkim")
+
+	if spf == "pass" || dkim == "pass" {
+		return nil
+	}
+
+	if servID == "" && spf != "fail" && spf != "softfail" && dkim != "fail" {
+		return nil
+	}
+
+	return fmt.Errorf("%w: spf=%s, dkim=%s", ErrInboundUnauthenticated, spf, dkim)
+}
+
+// parseAuthResults - Authentication-Results: authserv-id [версия]; метод=результат свойства; ...
+func parseAuthResults(headers []string) []AuthResult {
+	results := []AuthResult{}
+
+	for _, h := range headers {
+		parts := strings.Split(authComment.ReplaceAllString(h, ""), ";")
+
+		fields := strings.Fields(parts[0])
+		if len(fields) == 0 {
+			continue
+		}
+
+		servID := fields[0]
+
+		for _, part := range parts[1:] {
+			fields := strings.Fields(part)
+			if len(fields) == 0 {
+				continue
+			}
+
+			method, result, ok := strings.Cut(fields[0], "=")
+			if !ok {
+				continue
+			}
+
+			method, _, _ = strings.Cut(method, "/")
+			results = append(results, AuthResult{
+				ServID: servID,
+				Method: strings.ToLower(method),
+				Result: strings.ToLower(result),
+			})
+		}
+	}
+
+	return results
+}
+
+// RecipientAddresses - адреса получателей в нижнем регистре, без имен.
+func (m InboundMail) RecipientAddresses() []string {
+	emails := []string{}
+
+	for _, s := range m.Recipients {
+		list, err := (&mail.AddressParser{WordDecoder: wordDecoder}).ParseList(s)
+		if err != nil {
+			emails = append(emails, strings.ToLower(strings.Trim(strings.TrimSpace(s), "<>")))
+			continue
+		}
+
+		for _, addr := range list {
+			emails = append(emails, strings.ToLower(addr.Address))
+		}
+	}
+
+	return lo.Uniq(lo.Compact(emails))
+}
+
+// ThreadIDs - Message-ID писем, на которые отвечает письмо.
+func (m InboundMail) ThreadIDs() []string {
+	ids := []string{}
+	for _, s := range m.References {
+		ids = append(ids, messageIDs(s)...)
+	}
+
+	return lo.Uniq(ids)
+}
+
+func messageIDs(s string) []string {
+	return lo.Map(messageIDRe.FindAllStringSubmatch(s, -1), func(sub []string, _ int) string {
+		return sub[1]
+	})
+}
+
+// isAutoReply - RFC 3834 Auto-Submitted, Precedence рассылок и отправители уведомлений о недоставке.
+func isAutoReply(h mail.Header) bool {
+	if v := strings.ToLower(h.Get("Auto-Submitted")); v != "" && v != "no" {
+		return true
+	}
+
+	switch strings.ToLower(h.Get("Precedence")) {
+	case "bulk", "junk", "list", "auto_reply":
+		return true
+	}
+
+	from := strings.ToLower(h.Get("From"))
+
+	return h.Get("X-Autoreply") != "" || h.Get("X-Autorespond") != "" ||
+		strings.Contains(from, "mailer-daemon@") || strings.Contains(from, "postmaster@")
+}
+
+// ParseInbound - разбирает письмо RFC 822: отправитель, адреса для поиска задачи, текст ответа и вложения.
+func ParseInbound(raw []byte) (InboundMail, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return InboundMail{}, fmt.Errorf("%w: %s", ErrInboundRejected, err)
+	}
+
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+
+	from, err := parser.Parse(msg.Header.Get("From"))
+	if err != nil {
+		return InboundMail{}, fmt.Errorf("%w: отправитель: %s", ErrInboundRejected, err)
+	}
+
+	subject, err := wordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	m := InboundMail{
+		From:      strings.ToLower(from.Address),
+		FromName:  from.Name,
+		Subject:   subject,
+		MessageID: strings.Trim(strings.TrimSpace(msg.Header.Get("Message-ID")), "<>"),
+		AutoReply: isAutoReply(msg.Header),
+
+		AuthResults: parseAuthResults(msg.Header["Authentication-Results"]),
+	}
+
+	for _, h := range []string{"To", "Cc", "Delivered-To", "X-Original-To", "Envelope-To"} {
+		m.Recipients = append(m.Recipients, msg.Header[h]...)
+	}
+
+	for _, h := range []string{"In-Reply-To", "References"} {
+		m.References = append(m.References, msg.Header[h]...)
+	}
+
+	body := inboundBody{}
+
+	err = body.walk(textproto.MIMEHeader(msg.Header), msg.Body, 0)
+	if err != nil {
+		return m, fmt.Errorf("%w: %s", ErrInboundRejected, err)
+	}
+
+	text := body.text
+	if strings.TrimSpace(text) == "" && body.html != "" {
+		text = htmlToText(body.html)
+	}
+
+	m.Body = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	m.Text = StripReply(text)
+	m.Attachments = body.attachments
+
+	return m, nil
+}
+
+// inboundBody - первые text/plain и text/html части письма и вложения.
+type inboundBody struct {
+	text        string
+	html        string
+	attachments []InboundAttachment
+}
+
+func (b *inboundBody) walk(header textproto.MIMEHeader, r io.Reader, depth int) error {
+	if depth > 10 {
+		return errors.New("слишком глубокая вложенность частей")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			err = b.walk(part.Header, part, depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// multipart.Reader сам раскодирует quoted-printable и убирает заголовок
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, inboundMaxSize))
+	if err != nil {
+		return err
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	name := dparams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+
+	if name != "" || disposition == "attachment" {
+		if decoded, err := wordDecoder.DecodeHeader(name); err == nil {
+			name = decoded
+		}
+
+		b.attachments = append(b.attachments, InboundAttachment{
+			Name:        helpers.If(name != "", name, "attachment"),
+			ContentType: mediaType,
+			Data:        data,
+		})
+
+		return nil
+	}
+
+	switch mediaType {
+	case "text/plain":
+		if b.text == "" {
+			b.text, err = decodeCharset(data, params["charset"])
+		}
+	case "text/html":
+		if b.html == "" {
+			b.html, err = decodeCharset(data, params["charset"])
+		}
+	}
+
+	return err
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.NewDecoder().Reader(input), nil
+}
+
+func decodeCharset(data []byte, charset string) (string, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii":
+		return string(data), nil
+	}
+
+	r, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		return string(data), nil //nolint:nilerr // неизвестная кодировка - текст как есть
+	}
+
+	b, err := io.ReadAll(r)
+
+	return string(b), err
+}
+
+// htmlToText - текст html письма без цитаты (blockquote, gmail_quote) и разметки.
+func htmlToText(s string) string {
+	s = htmlSkip.ReplaceAllString(s, "")
+	s = htmlQuote.ReplaceAllString(s, "")
+	s = htmlBreak.ReplaceAllString(s, "\n")
+	s = htmlTag.ReplaceAllString(s, "")
+
+	return html.UnescapeString(s)
+}
+
+// StripReply - текст ответа до цитаты исходного письма и подписи.
+func StripReply(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	reply := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, ">") || replyAttribution.MatchString(trimmed) || replySignature.MatchString(line) || replySignature.MatchString(trimmed) {
+			break
+		}
+
+		reply = append(reply, strings.TrimRight(line, " \t"))
+	}
+
+	// атрибуция бывает перенесена на две строки: "On Mon, 1 Jan 2024 at 10:00, Ivan <" + "ivan@mail.ru> wrote:"
+	if n := len(reply); n > 0 && n < len(lines) && replyAttribution.MatchString(strings.TrimSpace(lines[n])) &&
+		strings.HasPrefix(strings.ToLower(strings.TrimSpace(reply[n-1])), "on ") {
+		reply = reply[:n-1]
+	}
+
+	return strings.TrimSpace(strings.Join(reply, "\n"))
+}
@@ -0,0 +1,250 @@
+package emails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	inboundSMTPTimeout = 5 * time.Minute
+	inboundMaxRcpt     = 100
+)
+
+// InboundHandler - обработка входящего письма, rcpt - получатели из конверта SMTP.
+// Ошибка ErrInboundRejected отклоняет письмо навсегда, остальные - до повторной попытки.
+type InboundHandler func(raw []byte, rcpt []string) error
+
+// InboundSMTP - SMTP сервер приема писем для локальной доставки от MTA: без TLS и авторизации,
+// наружу его не открывают.
+type InboundSMTP struct {
+	Addr     string
+	Hostname string
+	Handler  InboundHandler
+}
+
+// ListenAndServe - принимает письма до отмены ctx.
+func (s *InboundSMTP) ListenAndServe(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	logrus.Info("inbound smtp listen on ", s.Addr)
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		go s.serve(conn)
+	}
+}
+
+func (s *InboundSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	host := s.Hostname
+	if host == "" {
+		host = "localhost"
+	}
+
+	reply := func(code int, msg string) {
+		_ = tp.PrintfLine("%d %s", code, msg)
+	}
+
+	mailFrom := false
+	rcpt := []string{}
+
+	_ = conn.SetDeadline(time.Now().Add(inboundSMTPTimeout))
+	reply(220, host+" ESMTP")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		_ = conn.SetDeadline(time.Now().Add(inboundSMTPTimeout))
+
+		cmd, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(cmd) {
+		case "HELO":
+			reply(250, host)
+		case "EHLO":
+			_ = tp.PrintfLine("250-%s", host)
+			_ = tp.PrintfLine("250-SIZE %d", inboundMaxSize)
+			_ = tp.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			mailFrom, rcpt = true, []string{}
+			reply(250, "2.1.0 Ok")
+		case "RCPT":
+			switch {
+			case !mailFrom:
+				reply(503, "5.5.1 Need MAIL command")
+			case len(rcpt) >= inboundMaxRcpt:
+				reply(452, "4.5.3 Too many recipients")
+			default:
+				rcpt = append(rcpt, envelopeAddress(arg))
+				reply(250, "2.1.5 Ok")
+			}
+		case "DATA":
+			if len(rcpt) == 0 {
+				reply(503, "5.5.1 Need RCPT command")
+				continue
+			}
+
+			reply(354, "End data with <CR><LF>.<CR><LF>")
+
+			dr := tp.DotReader()
+
+			raw, err := io.ReadAll(io.LimitReader(dr, inboundMaxSize+1))
+			if err != nil {
+				return
+			}
+
+			// остаток большого письма дочитывается, чтобы не принять его за команды
+			_, err = io.Copy(io.Discard, dr)
+			if err != nil {
+				return
+			}
+
+			reply(s.handle(raw, rcpt))
+			mailFrom, rcpt = false, []string{}
+		case "RSET":
+			mailFrom, rcpt = false, []string{}
+			reply(250, "2.0.0 Ok")
+		case "NOOP":
+			reply(250, "2.0.0 Ok")
+		case "QUIT":
+			reply(221, "2.0.0 Bye")
+			return
+		default:
+			reply(502, "5.5.2 Command not implemented")
+		}
+	}
+}
+
+func (s *InboundSMTP) handle(raw []byte, rcpt []string) (int, string) {
+	if len(raw) > inboundMaxSize {
+		return 552, "5.3.4 Message too big"
+	}
+
+	err := s.Handler(raw, rcpt)
+
+	switch {
+	case err == nil:
+		return 250, "2.0.0 Ok: queued"
+	case errors.Is(err, ErrInboundRejected):
+		logrus.WithField("rcpt", rcpt).Warn("inbound mail rejected: ", err)
+		return 550, "5.7.1 Message rejected"
+	}
+
+	logrus.WithField("rcpt", rcpt).Error("inbound mail error: ", err)
+
+	return 451, "4.3.0 Try again later"
+}
+
+// envelopeAddress - адрес из "TO:<user@host> ПАРАМЕТРЫ".
+func envelopeAddress(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+
+	if start, end := strings.Index(addr, "<"), strings.Index(addr, ">"); start >= 0 && end > start {
+		return addr[start+1 : end]
+	}
+
+	addr, _, _ = strings.Cut(addr, " ")
+
+	return addr
+}
+
+// InboundMaildir - письма из каталога new обрабатываются и переносятся в cur: принятые с флагом S, отклоненные - T.
+// При временной ошибке письмо остается в new до следующего прохода.
+type InboundMaildir struct {
+	Dir     string
+	Handler InboundHandler
+}
+
+// Process - обрабатывает письма из new, возвращает сколько писем перенесено в cur.
+func (d *InboundMaildir) Process() (int, error) {
+	err := os.MkdirAll(filepath.Join(d.Dir, "cur"), 0o755)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(d.Dir, "new"))
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(d.Dir, "new", entry.Name())
+
+		err = d.handle(path)
+		if err != nil && !errors.Is(err, ErrInboundRejected) {
+			logrus.WithField("file", path).Error("inbound mail error: ", err)
+			continue
+		}
+
+		flag := "S"
+		if err != nil {
+			logrus.WithField("file", path).Warn("inbound mail rejected: ", err)
+			flag = "T"
+		}
+
+		err = os.Rename(path, filepath.Join(d.Dir, "cur", entry.Name()+":2,"+flag))
+		if err != nil {
+			return n, err
+		}
+
+		n++
+	}
+
+	return n, nil
+}
+
+func (d *InboundMaildir) handle(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if info.Size() > inboundMaxSize {
+		return fmt.Errorf("%w: письмо больше %d байт", ErrInboundRejected, inboundMaxSize)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return d.Handler(raw, nil)
+}
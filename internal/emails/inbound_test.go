@@ -0,0 +1,168 @@
+package emails
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestStripReply(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain", text: "Готово, проверьте\r\n", want: "Готово, проверьте"},
+		{name: "quote", text: "Сделал\n\n> Вас назначили исполнителем\n> задачи", want: "Сделал"},
+		{name: "gmail en", text: "Done\n\nOn Mon, 1 Jan 2024 at 10:00, Ivan <ivan@mail.ru> wrote:\n> text", want: "Done"},
+		{name: "gmail en wrapped", text: "Done\n\nOn Mon, 1 Jan 2024 at 10:00, Ivan Ivanov <\nivan@mail.ru> wrote:\n> text", want: "Done"},
+		{name: "gmail ru", text: "Принято\n\nпн, 1 янв. 2024 г. в 10:00, Иван <ivan@mail.ru>:\n> текст", want: "Принято"},
+		{name: "mail.ru", text: "Ок\n\nПонедельник, 1 января 2024, 10:00 +03:00 от Иван <ivan@mail.ru>:\nтекст", want: "Ок"},
+		{name: "outlook", text: "Посмотрю\n\n-----Original Message-----\nFrom: crm", want: "Посмотрю"},
+		{name: "signature", text: "Согласовано\n-- \nИван Иванов\n+7 900 000-00-00", want: "Согласовано"},
+		{name: "mobile", text: "Да\n\nОтправлено из мобильной Почты Mail.ru", want: "Да"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripReply(tt.text); got != tt.want {
+				t.Errorf("StripReply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplyTask(t *testing.T) {
+	now := time.Now()
+	task := uuid.New()
+	addr := ReplyAddress("reply@crm.ru", "secret", task, "Ivan@mail.ru", now.Add(ReplyTTL))
+	expired := ReplyAddress("reply@crm.ru", "secret", task, "ivan@mail.ru", now.Add(-48*time.Hour))
+	forged := strings.Replace(addr, "."+strconv.FormatInt(now.Add(ReplyTTL).Unix()/86400, 16)+".", ".fffff.", 1)
+
+	tests := []struct {
+		name   string
+		mail   InboundMail
+		wantOk bool
+	}{
+		{name: "recipient", mail: InboundMail{From: "ivan@mail.ru", Recipients: []string{"CRM <" + addr + ">"}}, wantOk: true},
+		{name: "in-reply-to", mail: InboundMail{From: "ivan@mail.ru", References: []string{messageID(addr)}}, wantOk: true},
+		{name: "other sender", mail: InboundMail{From: "petr@mail.ru", Recipients: []string{addr}}, wantOk: false},
+		{name: "no token", mail: InboundMail{From: "ivan@mail.ru", Recipients: []string{"reply@crm.ru"}}, wantOk: false},
+		{name: "expired", mail: InboundMail{From: "ivan@mail.ru", Recipients: []string{expired}}, wantOk: false},
+		{name: "extended expiry", mail: InboundMail{From: "ivan@mail.ru", Recipients: []string{forged}}, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.mail.ReplyTask("secret", now)
+			if ok != tt.wantOk || (ok && got != task) {
+				t.Errorf("ReplyTask() = %v, %v, want %v, %v", got, ok, task, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestReplyTaskFor(t *testing.T) {
+	task := uuid.New()
+	addr := ReplyAddress("reply@crm.ru", "secret", task, "ivan@mail.ru", time.Now().Add(-ReplyTTL))
+
+	// уведомление в ящике получателя, срок адреса для ответа не важен: отправитель - CRM, токен в Message-ID
+	m := InboundMail{From: "crm@crm.ru", MessageID: strings.Trim(messageID(addr), "<>")}
+
+	if got, ok := m.ReplyTaskFor("secret", "ivan@mail.ru"); !ok || got != task {
+		t.Errorf("ReplyTaskFor() = %v, %v, want %v", got, ok, task)
+	}
+
+	if _, ok := m.ReplyTaskFor("secret", "petr@mail.ru"); ok {
+		t.Error("ReplyTaskFor() matched other recipient")
+	}
+}
+
+func TestAuthenticated(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		servID  string
+		wantErr bool
+	}{
+		{name: "no mta", wantErr: false},
+		{name: "no results from mta", servID: "mx.crm.ru", wantErr: true},
+		{name: "spf pass", headers: []string{"mx.crm.ru; spf=pass smtp.mailfrom=mail.ru; dkim=none"}, servID: "mx.crm.ru", wantErr: false},
+		{name: "dkim pass of two", headers: []string{"mx.crm.ru 1; dkim=fail header.d=x.ru; dkim=pass (ok) header.d=mail.ru; spf=softfail"}, servID: "mx.crm.ru", wantErr: false},
+		{name: "dmarc fail", headers: []string{"mx.crm.ru; spf=pass; dkim=pass; dmarc=fail header.from=mail.ru"}, servID: "mx.crm.ru", wantErr: true},
+		{name: "spf fail", headers: []string{"mx.crm.ru; spf=fail smtp.mailfrom=mail.ru"}, servID: "mx.crm.ru", wantErr: true},
+		{name: "forged by sender", headers: []string{"mx.crm.ru; spf=fail", "evil.ru; spf=pass"}, servID: "mx.crm.ru", wantErr: true},
+		{name: "fail without mta", headers: []string{"mx.mail.ru; spf=softfail; dkim=none"}, wantErr: true},
+		{name: "none without mta", headers: []string{"mx.mail.ru; spf=none; dkim=none"}, wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := InboundMail{AuthResults: parseAuthResults(tt.headers)}
+
+			err := m.Authenticated(tt.servID)
+			if (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrInboundUnauthenticated)) {
+				t.Errorf("Authenticated() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseInbound(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: =?UTF-8?B?0JjQstCw0L0=?= <Ivan@Mail.ru>",
+		"To: reply+0123@crm.ru",
+		"In-Reply-To: <reply+0123.abc@crm.ru>",
+		"Authentication-Results: mx.crm.ru; spf=pass smtp.mailfrom=mail.ru",
+		"Subject: =?UTF-8?B?UmU6INCX0LDQtNCw0YfQsA==?=",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b1"`,
+		"",
+		"--b1",
+		`Content-Type: multipart/alternative; boundary="b2"`,
+		"",
+		"--b2",
+		"Content-Type: text/plain; charset=koi8-r",
+		"Content-Transfer-Encoding: base64",
+		"",
+		"88TFzMHOCgo+IPTF09Q=",
+		"--b2",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>html</p>",
+		"--b2--",
+		"--b1",
+		"Content-Type: application/pdf",
+		`Content-Disposition: attachment; filename="report.pdf"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		"JVBERi0=",
+		"--b1--",
+		"",
+	}, "\r\n")
+
+	m, err := ParseInbound([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.From != "ivan@mail.ru" || m.Subject != "Re: Задача" || m.Text != "Сделан" {
+		t.Errorf("ParseInbound() = %q, %q, %q", m.From, m.Subject, m.Text)
+	}
+
+	if len(m.AuthResults) != 1 || m.AuthResults[0] != (AuthResult{ServID: "mx.crm.ru", Method: "spf", Result: "pass"}) {
+		t.Errorf("ParseInbound() auth results = %+v", m.AuthResults)
+	}
+
+	if len(m.Recipients) != 1 || len(m.References) != 1 {
+		t.Errorf("ParseInbound() recipients = %v, references = %v", m.Recipients, m.References)
+	}
+
+	if len(m.Attachments) != 1 || m.Attachments[0].Name != "report.pdf" || string(m.Attachments[0].Data) != "%PDF-" {
+		t.Errorf("ParseInbound() attachments = %+v", m.Attachments)
+	}
+}
@@ -0,0 +1,226 @@
+package emails
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrIntakeEmailUsed = errors.New("адрес уже используется другим проектом")
+
+func (r *EmailRepository) GetIntakeAddress(projectUUID uuid.UUID) (orm IntakeAddress, err error) {
+	err = r.gorm.DB.
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("адрес для заявок не настроен")
+	}
+
+	return orm, err
+}
+
+// FindIntakeAddress - адрес для заявок среди адресов получателей.
+func (r *EmailRepository) FindIntakeAddress(emails []string) (orm IntakeAddress, err error) {
+	err = r.gorm.DB.
+		Where("lower(email) in ?", emails).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	return orm, err
+}
+
+// SaveIntakeAddress - у проекта один адрес для заявок, адрес не может принадлежать двум проектам.
+func (r *EmailRepository) SaveIntakeAddress(orm IntakeAddress) (IntakeAddress, error) {
+	err := r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		var used int64
+
+		err := tx.Model(&IntakeAddress{}).
+			Where("lower(email) = ?", strings.ToLower(orm.Email)).
+			Where("project_uuid <> ?", orm.ProjectUUID).
+			Where("deleted_at is null").
+			Count(&used).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if used > 0 {
+			return ErrIntakeEmailUsed
+		}
+
+		existing := IntakeAddress{}
+
+		err = tx.
+			Where("project_uuid = ?", orm.ProjectUUID).
+			Where("deleted_at is null").
+			Limit(1).
+			Find(&existing).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if existing.UUID == uuid.Nil {
+			return tx.Create(&orm).Error
+		}
+
+		orm.UUID = existing.UUID
+		orm.CreatedAt = existing.CreatedAt
+
+		return tx.Model(&IntakeAddress{}).
+			Where("uuid = ?", existing.UUID).
+			Updates(map[string]interface{}{
+				"email":           orm.Email,
+				"created_by":      orm.CreatedBy,
+				"created_by_uuid": orm.CreatedByUUID,
+				"responsible_by":  orm.ResponsibleBy,
+				"updated_at":      gorm.Expr("now()"),
+			}).
+			Error
+	})
+
+	return orm, err
+}
+
+func (r *EmailRepository) DeleteIntakeAddress(projectUUID uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&IntakeAddress{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("deleted_at is null").
+		Update("deleted_at", gorm.Expr("now()"))
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("адрес для заявок не настроен")
+	}
+
+	return nil
+}
+
+// FindIntakeMessage - первое известное письмо переписки из списка Message-ID.
+func (r *EmailRepository) FindIntakeMessage(messageIDs []string) (orm IntakeMessage, err error) {
+	err = r.gorm.DB.
+		Where("message_id in ?", messageIDs).
+		Order("created_at").
+		Take(&orm).
+		Error
+
+	return orm, err
+}
+
+// StoreIntakeMessage - повторная доставка того же письма не меняет запись.
+func (r *EmailRepository) StoreIntakeMessage(orm IntakeMessage) error {
+	return r.gorm.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&orm).
+		Error
+}
+
+func intakeAddressToDomain(orm IntakeAddress) domain.IntakeAddress {
+	return domain.IntakeAddress{
+		UUID:          orm.UUID,
+		ProjectUUID:   orm.ProjectUUID,
+		Email:         orm.Email,
+		CreatedBy:     orm.CreatedBy,
+		CreatedByUUID: orm.CreatedByUUID,
+		ResponsibleBy: orm.ResponsibleBy,
+		CreatedAt:     orm.CreatedAt,
+		UpdatedAt:     orm.UpdatedAt,
+	}
+}
+
+func (e *Emails) IntakeAddress(projectUUID uuid.UUID) (domain.IntakeAddress, error) {
+	orm, err := e.repo.GetIntakeAddress(projectUUID)
+
+	return intakeAddressToDomain(orm), err
+}
+
+func (e *Emails) SaveIntakeAddress(a domain.IntakeAddress) (domain.IntakeAddress, error) {
+	orm, err := e.repo.SaveIntakeAddress(IntakeAddress{
+		ProjectUUID:   a.ProjectUUID,
+		Email:         strings.ToLower(strings.TrimSpace(a.Email)),
+		CreatedBy:     a.CreatedBy,
+		CreatedByUUID: a.CreatedByUUID,
+		ResponsibleBy: a.ResponsibleBy,
+	})
+
+	return intakeAddressToDomain(orm), err
+}
+
+func (e *Emails) DeleteIntakeAddress(projectUUID uuid.UUID) error {
+	return e.repo.DeleteIntakeAddress(projectUUID)
+}
+
+// FindIntakeAddress - адрес для заявок, на который пришло письмо.
+func (e *Emails) FindIntakeAddress(m InboundMail) (domain.IntakeAddress, bool, error) {
+	emails := m.RecipientAddresses()
+	if len(emails) == 0 {
+		return domain.IntakeAddress{}, false, nil
+	}
+
+	orm, err := e.repo.FindIntakeAddress(emails)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.IntakeAddress{}, false, nil
+	}
+
+	return intakeAddressToDomain(orm), err == nil, err
+}
+
+// FindIntakeThread - задача переписки, в которой отвечает письмо (по In-Reply-To и References).
+func (e *Emails) FindIntakeThread(m InboundMail) (domain.IntakeMessage, bool, error) {
+	ids := m.ThreadIDs()
+	if len(ids) == 0 {
+		return domain.IntakeMessage{}, false, nil
+	}
+
+	orm, err := e.repo.FindIntakeMessage(ids)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.IntakeMessage{}, false, nil
+	}
+
+	return domain.IntakeMessage{
+		MessageID: orm.MessageID,
+		TaskUUID:  orm.TaskUUID,
+		AgentUUID: orm.AgentUUID,
+		CreatedAt: orm.CreatedAt,
+	}, err == nil, err
+}
+
+// StoreIntakeMessage - письмо без Message-ID не сохраняется: ответы на него не найти.
+func (e *Emails) StoreIntakeMessage(m domain.IntakeMessage) error {
+	if m.MessageID == "" {
+		return nil
+	}
+
+	return e.repo.StoreIntakeMessage(IntakeMessage{
+		MessageID: m.MessageID,
+		TaskUUID:  m.TaskUUID,
+		AgentUUID: m.AgentUUID,
+	})
+}
+
+// IsIntakeMessage - письмо уже обработано (повторная доставка MTA или из maildir).
+func (e *Emails) IsIntakeMessage(messageID string) (bool, error) {
+	if messageID == "" {
+		return false, nil
+	}
+
+	_, err := e.repo.FindIntakeMessage([]string{messageID})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
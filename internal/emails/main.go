@@ -1,148 +1,94 @@
 package emails
 
 import (
-	"crypto/tls"
-	"fmt"
-	"net/smtp"
-	"regexp"
-	"strings"
+	"net/mail"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/configs"
-	"github.com/sirupsen/logrus"
 )
 
 type IEmailsService interface {
 	SendEmail(to []string, message IMessage) error
+	SendEmailReplyTo(to []string, replyTo string, message IMessage) error
+
+	ClaimDueMails(now time.Time, limit int) ([]domain.Mail, error)
+	DeliverMail(m domain.Mail) error
+	MarkMailFailed(m domain.Mail, reason error, now time.Time) error
+	SearchMails(filter dto.MailSearchDTO) ([]domain.Mail, int64, error)
+	ResendMail(uid uuid.UUID) error
+
+	Capture() (*MemoryTransport, bool)
+
+	NewMessage(templateType string, scope domain.EmailScope, lang string, data TemplateData) (IMessage, error)
+	Templates(federationUUID uuid.UUID) ([]domain.EmailTemplate, error)
+	SaveTemplate(t domain.EmailTemplate) (domain.EmailTemplate, error)
+	DeleteTemplate(templateType, lang string, scope domain.EmailScope) error
+	PreviewTemplate(t domain.EmailTemplate) (IMessage, error)
+	Branding(federationUUID uuid.UUID) (domain.EmailBranding, error)
+	SaveBranding(federationUUID, createdBy uuid.UUID, brand domain.EmailBranding) error
+
+	IntakeAddress(projectUUID uuid.UUID) (domain.IntakeAddress, error)
+	SaveIntakeAddress(a domain.IntakeAddress) (domain.IntakeAddress, error)
+	DeleteIntakeAddress(projectUUID uuid.UUID) error
+	FindIntakeAddress(m InboundMail) (domain.IntakeAddress, bool, error)
+	FindIntakeThread(m InboundMail) (domain.IntakeMessage, bool, error)
+	StoreIntakeMessage(m domain.IntakeMessage) error
+	IsIntakeMessage(messageID string) (bool, error)
 }
 
 type Emails struct {
-	from     string
-	password string
-	smtpHost string
-	smtpPort string
-
-	enable bool
+	from      string
+	transport Transport
 
 	repo *EmailRepository
 }
 
-func New(from, password, smtpHost, smtpPort string, enable bool, r *EmailRepository) IEmailsService {
+func New(from string, transport Transport, r *EmailRepository) IEmailsService {
 	return &Emails{
-		from:     from,
-		password: password,
-		smtpHost: smtpHost,
-		smtpPort: smtpPort,
-
-		enable: enable,
+		from:      from,
+		transport: transport,
 
 		repo: r,
 	}
 }
 
+// NewFromCreds - транспорт из SMTP_CREDS (см. ParseDSN), при SMTP_ENABLE=false в лог пишутся только получатели и тема писем.
 func NewFromCreds(conf *configs.Configs, r *EmailRepository) (IEmailsService, error) {
-	// parse string: smtp://johndoe@yandex.ru:xxxxxx:smtp.yandex.ru:465
-	pattern := regexp.MustCompile(`smtp://(?P<password>[^:]+):(?P<user>[^:]+):(?P<host>[^:]+):(?P<port>[^:]+)`)
-	sub := pattern.FindStringSubmatch(conf.SMTP_CREDS)
-
-	if len(sub) != 5 {
-		return &Emails{}, fmt.Errorf("invalid smtp connection string")
+	transport, from, err := ParseDSN(conf.SMTP_CREDS)
+	if err != nil {
+		return &Emails{}, err
 	}
 
-	password := sub[1]
-	user := sub[2]
-	host := sub[3]
-	port := sub[4]
+	if !conf.SMTP_ENABLE {
+		transport = LogTransport{}
+	}
 
-	return New(user, password, host, port, conf.SMTP_ENABLE, r), nil
+	return New(from, transport, r), nil
 }
 
+// SendEmail - ставит письмо в очередь, отправляют воркеры (см. DeliverMail).
 func (e *Emails) SendEmail(to []string, message IMessage) error {
-	if e.enable {
-
-		header := ""
-		header += fmt.Sprintf("From: %s\r\n", e.from)
-
-		header += fmt.Sprintf("To: %s\r\n", strings.Join(to, ";"))
-
-		subject := "Subject: " + message.GetSubject() + "\n"
-		mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-		body := message.GetBody() + "\n"
-		msg := []byte(header + subject + mime + body)
-
-		// Create authentication
-		auth := smtp.PlainAuth("", e.from, e.password, e.smtpHost)
-
-		logrus.Debug(e.from, e.password, e.smtpHost, e.smtpHost+":"+e.smtpPort, msg)
-
-		tlsConfig := &tls.Config{
-			MinVersion:         tls.VersionTLS12,
-			InsecureSkipVerify: false,
-			ServerName:         e.smtpHost,
-		}
-
-		conn, err := tls.Dial("tcp", e.smtpHost+":"+e.smtpPort, tlsConfig)
-		if err != nil {
-			return err
-		}
-
-		client, err := smtp.NewClient(conn, e.smtpHost)
-		if err != nil {
-			return err
-		}
-
-		// step 1: Use Auth
-		err = client.Auth(auth)
-		if err != nil {
-			return err
-		}
-
-		// step 2: add all from and to
-		err = client.Mail(e.from)
-		if err != nil {
-			return err
-		}
-
-		for _, k := range to {
-			logrus.Debug("sending to: ", k)
-			err = client.Rcpt(k)
-			if err != nil {
-				return err
-			}
-		}
-
-		// Data
-		w, err := client.Data()
-		if err != nil {
-			return err
-		}
-
-		_, err = w.Write(msg)
-		if err != nil {
-			return err
-		}
-
-		err = w.Close()
-		if err != nil {
-			return err
-		}
-
-		err = client.Quit()
-		if err != nil {
-			return err
-		}
-
-		logrus.WithFields(logrus.Fields{
-			"module":  "emails",
-			"to":      to,
-			"from":    e.from,
-			"subject": message.GetSubject(),
-		}).Debug("Email was sent to: ", to)
-	}
+	return e.SendEmailReplyTo(to, "", message)
+}
 
-	_, err := e.repo.StoreEmail(message)
-	if err != nil {
-		return err
+// SendEmailReplyTo - письмо с адресом для ответа, пустой replyTo - ответ отправителю.
+func (e *Emails) SendEmailReplyTo(to []string, replyTo string, message IMessage) error {
+	from := e.from
+	if name := message.GetSenderName(); name != "" {
+		from = (&mail.Address{Name: name, Address: e.from}).String()
 	}
 
-	return nil
+	_, err := e.repo.StoreEmail(from, replyTo, to, message)
+
+	return err
+}
+
+// Capture - письма в памяти, если выбран транспорт memory://.
+func (e *Emails) Capture() (*MemoryTransport, bool) {
+	t, ok := e.transport.(*MemoryTransport)
+
+	return t, ok
 }
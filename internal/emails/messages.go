@@ -2,19 +2,27 @@ package emails
 
 import (
 	"bytes"
+	htmltemplate "html/template"
+	"strings"
 	"text/template"
 
+	"github.com/krisch/crm-backend/domain"
+
 	_ "embed"
 )
 
 type IMessage interface {
 	GetSubject() string
 	GetBody() string
+	GetSenderName() string
+	GetListUnsubscribe() string
 }
 
 type Message struct {
-	subject string
-	body    string
+	subject         string
+	body            string
+	senderName      string
+	listUnsubscribe string
 }
 
 func (m Message) GetSubject() string {
@@ -25,6 +33,16 @@ func (m Message) GetBody() string {
 	return m.body
 }
 
+// GetSenderName - имя отправителя из оформления федерации, пустое - только адрес.
+func (m Message) GetSenderName() string {
+	return m.senderName
+}
+
+// GetListUnsubscribe - адрес отписки в один клик, если письмо - рассылка.
+func (m Message) GetListUnsubscribe() string {
+	return m.listUnsubscribe
+}
+
 // Confirmation email template
 //
 //go:embed confirmation.html
@@ -33,46 +51,165 @@ var confirmationTmpl string
 //go:embed reset.html
 var resetTmpl string
 
-func NewConfirmationMessage(code string) (IMessage, error) {
-	templateData := struct {
-		Code string
-	}{
-		Code: code,
-	}
+//go:embed digest.html
+var digestTmpl string
+
+//go:embed notification.html
+var notificationTmpl string
 
-	body, err := parseTemplate("confirmation", confirmationTmpl, templateData)
+type DigestComment struct {
+	Author  string
+	At      string
+	Mention bool
 
-	if err == nil {
-		return Message{
-			subject: "Подтверждение профиля",
-			body:    body,
-		}, nil
+	// HTML - безопасный HTML комментария (domain.RenderMarkdown).
+	HTML htmltemplate.HTML
+}
+
+// NewDigestComment - комментарий дайджеста, markdown рендерится в HTML с экранированием пользовательского текста.
+func NewDigestComment(author, at string, mention bool, markdown string) DigestComment {
+	return DigestComment{
+		Author:  author,
+		At:      at,
+		Mention: mention,
+		//nolint:gosec // RenderMarkdown экранирует весь текст, см. TestNewDigestMessageHostileComment
+		HTML: htmltemplate.HTML(domain.RenderMarkdown(markdown)),
 	}
+}
+
+type DigestTask struct {
+	ID   int
+	Name string
+	URL  string
+
+	Comments  []DigestComment
+	Uploads   []string
+	Reminders []string
+}
+
+// Branded - оформление письма, в шаблонах доступно как {{ .Brand.LogoURL }}.
+type Branded struct {
+	Brand domain.EmailBranding
+}
 
-	return Message{}, nil
+func (b *Branded) setBrand(brand domain.EmailBranding) {
+	b.Brand = brand
+}
+
+// TemplateData - данные шаблона письма: *CodeData, *DigestData или *NotificationData.
+type TemplateData interface {
+	setBrand(brand domain.EmailBranding)
+}
+
+// unsubscribable - данные рассылки со ссылкой отписки.
+type unsubscribable interface {
+	listUnsubscribe() string
+}
+
+type CodeData struct {
+	Branded
+
+	Code string
+}
+
+type DigestData struct {
+	Branded
+
+	Name   string
+	Period string
+	Tasks  []DigestTask
+
+	UnsubscribeURL string
+}
+
+func (d *DigestData) listUnsubscribe() string {
+	return d.UnsubscribeURL
+}
+
+func NewConfirmationMessage(code string) (IMessage, error) {
+	return newDefaultMessage(domain.EmailTemplateConfirmation, &CodeData{Code: code})
 }
 
 func NewResetMessage(code string) (IMessage, error) {
-	templateData := struct {
-		Code string
-	}{
-		Code: code,
+	return newDefaultMessage(domain.EmailTemplateReset, &CodeData{Code: code})
+}
+
+func NewDigestMessage(data DigestData) (IMessage, error) {
+	return newDefaultMessage(domain.EmailTemplateDigest, &data)
+}
+
+type NotificationData struct {
+	Branded
+
+	Subject string
+	Name    string
+	Text    string
+	URL     string
+}
+
+func NewNotificationMessage(subject string, data NotificationData) (IMessage, error) {
+	data.Subject = subject
+
+	return newDefaultMessage(domain.EmailTemplateNotification, &data)
+}
+
+// defaultTemplates - встроенные шаблоны на LangDefault, если в templates нет подходящего.
+var defaultTemplates = map[string]domain.EmailTemplate{
+	domain.EmailTemplateConfirmation: {Subject: "Подтверждение профиля", Body: confirmationTmpl},
+	domain.EmailTemplateReset:        {Subject: "Сброс пароля", Body: resetTmpl},
+	domain.EmailTemplateDigest:       {Subject: "Непрочитанные уведомления", Body: digestTmpl},
+	domain.EmailTemplateNotification: {Subject: "{{ .Subject }}", Body: notificationTmpl},
+}
+
+func newDefaultMessage(templateType string, data TemplateData) (IMessage, error) {
+	return renderMessage(defaultTemplates[templateType], domain.EmailBranding{}, data)
+}
+
+// renderMessage - тема (text/template, в одну строку) и тело (html/template) письма с оформлением brand.
+// Обращение к несуществующему полю данных - ошибка, так проверяются шаблоны при сохранении.
+func renderMessage(tmpl domain.EmailTemplate, brand domain.EmailBranding, data TemplateData) (IMessage, error) {
+	data.setBrand(brand)
+
+	subject, err := parseTemplate(tmpl.Type+":subject", tmpl.Subject, data)
+	if err != nil {
+		return Message{}, err
 	}
 
-	body, err := parseTemplate("reset", resetTmpl, templateData)
+	body, err := parseHTMLTemplate(tmpl.Type+":body", tmpl.Body, data)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg := Message{
+		subject:    strings.Join(strings.Fields(subject), " "),
+		body:       body,
+		senderName: brand.SenderName,
+	}
 
-	if err == nil {
-		return Message{
-			subject: "Сброс пароля",
-			body:    body,
-		}, nil
+	if u, ok := data.(unsubscribable); ok {
+		msg.listUnsubscribe = u.listUnsubscribe()
 	}
 
-	return Message{}, nil
+	return msg, nil
 }
 
 func parseTemplate(name, templateString string, data interface{}) (string, error) {
-	t, err := template.New(name).Parse(templateString)
+	t, err := template.New(name).Option("missingkey=error").Parse(templateString)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// parseHTMLTemplate - для писем с пользовательским текстом, экранирует все кроме htmltemplate.HTML.
+func parseHTMLTemplate(name, templateString string, data interface{}) (string, error) {
+	t, err := htmltemplate.New(name).Option("missingkey=error").Parse(templateString)
 	if err != nil {
 		return "", err
 	}
@@ -2,9 +2,13 @@ package emails
 
 import (
 	_ "embed"
+	"errors"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/helpers"
 )
 
@@ -52,3 +56,156 @@ func TestNewConfirmationMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDigestMessage(t *testing.T) {
+	got, err := NewDigestMessage(DigestData{
+		Name:   "<Иван>",
+		Period: "за день",
+		Tasks: []DigestTask{{
+			ID:   42,
+			Name: "Отчет <script>",
+			URL:  "https://crm.example/task/42",
+			Comments: []DigestComment{
+				{Author: "Петр", At: "10:00", Mention: true, HTML: "<p><strong>готово</strong></p>"},
+			},
+			Uploads: []string{"a.pdf", "b.pdf"},
+		}},
+		UnsubscribeURL: "https://crm.example/unsubscribe?token=1&email=a",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, look := range []string{"&lt;Иван&gt;", "Отчет &lt;script&gt;", "<strong>готово</strong>", "a.pdf, b.pdf", "Вас упомянули", "token=1&amp;email=a"} {
+		if !strings.Contains(got.GetBody(), look) {
+			t.Errorf("NewDigestMessage() body does not contain %q", look)
+		}
+	}
+
+	if got.GetListUnsubscribe() != "https://crm.example/unsubscribe?token=1&email=a" {
+		t.Errorf("NewDigestMessage() list unsubscribe = %q", got.GetListUnsubscribe())
+	}
+}
+
+func TestNewDigestMessageHostileComment(t *testing.T) {
+	hostile := []string{
+		`<img src=x onerror="alert(1)">`,
+		"@[a https://x.com/a/onmouseover=location=/javascript:alert%281%29/.source//]",
+		"[клик](javascript:alert(1)) `</code><script>alert(1)</script>`",
+	}
+
+	comments := []DigestComment{}
+	for _, c := range hostile {
+		comments = append(comments, NewDigestComment("Петр", "10:00", false, c))
+	}
+
+	got, err := NewDigestMessage(DigestData{
+		Name:   "Иван",
+		Period: "за день",
+		Tasks:  []DigestTask{{ID: 42, Name: "Отчет", URL: "https://crm.example/task/42", Comments: comments}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := got.GetBody()
+	for _, bad := range []string{"<img", "<script", `href="javascript`, `" onmouseover`, "\x00"} {
+		if strings.Contains(body, bad) {
+			t.Errorf("NewDigestMessage() body contains %q", bad)
+		}
+	}
+
+	if m := regexp.MustCompile(`="[^"]*<`).FindString(body); m != "" {
+		t.Errorf("NewDigestMessage() body has HTML inside an attribute: %q", m)
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	brand := domain.EmailBranding{LogoURL: "https://crm.example/logo.png", PrimaryColor: "#1a2b3c", SenderName: "Рога и копыта"}
+
+	got, err := renderMessage(defaultTemplates[domain.EmailTemplateNotification], brand, &NotificationData{
+		Subject: "Задача\r\nBcc: x@mail.ru",
+		Name:    "Иван",
+		Text:    "Вас назначили",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.GetSubject() != "Задача Bcc: x@mail.ru" {
+		t.Errorf("renderMessage() subject = %q, want one line", got.GetSubject())
+	}
+
+	if got.GetSenderName() != brand.SenderName {
+		t.Errorf("renderMessage() sender = %q, want %q", got.GetSenderName(), brand.SenderName)
+	}
+
+	for _, look := range []string{`src="https://crm.example/logo.png"`, "color: #1a2b3c", "Вас назначили"} {
+		if !strings.Contains(got.GetBody(), look) {
+			t.Errorf("renderMessage() body does not contain %q", look)
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    domain.EmailTemplate
+		wantErr error
+	}{
+		{
+			name: "ok",
+			tmpl: domain.EmailTemplate{Type: domain.EmailTemplateConfirmation, Lang: domain.LangEn, Subject: "Code", Body: "<p>{{ .Code }} {{ .Brand.SenderName }}</p>"},
+		},
+		{
+			name:    "unknown variable",
+			tmpl:    domain.EmailTemplate{Type: domain.EmailTemplateConfirmation, Lang: domain.LangRu, Subject: "Код", Body: "{{ .Name }}"},
+			wantErr: ErrEmailTemplate,
+		},
+		{
+			name:    "syntax",
+			tmpl:    domain.EmailTemplate{Type: domain.EmailTemplateDigest, Lang: domain.LangRu, Subject: "{{ .Name ", Body: "ok"},
+			wantErr: ErrEmailTemplate,
+		},
+		{
+			name:    "type",
+			tmpl:    domain.EmailTemplate{Type: domain.EmailBrandingType, Lang: domain.LangRu, Subject: "a", Body: "b"},
+			wantErr: ErrEmailTemplateType,
+		},
+		{
+			name:    "lang",
+			tmpl:    domain.EmailTemplate{Type: domain.EmailTemplateReset, Lang: "de", Subject: "a", Body: "b"},
+			wantErr: ErrEmailTemplateLang,
+		},
+		{
+			name:    "global type in federation",
+			tmpl:    domain.EmailTemplate{Type: domain.EmailTemplateReset, Lang: domain.LangRu, FederationUUID: uuid.New(), Subject: "Код", Body: "{{ .Code }}"},
+			wantErr: ErrEmailTemplateScope,
+		},
+		{
+			name: "notification in federation",
+			tmpl: domain.EmailTemplate{Type: domain.EmailTemplateNotification, Lang: domain.LangRu, FederationUUID: uuid.New(), Subject: "{{ .Subject }}", Body: "{{ .Text }}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTemplate(tt.tmpl)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Errorf("validateTemplate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultTemplatesValid(t *testing.T) {
+	for _, templateType := range domain.EmailTemplateTypes {
+		tmpl := defaultTemplates[templateType]
+		tmpl.Type = templateType
+		tmpl.Lang = domain.LangDefault
+
+		if err := validateTemplate(tmpl); err != nil {
+			t.Errorf("default %s template: %v", templateType, err)
+		}
+	}
+}
@@ -3,17 +3,69 @@ package emails
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/datatypes"
 )
 
 type Mail struct {
 	UUID    string `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
-	From    string `gorm:"type:varchar(100);default:'';not null"`
-	To      string `gorm:"type:varchar(100);default:'';not null;"`
+	From    string `gorm:"type:text;default:'';not null"`
+	ReplyTo string `gorm:"type:text;default:'';not null"`
+	To      string `gorm:"type:text;default:'';not null;"`
 	Subject string `gorm:"type:varchar(200);default:'';not null;"`
 	Text    string `gorm:"type:text;default:'';not null;"`
 
+	ListUnsubscribe string `gorm:"type:text;default:'';not null"`
+
+	Status        string     `gorm:"type:varchar(20);default:'pending';not null"`
+	Attempts      int        `gorm:"type:int;default:0;not null"`
+	Error         string     `gorm:"type:text;default:'';not null"`
+	NextAttemptAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	SentAt        *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
 	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
 
 	Meta datatypes.JSON `gorm:"default:'{}';not null;"`
 }
+
+// Template - шаблон письма или оформление федерации (EmailBrandingType, Template - json).
+type Template struct {
+	UUID           uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	CreatedBy      uuid.UUID  `gorm:"type:uuid;not null"`
+	FederationUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	CompanyUUID    *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	ProjectUUID    *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	UserUUID       *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	Type     string `gorm:"type:varchar(20)"`
+	Lang     string `gorm:"type:varchar(10);default:'';not null"`
+	Subject  string `gorm:"type:text;default:'';not null"`
+	Template string `gorm:"type:text"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+// IntakeAddress - адрес проекта для заявок по почте.
+type IntakeAddress struct {
+	UUID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	ProjectUUID   uuid.UUID `gorm:"type:uuid;not null"`
+	Email         string    `gorm:"type:varchar(100);not null"`
+	CreatedBy     string    `gorm:"type:varchar(100);not null"`
+	CreatedByUUID uuid.UUID `gorm:"type:uuid;not null"`
+	ResponsibleBy string    `gorm:"type:varchar(100);default:'';not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+// IntakeMessage - Message-ID письма переписки по задаче из заявки.
+type IntakeMessage struct {
+	MessageID string     `gorm:"type:text;primary_key:true"`
+	TaskUUID  uuid.UUID  `gorm:"type:uuid;not null"`
+	AgentUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+}
@@ -0,0 +1,55 @@
+package emails
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+)
+
+// ClaimDueMails - берет в отправку письма, у которых подошло время попытки.
+func (e *Emails) ClaimDueMails(now time.Time, limit int) ([]domain.Mail, error) {
+	orms, err := e.repo.ClaimMails(now, limit)
+
+	return lo.Map(orms, func(orm Mail, _ int) domain.Mail {
+		return mailToDomain(orm)
+	}), err
+}
+
+// DeliverMail - отправляет взятое письмо через транспорт и помечает отправленным.
+func (e *Emails) DeliverMail(m domain.Mail) error {
+	// у писем до очереди отправитель не сохранялся
+	m.From = helpers.If(m.From != "", m.From, e.from)
+
+	err := e.transport.Send(m)
+	if err != nil {
+		return err
+	}
+
+	return e.repo.MarkMailSent(m.UUID)
+}
+
+// MarkMailFailed - повтор с экспоненциальной паузой, после MailMaxAttempts попыток письмо остается в dead.
+func (e *Emails) MarkMailFailed(m domain.Mail, reason error, now time.Time) error {
+	if m.Attempts >= domain.MailMaxAttempts {
+		return e.repo.MarkMailFailed(m.UUID, domain.MailDead, reason.Error(), m.NextAttemptAt)
+	}
+
+	return e.repo.MarkMailFailed(m.UUID, domain.MailPending, reason.Error(), m.RetryAt(now))
+}
+
+func (e *Emails) SearchMails(filter dto.MailSearchDTO) ([]domain.Mail, int64, error) {
+	orms, total, err := e.repo.SearchMails(filter)
+
+	return lo.Map(orms, func(orm Mail, _ int) domain.Mail {
+		return mailToDomain(orm)
+	}), total, err
+}
+
+// ResendMail - письмо отправляется заново с новым счетчиком попыток.
+func (e *Emails) ResendMail(uid uuid.UUID) error {
+	return e.repo.ResendMail(uid)
+}
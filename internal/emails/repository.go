@@ -1,9 +1,19 @@
 package emails
 
 import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"gorm.io/gorm"
 )
 
+// Взятое в отправку письмо, не завершенное за это время (упал инстанс), отправляется повторно.
+const mailSendingTimeout = 10 * time.Minute
+
 type EmailRepository struct {
 	gorm *postgres.GDB
 }
@@ -14,10 +24,17 @@ func NewRepository(db *postgres.GDB) *EmailRepository {
 	}
 }
 
-func (r *EmailRepository) StoreEmail(email IMessage) (string, error) {
+// StoreEmail - ставит письмо в очередь отправки.
+func (r *EmailRepository) StoreEmail(from, replyTo string, to []string, email IMessage) (string, error) {
 	mail := &Mail{
+		From:    from,
+		ReplyTo: replyTo,
+		To:      strings.Join(to, ","),
 		Subject: email.GetSubject(),
 		Text:    email.GetBody(),
+		Status:  domain.MailPending,
+
+		ListUnsubscribe: email.GetListUnsubscribe(),
 	}
 
 	err := r.gorm.DB.Create(&mail).Error
@@ -27,3 +44,131 @@ func (r *EmailRepository) StoreEmail(email IMessage) (string, error) {
 
 	return mail.UUID, nil
 }
+
+// ClaimMails - зависшее в отправке письмо берется повторно, пока не исчерпаны попытки, затем помечается недоставленным.
+func (r *EmailRepository) ClaimMails(now time.Time, limit int) (orms []Mail, err error) {
+	err = r.gorm.DB.Exec(`
+		UPDATE mails
+		SET status = ?, error = ?, updated_at = now()
+		WHERE status = ? AND updated_at < ? AND attempts >= ?`,
+		domain.MailDead, "отправка прервана, попытки исчерпаны",
+		domain.MailSending, now.Add(-mailSendingTimeout), domain.MailMaxAttempts,
+	).Error
+	if err != nil {
+		return orms, err
+	}
+
+	err = r.gorm.DB.Raw(`
+		UPDATE mails
+		SET status = ?, attempts = attempts + 1, updated_at = now()
+		WHERE uuid IN (
+			SELECT uuid FROM mails
+			WHERE (status = ? AND next_attempt_at <= ?) OR (status = ? AND updated_at < ? AND attempts < ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.MailSending,
+		domain.MailPending, now,
+		domain.MailSending, now.Add(-mailSendingTimeout), domain.MailMaxAttempts,
+		limit,
+	).Scan(&orms).Error
+
+	return orms, err
+}
+
+func (r *EmailRepository) MarkMailSent(uid uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&Mail{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"status":     domain.MailSent,
+			"error":      "",
+			"sent_at":    gorm.Expr("now()"),
+			"updated_at": gorm.Expr("now()"),
+		}).
+		Error
+}
+
+func (r *EmailRepository) MarkMailFailed(uid uuid.UUID, status, reason string, nextAttemptAt time.Time) error {
+	return r.gorm.DB.
+		Model(&Mail{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"error":           reason,
+			"next_attempt_at": nextAttemptAt,
+			"updated_at":      gorm.Expr("now()"),
+		}).
+		Error
+}
+
+func (r *EmailRepository) SearchMails(filter dto.MailSearchDTO) (orms []Mail, total int64, err error) {
+	q := r.gorm.DB.Model(&Mail{})
+
+	if filter.Status != nil {
+		q = q.Where("status = ?", *filter.Status)
+	}
+
+	if filter.To != nil {
+		q = q.Where(`"to" ilike ?`, "%"+*filter.To+"%")
+	}
+
+	err = q.Count(&total).Error
+	if err != nil {
+		return orms, total, err
+	}
+
+	err = q.
+		Order("created_at desc").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&orms).
+		Error
+
+	return orms, total, err
+}
+
+// ResendMail - возвращает письмо в очередь, в том числе уже отправленное.
+func (r *EmailRepository) ResendMail(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&Mail{}).
+		Where("uuid = ? and status <> ?", uid, domain.MailSending).
+		Updates(map[string]interface{}{
+			"status":          domain.MailPending,
+			"attempts":        0,
+			"error":           "",
+			"next_attempt_at": gorm.Expr("now()"),
+			"updated_at":      gorm.Expr("now()"),
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("письмо не найдено или отправляется")
+	}
+
+	return nil
+}
+
+func mailToDomain(orm Mail) domain.Mail {
+	return domain.Mail{
+		UUID:            uuid.MustParse(orm.UUID),
+		From:            orm.From,
+		ReplyTo:         orm.ReplyTo,
+		To:              strings.Split(orm.To, ","),
+		Subject:         orm.Subject,
+		Body:            orm.Text,
+		ListUnsubscribe: orm.ListUnsubscribe,
+		Status:          orm.Status,
+		Attempts:        orm.Attempts,
+		Error:           orm.Error,
+		NextAttemptAt:   orm.NextAttemptAt,
+		SentAt:          orm.SentAt,
+		CreatedAt:       orm.CreatedAt,
+		UpdatedAt:       orm.UpdatedAt,
+	}
+}
@@ -0,0 +1,339 @@
+package emails
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEmailTemplateType  = errors.New("неизвестный тип шаблона письма")
+	ErrEmailTemplateScope = errors.New("шаблон этого типа задается только для всех федераций")
+	ErrEmailTemplateLang  = errors.New("неизвестный язык шаблона письма")
+	ErrEmailTemplate      = errors.New("ошибка в шаблоне письма")
+)
+
+func uuidPtr(uid uuid.UUID) *uuid.UUID {
+	return helpers.If(uid == uuid.Nil, nil, &uid)
+}
+
+// scopeQuery - шаблоны ровно этой области, uuid.Nil - без федерации или компании.
+func scopeQuery(q *gorm.DB, scope domain.EmailScope) *gorm.DB {
+	if scope.FederationUUID == uuid.Nil {
+		q = q.Where("federation_uuid is null")
+	} else {
+		q = q.Where("federation_uuid = ?", scope.FederationUUID)
+	}
+
+	if scope.CompanyUUID == uuid.Nil {
+		return q.Where("company_uuid is null")
+	}
+
+	return q.Where("company_uuid = ?", scope.CompanyUUID)
+}
+
+// GetTemplates - шаблоны писем федерации и ее компаний, для uuid.Nil - общие.
+func (r *EmailRepository) GetTemplates(federationUUID uuid.UUID) (orms []Template, err error) {
+	q := r.gorm.DB.
+		Where("deleted_at is null").
+		Where("type in ?", domain.EmailTemplateTypes)
+
+	if federationUUID == uuid.Nil {
+		q = q.Where("federation_uuid is null")
+	} else {
+		q = q.Where("federation_uuid = ?", federationUUID)
+	}
+
+	err = q.
+		Order("type, lang, company_uuid nulls first").
+		Find(&orms).
+		Error
+
+	return orms, err
+}
+
+// FindTemplates - шаблоны типа, подходящие области: ее компании, ее федерации и общие.
+func (r *EmailRepository) FindTemplates(templateType string, scope domain.EmailScope) (orms []Template, err error) {
+	err = r.gorm.DB.
+		Where("deleted_at is null").
+		Where("type = ?", templateType).
+		Where("federation_uuid is null or federation_uuid = ?", scope.FederationUUID).
+		Where("company_uuid is null or company_uuid = ?", scope.CompanyUUID).
+		Find(&orms).
+		Error
+
+	return orms, err
+}
+
+// SaveTemplate - создает или заменяет шаблон типа и языка в области.
+func (r *EmailRepository) SaveTemplate(orm Template) (Template, error) {
+	err := r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		existing := Template{}
+
+		err := scopeQuery(tx, domain.EmailScope{
+			FederationUUID: lo.FromPtr(orm.FederationUUID),
+			CompanyUUID:    lo.FromPtr(orm.CompanyUUID),
+		}).
+			Where("deleted_at is null").
+			Where("type = ? and lang = ?", orm.Type, orm.Lang).
+			Limit(1).
+			Find(&existing).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if existing.UUID == uuid.Nil {
+			return tx.Create(&orm).Error
+		}
+
+		orm.UUID = existing.UUID
+
+		return tx.Model(&Template{}).
+			Where("uuid = ?", existing.UUID).
+			Updates(map[string]interface{}{
+				"subject":    orm.Subject,
+				"template":   orm.Template,
+				"created_by": orm.CreatedBy,
+				"updated_at": gorm.Expr("now()"),
+			}).
+			Error
+	})
+
+	return orm, err
+}
+
+func (r *EmailRepository) DeleteTemplate(templateType, lang string, scope domain.EmailScope) error {
+	res := scopeQuery(r.gorm.DB.Model(&Template{}), scope).
+		Where("deleted_at is null").
+		Where("type = ? and lang = ?", templateType, lang).
+		Update("deleted_at", gorm.Expr("now()"))
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("шаблон не найден")
+	}
+
+	return nil
+}
+
+func templateToDomain(orm Template) domain.EmailTemplate {
+	return domain.EmailTemplate{
+		UUID:           orm.UUID,
+		Type:           orm.Type,
+		Lang:           orm.Lang,
+		FederationUUID: lo.FromPtr(orm.FederationUUID),
+		CompanyUUID:    lo.FromPtr(orm.CompanyUUID),
+
+		Subject: orm.Subject,
+		Body:    orm.Template,
+
+		CreatedBy: orm.CreatedBy,
+		UpdatedAt: orm.UpdatedAt,
+	}
+}
+
+// NewMessage - письмо по шаблону из templates (см. domain.PickEmailTemplate) или встроенному, с оформлением федерации.
+// Если сохраненный шаблон не отрисовался, письмо уходит по встроенному.
+func (e *Emails) NewMessage(templateType string, scope domain.EmailScope, lang string, data TemplateData) (IMessage, error) {
+	brand, err := e.Branding(scope.FederationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	orms, err := e.repo.FindTemplates(templateType, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, ok := domain.PickEmailTemplate(lo.Map(orms, func(orm Template, _ int) domain.EmailTemplate {
+		return templateToDomain(orm)
+	}), scope, lang)
+	if !ok {
+		return renderMessage(defaultTemplates[templateType], brand, data)
+	}
+
+	msg, err := renderMessage(tmpl, brand, data)
+	if err != nil {
+		logrus.WithField("template", tmpl.UUID).Error("email template render error: ", err)
+
+		return renderMessage(defaultTemplates[templateType], brand, data)
+	}
+
+	return msg, nil
+}
+
+// Templates - сохраненные шаблоны федерации (с шаблонами ее компаний), для uuid.Nil - общие.
+func (e *Emails) Templates(federationUUID uuid.UUID) ([]domain.EmailTemplate, error) {
+	orms, err := e.repo.GetTemplates(federationUUID)
+
+	return lo.Map(orms, func(orm Template, _ int) domain.EmailTemplate {
+		return templateToDomain(orm)
+	}), err
+}
+
+// SaveTemplate - шаблон проверяется отрисовкой на примере данных: неизвестная переменная или синтаксис - ErrEmailTemplate.
+func (e *Emails) SaveTemplate(t domain.EmailTemplate) (domain.EmailTemplate, error) {
+	err := validateTemplate(t)
+	if err != nil {
+		return t, err
+	}
+
+	orm, err := e.repo.SaveTemplate(Template{
+		CreatedBy:      t.CreatedBy,
+		FederationUUID: uuidPtr(t.FederationUUID),
+		CompanyUUID:    uuidPtr(t.CompanyUUID),
+		Type:           t.Type,
+		Lang:           t.Lang,
+		Subject:        t.Subject,
+		Template:       t.Body,
+	})
+	if err != nil {
+		return t, err
+	}
+
+	t.UUID = orm.UUID
+
+	return t, nil
+}
+
+func (e *Emails) DeleteTemplate(templateType, lang string, scope domain.EmailScope) error {
+	return e.repo.DeleteTemplate(templateType, lang, scope)
+}
+
+// PreviewTemplate - письмо на примере данных. Без темы и тела - действующий шаблон области на языке t.Lang.
+func (e *Emails) PreviewTemplate(t domain.EmailTemplate) (IMessage, error) {
+	scope := domain.EmailScope{FederationUUID: t.FederationUUID, CompanyUUID: t.CompanyUUID}
+
+	if t.Subject == "" && t.Body == "" {
+		err := validateTemplateType(t)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.NewMessage(t.Type, scope, t.Lang, sampleData(t.Type))
+	}
+
+	err := validateTemplate(t)
+	if err != nil {
+		return nil, err
+	}
+
+	brand, err := e.Branding(t.FederationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderMessage(t, brand, sampleData(t.Type))
+}
+
+// Branding - оформление писем федерации, для uuid.Nil - по умолчанию.
+func (e *Emails) Branding(federationUUID uuid.UUID) (domain.EmailBranding, error) {
+	brand := domain.EmailBranding{}
+	if federationUUID == uuid.Nil {
+		return brand, nil
+	}
+
+	orms, err := e.repo.FindTemplates(domain.EmailBrandingType, domain.EmailScope{FederationUUID: federationUUID})
+	if err != nil {
+		return brand, err
+	}
+
+	for _, orm := range orms {
+		if lo.FromPtr(orm.FederationUUID) == federationUUID && orm.CompanyUUID == nil {
+			err = json.Unmarshal([]byte(orm.Template), &brand)
+		}
+	}
+
+	return brand, err
+}
+
+func (e *Emails) SaveBranding(federationUUID, createdBy uuid.UUID, brand domain.EmailBranding) error {
+	j, err := json.Marshal(brand)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.repo.SaveTemplate(Template{
+		CreatedBy:      createdBy,
+		FederationUUID: &federationUUID,
+		Type:           domain.EmailBrandingType,
+		Template:       string(j),
+	})
+
+	return err
+}
+
+// validateTemplateType - известный тип, который можно переопределить в области шаблона.
+func validateTemplateType(t domain.EmailTemplate) error {
+	if !lo.Contains(domain.EmailTemplateTypes, t.Type) {
+		return fmt.Errorf("%w: %s", ErrEmailTemplateType, t.Type)
+	}
+
+	scope := domain.EmailScope{FederationUUID: t.FederationUUID, CompanyUUID: t.CompanyUUID}
+	if !scope.Global() && !lo.Contains(domain.EmailTemplateScopedTypes, t.Type) {
+		return fmt.Errorf("%w: %s", ErrEmailTemplateScope, t.Type)
+	}
+
+	return nil
+}
+
+func validateTemplate(t domain.EmailTemplate) error {
+	err := validateTemplateType(t)
+	if err != nil {
+		return err
+	}
+
+	if !lo.Contains(domain.Languages, t.Lang) {
+		return fmt.Errorf("%w: %s", ErrEmailTemplateLang, t.Lang)
+	}
+
+	_, err = renderMessage(t, domain.EmailBranding{}, sampleData(t.Type))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrEmailTemplate, err)
+	}
+
+	return nil
+}
+
+// sampleData - пример данных для проверки и предпросмотра шаблона.
+func sampleData(templateType string) TemplateData {
+	switch templateType {
+	case domain.EmailTemplateDigest:
+		return &DigestData{
+			Name:   "Иван",
+			Period: "за день",
+			Tasks: []DigestTask{{
+				ID:   42,
+				Name: "Подготовить отчет",
+				URL:  "https://example.com/task/42",
+				Comments: []DigestComment{
+					NewDigestComment("Петр Петров", "10:00", true, "Отчет готов, посмотрите"),
+				},
+				Uploads:   []string{"report.pdf"},
+				Reminders: []string{"Созвон с клиентом"},
+			}},
+			UnsubscribeURL: "https://example.com/unsubscribe",
+		}
+	case domain.EmailTemplateNotification:
+		return &NotificationData{
+			Subject: "Задача #42 «Подготовить отчет»",
+			Name:    "Иван",
+			Text:    "Вас назначили исполнителем",
+			URL:     "https://example.com/task/42",
+		}
+	}
+
+	return &CodeData{Code: "123456"}
+}
@@ -0,0 +1,130 @@
+package emails
+
+import (
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// SMTPTransport - отправка по SMTP: с неявным TLS (465) или STARTTLS (587).
+// Insecure - без TLS, только для локальных стендов. Dialer - свой (например, с проверкой адреса), иначе с Timeout.
+type SMTPTransport struct {
+	User     string
+	Password string
+	Host     string
+	Port     string
+	StartTLS bool
+	Insecure bool
+	Timeout  time.Duration
+	Dialer   *net.Dialer
+}
+
+func (t *SMTPTransport) Send(m domain.Mail) error {
+	msg, err := buildMessage(m)
+	if err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"module": "emails",
+		"user":   t.User,
+		"addr":   t.Host + ":" + t.Port,
+	}).Debug("sending email")
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: false,
+		ServerName:         t.Host,
+	}
+
+	var conn net.Conn
+
+	dialer := t.Dialer
+	if dialer == nil {
+		dialer = &net.Dialer{Timeout: t.Timeout}
+	}
+	if t.StartTLS || t.Insecure {
+		conn, err = dialer.Dial("tcp", t.Host+":"+t.Port)
+	} else {
+		conn, err = tls.DialWithDialer(dialer, "tcp", t.Host+":"+t.Port, tlsConfig)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, t.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if t.StartTLS && !t.Insecure {
+		err = client.StartTLS(tlsConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// step 1: Use Auth
+	if t.User != "" {
+		err = client.Auth(smtp.PlainAuth("", t.User, t.Password, t.Host))
+		if err != nil {
+			return err
+		}
+	}
+
+	// step 2: add all from and to, в конверте только адрес без имени отправителя
+	envelope := m.From
+	if addr, parseErr := mail.ParseAddress(m.From); parseErr == nil {
+		envelope = addr.Address
+	}
+
+	err = client.Mail(envelope)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range m.To {
+		logrus.Debug("sending to: ", k)
+		err = client.Rcpt(k)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Data
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	err = client.Quit()
+	if err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"module":  "emails",
+		"to":      m.To,
+		"from":    m.From,
+		"subject": m.Subject,
+	}).Debug("Email was sent to: ", m.To)
+
+	return nil
+}
@@ -1,13 +1,53 @@
 package federation
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
 )
 
 func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.CompanyFieldDTO, err error) {
+	if cf.DataType == domain.Relation {
+		if cf.Relation == nil {
+			return items, errors.New("для поля relation нужна настройка relation")
+		}
+
+		if err = cf.Relation.Validate(); err != nil {
+			return items, err
+		}
+
+		if cf.Relation.Target == domain.RelationTargetCatalog {
+			catalog, err := s.catalogs.GetCatalog(*cf.Relation.CatalogUUID)
+			if err != nil {
+				return items, err
+			}
+
+			if catalog.CompanyUUID != cf.CompanyUUID {
+				return items, dto.NotFoundErr("справочник не найден")
+			}
+		}
+	}
+
+	if cf.Rules != nil {
+		if err = cf.Rules.Validate(); err != nil {
+			return items, err
+		}
+	}
+
+	if cf.HasOptions() {
+		cf.Options, err = domain.CompanyFieldOptions{}.Merge(cf.Options)
+		if err != nil {
+			return items, err
+		}
+	} else {
+		cf.Options = domain.CompanyFieldOptions{}
+	}
+
 	orm, err := s.repo.CreateCompanyField(cf)
 	if err != nil {
 		return items, err
@@ -20,10 +60,48 @@ func (s *Service) CreateCompanyField(cf *domain.CompanyField) (items dto.Company
 		DataType:    orm.DataType,
 		Hash:        orm.Hash,
 		Icon:        orm.Icon,
+		Options:     dto.NewCompanyFieldOptionDTOs(orm.Options),
+		Rules:       orm.Rules,
+		Relation:    orm.Relation,
 	}, err
 }
 
 func (s *Service) PutCompanyField(pf *domain.CompanyField) error {
+	if pf.Rules != nil {
+		if err := pf.Rules.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if pf.Options != nil {
+		orm, err := s.repo.GetCompanyField(pf.UUID)
+		if err != nil {
+			return err
+		}
+
+		pf.DataType = domain.FieldDataType(orm.DataType)
+		if !pf.HasOptions() {
+			return errors.New("варианты доступны только для полей select и multi_select")
+		}
+
+		pf.Options, err = orm.Options.Merge(pf.Options)
+		if err != nil {
+			return err
+		}
+
+		// Удалять можно только неиспользуемые варианты, иначе в задачах останутся значения без варианта
+		if removed := orm.Options.Removed(pf.Options); len(removed) > 0 {
+			count, err := s.repo.CountFieldOptionTasks(orm.CompanyUUID, orm.Hash, removed)
+			if err != nil {
+				return err
+			}
+
+			if count > 0 {
+				return fmt.Errorf("удаляемые варианты используются в задачах: %d", count)
+			}
+		}
+	}
+
 	return s.repo.PutCompanyField(pf)
 }
 
@@ -45,6 +123,9 @@ func (s *Service) GetProjectFields(uid uuid.UUID) (items []domain.CompanyField,
 			CompanyUUID:        item.CompanyUUID,
 			RequiredOnStatuses: item.RequiredOnStatuses,
 			Style:              item.Style,
+			Options:            item.Options,
+			Rules:              lo.ToPtr(item.Rules.Merge(item.ProjectRules)),
+			Relation:           item.Relation,
 		}
 	})
 
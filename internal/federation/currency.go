@@ -0,0 +1,78 @@
+package federation
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+// LoadCurrencyRates - загрузка таблицы курсов компании; курс на ту же дату перезаписывается.
+func (s *Service) LoadCurrencyRates(rates []domain.CurrencyRate) (err error) {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	return s.repo.SaveCurrencyRates(rates)
+}
+
+func (s *Service) GetCurrencyRates(companyUUID uuid.UUID) (domain.CurrencyRates, error) {
+	return s.repo.GetCurrencyRates(companyUUID)
+}
+
+func (s *Service) ConvertMoney(companyUUID uuid.UUID, m domain.Money, to string, at time.Time) (domain.Money, error) {
+	rates, err := s.repo.GetCurrencyRates(companyUUID)
+	if err != nil {
+		return m, err
+	}
+
+	return rates.Convert(m, to, at)
+}
+
+// convertedTotals - итог по полю в каждой из встречающихся валют, если курсы позволяют пересчет.
+func convertedTotals(rates domain.CurrencyRates, sums []FieldMoneyStatistics, at time.Time) []dto.FieldMoneyStatistics {
+	totals := []dto.FieldMoneyStatistics{}
+	if len(sums) < 2 {
+		return totals
+	}
+
+	for _, target := range sums {
+		total := new(big.Rat)
+		count := 0
+		ok := true
+
+		for _, item := range sums {
+			m, err := rates.Convert(domain.Money{Amount: item.Sum, Currency: item.Currency}, target.Currency, at)
+			if err != nil {
+				ok = false
+				break
+			}
+
+			total.Add(total, m.Rat())
+			count += item.Count
+		}
+
+		if ok {
+			totals = append(totals, dto.FieldMoneyStatistics{
+				Currency: target.Currency,
+				Sum:      domain.FormatAmount(total),
+				Count:    count,
+			})
+		}
+	}
+
+	return totals
+}
+
+func moneyStatisticsDTO(items []FieldMoneyStatistics) []dto.FieldMoneyStatistics {
+	return lo.Map(items, func(item FieldMoneyStatistics, _ int) dto.FieldMoneyStatistics {
+		return dto.FieldMoneyStatistics{
+			Currency: item.Currency,
+			Sum:      domain.FormatAmount(domain.Money{Amount: item.Sum}.Rat()),
+			Count:    item.Count,
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package federation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+func (s *Service) GetCompanyField(uid uuid.UUID) (cf domain.CompanyField, err error) {
+	orm, err := s.repo.GetCompanyField(uid)
+	if err != nil {
+		return cf, err
+	}
+
+	return domain.CompanyField{
+		UUID:        orm.UUID,
+		Hash:        orm.Hash,
+		Name:        orm.Name,
+		Description: orm.Description,
+		Icon:        orm.Icon,
+		DataType:    domain.FieldDataType(orm.DataType),
+		CompanyUUID: orm.CompanyUUID,
+		Options:     orm.Options,
+		Rules:       &orm.Rules,
+		Relation:    orm.Relation,
+	}, nil
+}
+
+func (s *Service) ChangeCompanyFieldType(uid uuid.UUID, dataType domain.FieldDataType, options domain.CompanyFieldOptions) error {
+	return s.repo.ChangeCompanyFieldType(uid, dataType, options)
+}
+
+func (s *Service) CreateFieldMigration(m domain.FieldMigration) error {
+	return s.repo.CreateFieldMigration(m)
+}
+
+func (s *Service) SaveFieldMigrationProgress(m domain.FieldMigration) error {
+	return s.repo.SaveFieldMigrationProgress(m)
+}
+
+func (s *Service) GetFieldMigration(uid uuid.UUID) (domain.FieldMigration, error) {
+	return s.repo.GetFieldMigration(uid)
+}
+
+func (s *Service) HasRunningFieldMigration(fieldUUID uuid.UUID) (bool, error) {
+	return s.repo.HasRunningFieldMigration(fieldUUID)
+}
+
+func (s *Service) FailStaleFieldMigrations(before time.Time, reason string) (int64, error) {
+	return s.repo.FailStaleFieldMigrations(before, reason)
+}
@@ -108,6 +108,13 @@ type CompanyFields struct {
 
 	RequiredOnStatuses IntArray `gorm:"->;type:jsonb;default:'[]';not null;"`
 	Style              string   `gorm:"->;type:varchar(20);default:'';not null;"`
+
+	Options domain.CompanyFieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
+	Rules   domain.FieldRules          `gorm:"type:jsonb;default:'{}';not null;"`
+
+	Relation *domain.FieldRelation `gorm:"type:jsonb;"`
+
+	ProjectRules domain.FieldRules `gorm:"->;type:jsonb;"`
 }
 
 type JSONArray []any
@@ -281,6 +288,8 @@ type ProjectField struct {
 	RequiredOnStatuses IntArray `gorm:"type:jsonb;default:'[]';not null;"`
 	Style              string   `gorm:"type:varchar(50);default:'';not null;"`
 
+	Rules domain.FieldRules `gorm:"type:jsonb;default:'{}';not null;"`
+
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
@@ -330,4 +339,58 @@ type FieldStatistics struct {
 	Count  int     `json:"count"`
 	Total  int     `json:"total"`
 	Filled float64 `json:"filled"`
+
+	Options []FieldOptionStatistics `gorm:"-"`
+	Sums    []FieldMoneyStatistics  `gorm:"-"`
+}
+
+type FieldMoneyStatistics struct {
+	Hash     string `json:"hash"`
+	Currency string `json:"currency"`
+	Sum      string `json:"sum"`
+	Count    int    `json:"count"`
+}
+
+type FieldOptionStatistics struct {
+	Hash   string    `json:"hash"`
+	UUID   uuid.UUID `json:"uuid"`
+	Label  string    `json:"label"`
+	Color  string    `json:"color"`
+	Count  int       `json:"count"`
+	Filled float64   `json:"filled"`
+}
+
+type CurrencyRate struct {
+	UUID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	CompanyUUID  uuid.UUID `gorm:"type:uuid;not null"`
+	CurrencyFrom string    `gorm:"type:varchar(3);not null;"`
+	CurrencyTo   string    `gorm:"type:varchar(3);not null;"`
+	Rate         string    `gorm:"type:numeric(24,10);not null;"`
+	Date         time.Time `gorm:"type:date;not null;"`
+	CreatedBy    string    `gorm:"type:varchar(255);default:'';not null;"`
+	CreatedAt    time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt    time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type FieldTypeMigration struct {
+	UUID         uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	CompanyUUID  uuid.UUID      `gorm:"type:uuid;not null"`
+	Target       string         `gorm:"type:varchar(20);not null;"`
+	FieldUUID    uuid.UUID      `gorm:"type:uuid;not null"`
+	Hash         string         `gorm:"type:varchar(15);not null;"`
+	FromType     int            `gorm:"type:int;not null;"`
+	ToType       int            `gorm:"type:int;not null;"`
+	Strategy     string         `gorm:"type:varchar(20);default:'fail';not null;"`
+	DefaultValue datatypes.JSON `gorm:"type:jsonb;"`
+	Status       string         `gorm:"type:varchar(20);default:'running';not null;"`
+	Total        int            `gorm:"type:int;default:0;not null"`
+	Processed    int            `gorm:"type:int;default:0;not null"`
+	Converted    int            `gorm:"type:int;default:0;not null"`
+	Cleared      int            `gorm:"type:int;default:0;not null"`
+	Defaulted    int            `gorm:"type:int;default:0;not null"`
+	Error        string         `gorm:"type:text;default:'';not null"`
+	CreatedBy    string         `gorm:"type:varchar(255);default:'';not null;"`
+	CreatedAt    time.Time      `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt    time.Time      `gorm:"type:timestamptz;default:now();not null"`
+	FinishedAt   *time.Time     `gorm:"type:timestamptz;default:NULL;"`
 }
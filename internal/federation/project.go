@@ -128,12 +128,30 @@ func (s *Service) GetProjectStatistic(companyUID, uid uuid.UUID) (item ProjectSt
 		return item, dtos, err
 	}
 
+	rates, err := s.repo.GetCurrencyRates(companyUID)
+	if err != nil {
+		return item, dtos, err
+	}
+
+	now := time.Now()
+
 	return orm, lo.Map(fs, func(item FieldStatistics, _ int) dto.FieldStatistics {
 		return dto.FieldStatistics{
 			Name:   item.Name,
 			Filled: item.Filled,
 			Count:  item.Count,
 			Total:  item.Total,
+			Options: lo.Map(item.Options, func(o FieldOptionStatistics, _ int) dto.FieldOptionStatistics {
+				return dto.FieldOptionStatistics{
+					UUID:   o.UUID,
+					Label:  o.Label,
+					Color:  o.Color,
+					Count:  o.Count,
+					Filled: o.Filled,
+				}
+			}),
+			Sums:      moneyStatisticsDTO(item.Sums),
+			Converted: convertedTotals(rates, item.Sums, now),
 		}
 	}), err
 }
@@ -287,8 +305,14 @@ func (s *Service) GetCompanyProjectCatalogData(companyUUID uuid.UUID, catalogNam
 	return s.repo.GetCompanyProjectCatalogData(companyUUID, catalogName)
 }
 
-func (s *Service) AddProjectField(uid, companyUUID, companyFieldUUID uuid.UUID, requiredOnstatuses []int, style string) error {
-	err := s.repo.AddProjectField(uid, companyUUID, companyFieldUUID, requiredOnstatuses, style)
+func (s *Service) AddProjectField(uid, companyUUID, companyFieldUUID uuid.UUID, requiredOnstatuses []int, style string, rules *domain.FieldRules) error {
+	if rules != nil {
+		if err := rules.Validate(); err != nil {
+			return err
+		}
+	}
+
+	err := s.repo.AddProjectField(uid, companyUUID, companyFieldUUID, requiredOnstatuses, style, rules)
 
 	if err == nil {
 		s.repo.PubUpdate()
@@ -2,8 +2,10 @@ package federation
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -12,9 +14,11 @@ import (
 	"github.com/krisch/crm-backend/internal/profile"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/lib/pq"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -283,6 +287,15 @@ func (r *Repository) CreateCompanyField(cf *domain.CompanyField) (orm CompanyFie
 			DataType:    int(cf.DataType),
 			Hash:        helpers.IntToLetters(company.FieldLastName + 1),
 			CompanyUUID: cf.CompanyUUID,
+			Options:     cf.Options,
+		}
+
+		if cf.Rules != nil {
+			orm.Rules = *cf.Rules
+		}
+
+		if cf.DataType == domain.Relation {
+			orm.Relation = cf.Relation
 		}
 
 		err = tx.Create(&orm).Error
@@ -313,12 +326,21 @@ func (r *Repository) PutCompanyField(pf *domain.CompanyField) error {
 		Icon:        pf.Icon,
 	}
 
-	err := r.gorm.DB.
+	q := r.gorm.DB.
 		Model(&orm).
 		Where("uuid = ?", pf.UUID).
 		Update("name", orm.Name).
-		Update("description", orm.Description).
-		Error
+		Update("description", orm.Description)
+
+	if pf.Options != nil {
+		q = q.Update("options", pf.Options)
+	}
+
+	if pf.Rules != nil {
+		q = q.Update("rules", *pf.Rules)
+	}
+
+	err := q.Update("updated_at", "now()").Error
 
 	if err == nil {
 		r.PubUpdate()
@@ -327,11 +349,36 @@ func (r *Repository) PutCompanyField(pf *domain.CompanyField) error {
 	return err
 }
 
+func (r *Repository) GetCompanyField(uid uuid.UUID) (orm CompanyFields, err error) {
+	err = r.gorm.DB.Model(&orm).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		First(&orm).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("поле не найдено")
+	}
+
+	return orm, err
+}
+
+// CountFieldOptionTasks - количество задач компании, в которых выбран хотя бы один из вариантов.
+func (r *Repository) CountFieldOptionTasks(companyUUID uuid.UUID, hash string, options []string) (count int64, err error) {
+	err = r.gorm.DB.
+		Table("tasks").
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Where("jsonb_exists_any(fields -> ?, ?::text[])", hash, pq.Array(options)).
+		Count(&count).Error
+
+	return count, err
+}
+
 func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyFields, err error) {
 	orm = []CompanyFields{}
 
 	r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, pf.style, pf.required_on_statuses").
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.options, company_fields.rules, company_fields.relation, pf.rules as project_rules, pf.style, pf.required_on_statuses").
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Where("pf.project_uuid = ?", projectUUID).
 		Where("company_fields.deleted_at is null").
@@ -350,7 +397,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 
 	// Company Fields
 	res := r.gorm.DB.Model(&orm).
-		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
+		Select("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.description, company_fields.hash, company_fields.data_type, company_fields.options, company_fields.rules, company_fields.relation, COALESCE(json_agg(distinct pf.project_uuid) FILTER (WHERE pf.project_uuid IS NOT NULL), '[]' ) as project_uuids,"+
 			"count(*) as tasks_total,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null) as tasks_filled,"+
 			"count(*) FILTER (WHERE t.fields->>company_fields.hash is not null and t.finished_at is null) as tasks_active_filled",
@@ -360,7 +407,7 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 		Where("company_fields.company_uuid", companyUUID).
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Joins("left join tasks t on t.project_uuid = pf.project_uuid ").
-		Group("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.hash, company_fields.data_type, pf.style, pf.required_on_statuses").
+		Group("company_fields.uuid, company_fields.icon, company_fields.name, company_fields.hash, company_fields.data_type, company_fields.options, company_fields.rules, company_fields.relation, pf.style, pf.required_on_statuses").
 		Find(&orm)
 	if res.Error != nil {
 		return dmns, res.Error
@@ -375,6 +422,9 @@ func (r *Repository) GetCompanyFields(companyUUID uuid.UUID) (dmns []domain.Comp
 			Icon:        item.Icon,
 			DataType:    domain.FieldDataType(item.DataType),
 			CompanyUUID: item.CompanyUUID,
+			Options:     item.Options,
+			Rules:       &item.Rules,
+			Relation:    item.Relation,
 			ProjectUUID: lo.Map(item.ProjectUUID, func(uid any, index int) uuid.UUID {
 				return uuid.MustParse(uid.(string))
 			}),
@@ -476,6 +526,48 @@ func (r *Repository) ProjectStatistic(companyUID, uid uuid.UUID) (orm ProjectSta
 		return orm, fieldStatistics, res.Error
 	}
 
+	// Statistics for select / multi_select options
+	optionStatistics := []FieldOptionStatistics{}
+	res = r.gorm.DB.Raw("with t as (select count(*) as total from tasks where project_uuid = ? and deleted_at is null) "+
+		"select cf.hash, (o->>'uuid')::uuid as uuid, o->>'label' as label, coalesce(o->>'color', '') as color, count(tasks.uuid) as count, "+
+		"case when t.total = 0 then 0 else round(count(tasks.uuid)::decimal / t.total * 100, 2) end as filled "+
+		"from company_fields cf "+
+		"join project_fields pf on pf.company_field_uuid = cf.uuid and pf.project_uuid = ? and pf.deleted_at is null "+
+		"cross join jsonb_array_elements(cf.options) o "+
+		"left join t on 1 = 1 "+
+		"left join tasks on tasks.project_uuid = pf.project_uuid and tasks.deleted_at is null "+
+		"and (tasks.fields->>cf.hash = o->>'uuid' or tasks.fields->cf.hash @> jsonb_build_array(o->>'uuid')) "+
+		"where cf.company_uuid = ? and cf.deleted_at is null and cf.data_type in (?, ?) "+
+		"group by cf.hash, o->>'uuid', o->>'label', o->>'color', (o->>'order')::int, t.total "+
+		"order by cf.hash, (o->>'order')::int", uid, uid, companyUID, int(domain.Select), int(domain.MultiSelect)).Scan(&optionStatistics)
+	if res.Error != nil {
+		return orm, fieldStatistics, res.Error
+	}
+
+	// Statistics for money fields: sums per currency
+	moneyStatistics := []FieldMoneyStatistics{}
+	res = r.gorm.DB.Raw("select cf.hash, tasks.fields->cf.hash->>'currency' as currency, "+
+		"sum((tasks.fields->cf.hash->>'amount')::numeric)::text as sum, count(*) as count "+
+		"from company_fields cf "+
+		"join project_fields pf on pf.company_field_uuid = cf.uuid and pf.project_uuid = ? and pf.deleted_at is null "+
+		"join tasks on tasks.project_uuid = pf.project_uuid and tasks.deleted_at is null and jsonb_typeof(tasks.fields->cf.hash) = 'object' "+
+		"where cf.company_uuid = ? and cf.deleted_at is null and cf.data_type = ? "+
+		"group by cf.hash, tasks.fields->cf.hash->>'currency' "+
+		"order by cf.hash, tasks.fields->cf.hash->>'currency'", uid, companyUID, int(domain.MoneyType)).Scan(&moneyStatistics)
+	if res.Error != nil {
+		return orm, fieldStatistics, res.Error
+	}
+
+	for i := range fieldStatistics {
+		fieldStatistics[i].Options = lo.Filter(optionStatistics, func(item FieldOptionStatistics, _ int) bool {
+			return item.Hash == fieldStatistics[i].Hash
+		})
+
+		fieldStatistics[i].Sums = lo.Filter(moneyStatistics, func(item FieldMoneyStatistics, _ int) bool {
+			return item.Hash == fieldStatistics[i].Hash
+		})
+	}
+
 	return orm, fieldStatistics, err
 }
 
@@ -1401,7 +1493,7 @@ func (r *Repository) RemoveUserFromGroups(groupUUID, userUUID uuid.UUID) (err er
 	return err
 }
 
-func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID uuid.UUID, requiredOnStatuses []int, style string) (err error) {
+func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID uuid.UUID, requiredOnStatuses []int, style string, rules *domain.FieldRules) (err error) {
 	existingRecord := &ProjectField{}
 
 	if style != "" && style != "hide_when_empty" && style != "show_when_empty" {
@@ -1420,19 +1512,25 @@ func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID
 
 	if res.RowsAffected > 0 &&
 		style == existingRecord.Style &&
-		helpers.EquelSlices(existingRecord.RequiredOnStatuses, requiredOnStatuses) {
+		helpers.EquelSlices(existingRecord.RequiredOnStatuses, requiredOnStatuses) &&
+		rules == nil {
 		return errors.New("поле уже добавлено")
 	}
 
 	if res.RowsAffected > 0 {
-		err = r.gorm.DB.
+		q := r.gorm.DB.
 			Model(&ProjectField{}).
 			Where("project_uuid", projectUUID).
 			Where("company_field_uuid", companyFieldUUID).
 			Where("deleted_at is null").
 			Update("required_on_statuses", IntArray(requiredOnStatuses)).
-			Update("style", style).
-			Error
+			Update("style", style)
+
+		if rules != nil {
+			q = q.Update("rules", *rules)
+		}
+
+		err = q.Update("updated_at", "now()").Error
 	} else {
 		existingRecord = &ProjectField{
 			UUID:               uuid.New(),
@@ -1443,6 +1541,10 @@ func (r *Repository) AddProjectField(projectUUID, companyUUID, companyFieldUUID
 			Style:              style,
 		}
 
+		if rules != nil {
+			existingRecord.Rules = *rules
+		}
+
 		err = r.gorm.DB.Create(&existingRecord).Error
 	}
 	if err == nil {
@@ -1571,3 +1673,196 @@ func (r *Repository) DeleteProjectStatus(uid uuid.UUID) (err error) {
 
 	return err
 }
+
+func (r *Repository) SaveCurrencyRates(rates []domain.CurrencyRate) (err error) {
+	orms := lo.Map(rates, func(item domain.CurrencyRate, _ int) CurrencyRate {
+		return CurrencyRate{
+			UUID:         item.UUID,
+			CompanyUUID:  item.CompanyUUID,
+			CurrencyFrom: item.From,
+			CurrencyTo:   item.To,
+			Rate:         item.Rate,
+			Date:         item.Date,
+			CreatedBy:    item.CreatedBy,
+		}
+	})
+
+	return r.gorm.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "company_uuid"}, {Name: "currency_from"}, {Name: "currency_to"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"rate":       gorm.Expr("excluded.rate"),
+			"created_by": gorm.Expr("excluded.created_by"),
+			"updated_at": "now()",
+		}),
+	}).CreateInBatches(&orms, 500).Error
+}
+
+func (r *Repository) GetCurrencyRates(companyUUID uuid.UUID) (dmns domain.CurrencyRates, err error) {
+	orms := []CurrencyRate{}
+
+	err = r.gorm.DB.Model(&orms).
+		Where("company_uuid = ?", companyUUID).
+		Order("date desc, currency_from, currency_to").
+		Find(&orms).Error
+	if err != nil {
+		return dmns, err
+	}
+
+	return lo.Map(orms, func(item CurrencyRate, _ int) domain.CurrencyRate {
+		return domain.CurrencyRate{
+			UUID:        item.UUID,
+			CompanyUUID: item.CompanyUUID,
+			From:        item.CurrencyFrom,
+			To:          item.CurrencyTo,
+			Rate:        item.Rate,
+			Date:        item.Date,
+			CreatedBy:   item.CreatedBy,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	}), nil
+}
+
+func (r *Repository) CreateFieldMigration(m domain.FieldMigration) error {
+	orm, err := fieldMigrationORM(m)
+	if err != nil {
+		return err
+	}
+
+	return r.gorm.DB.Create(&orm).Error
+}
+
+// SaveFieldMigrationProgress - обновление счетчиков и статуса миграции типа поля.
+func (r *Repository) SaveFieldMigrationProgress(m domain.FieldMigration) error {
+	return r.gorm.DB.
+		Model(&FieldTypeMigration{}).
+		Where("uuid = ?", m.UUID).
+		Updates(map[string]interface{}{
+			"status":      m.Status,
+			"total":       m.Total,
+			"processed":   m.Processed,
+			"converted":   m.Converted,
+			"cleared":     m.Cleared,
+			"defaulted":   m.Defaulted,
+			"error":       m.Error,
+			"finished_at": m.FinishedAt,
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+func (r *Repository) GetFieldMigration(uid uuid.UUID) (dmn domain.FieldMigration, err error) {
+	orm := FieldTypeMigration{}
+
+	err = r.gorm.DB.Model(&orm).Where("uuid = ?", uid).First(&orm).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dmn, dto.NotFoundErr("миграция не найдена")
+	}
+	if err != nil {
+		return dmn, err
+	}
+
+	return fieldMigrationDomain(orm), nil
+}
+
+func (r *Repository) HasRunningFieldMigration(fieldUUID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.gorm.DB.
+		Model(&FieldTypeMigration{}).
+		Where("field_uuid = ?", fieldUUID).
+		Where("status = ?", domain.FieldMigrationStatusRunning).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+// FailStaleFieldMigrations - миграции в статусе running, не обновлявшиеся с before, помечаются упавшими.
+func (r *Repository) FailStaleFieldMigrations(before time.Time, reason string) (int64, error) {
+	q := r.gorm.DB.
+		Model(&FieldTypeMigration{}).
+		Where("status = ?", domain.FieldMigrationStatusRunning).
+		Where("updated_at < ?", before).
+		Updates(map[string]interface{}{
+			"status":      domain.FieldMigrationStatusFailed,
+			"error":       reason,
+			"finished_at": time.Now(),
+			"updated_at":  time.Now(),
+		})
+
+	return q.RowsAffected, q.Error
+}
+
+func (r *Repository) ChangeCompanyFieldType(uid uuid.UUID, dataType domain.FieldDataType, options domain.CompanyFieldOptions) error {
+	q := r.gorm.DB.
+		Model(&CompanyFields{}).
+		Where("uuid = ?", uid).
+		Update("data_type", int(dataType))
+
+	if options != nil {
+		q = q.Update("options", options)
+	}
+
+	err := q.Update("updated_at", "now()").Error
+	if err == nil {
+		r.PubUpdate()
+	}
+
+	return err
+}
+
+func fieldMigrationORM(m domain.FieldMigration) (FieldTypeMigration, error) {
+	orm := FieldTypeMigration{
+		UUID:        m.UUID,
+		CompanyUUID: m.CompanyUUID,
+		Target:      m.Target,
+		FieldUUID:   m.FieldUUID,
+		Hash:        m.Hash,
+		FromType:    int(m.FromType),
+		ToType:      int(m.ToType),
+		Strategy:    m.Strategy,
+		Status:      m.Status,
+		Total:       m.Total,
+		CreatedBy:   m.CreatedBy,
+	}
+
+	if m.Default != nil {
+		b, err := json.Marshal(m.Default)
+		if err != nil {
+			return orm, err
+		}
+
+		orm.DefaultValue = b
+	}
+
+	return orm, nil
+}
+
+func fieldMigrationDomain(orm FieldTypeMigration) domain.FieldMigration {
+	m := domain.FieldMigration{
+		UUID:        orm.UUID,
+		CompanyUUID: orm.CompanyUUID,
+		Target:      orm.Target,
+		FieldUUID:   orm.FieldUUID,
+		Hash:        orm.Hash,
+		FromType:    domain.FieldDataType(orm.FromType),
+		ToType:      domain.FieldDataType(orm.ToType),
+		Strategy:    orm.Strategy,
+		Status:      orm.Status,
+		Total:       orm.Total,
+		Processed:   orm.Processed,
+		Converted:   orm.Converted,
+		Cleared:     orm.Cleared,
+		Defaulted:   orm.Defaulted,
+		Error:       orm.Error,
+		CreatedBy:   orm.CreatedBy,
+		CreatedAt:   orm.CreatedAt,
+		UpdatedAt:   orm.UpdatedAt,
+		FinishedAt:  orm.FinishedAt,
+	}
+
+	if len(orm.DefaultValue) > 0 {
+		//nolint
+		json.Unmarshal(orm.DefaultValue, &m.Default)
+	}
+
+	return m
+}
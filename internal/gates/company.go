@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
@@ -78,3 +79,16 @@ func (a *Service) CompanyRemoveUser(companyUUID, _, userUUID uuid.UUID) error {
 
 	return nil
 }
+
+// CompanyView - настройки и журналы компании видят только ее участники.
+func (a *Service) CompanyView(companyUUID, userUUID uuid.UUID) error {
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	hasCompany := lo.IndexOf(cUUIDs, companyUUID)
+
+	if hasCompany == -1 {
+		return dto.NotFoundErr("компания не найдена")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
@@ -53,3 +54,16 @@ func (a *Service) ProjectPatch(project domain.Project, userUUID uuid.UUID) error
 
 	return nil
 }
+
+// ProjectView - проект и его задачи видят участники компании проекта.
+func (a *Service) ProjectView(project domain.Project, userUUID uuid.UUID) error {
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	hasCompany := lo.IndexOf(cUUIDs, project.CompanyUUID)
+
+	if hasCompany == -1 {
+		return dto.NotFoundErr("проект не найден")
+	}
+
+	return nil
+}
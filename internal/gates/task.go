@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
 )
 
@@ -43,3 +44,16 @@ func (a *Service) TaskPatch(task domain.Task, userUUID uuid.UUID) error {
 
 	return nil
 }
+
+// TaskView - задачу, ее комментарии и историю видят участники компании задачи.
+func (a *Service) TaskView(task domain.Task, userUUID uuid.UUID) error {
+	cUUIDs := a.dict.GetUserCompanies(userUUID)
+
+	hasCompany := lo.IndexOf(cUUIDs, task.CompanyUUID)
+
+	if hasCompany == -1 {
+		return dto.NotFoundErr("задача не найдена")
+	}
+
+	return nil
+}
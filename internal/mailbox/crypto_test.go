@@ -0,0 +1,50 @@
+package mailbox
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	key := passwordKey("solt")
+
+	encrypted, err := encryptPassword(key, "пароль 123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if encrypted == "пароль 123" {
+		t.Fatal("encryptPassword() returned plain password")
+	}
+
+	if got, err := decryptPassword(key, encrypted); err != nil || got != "пароль 123" {
+		t.Errorf("decryptPassword() = %q, %v", got, err)
+	}
+
+	if _, err := decryptPassword(passwordKey("other"), encrypted); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("decryptPassword() with other key error = %v", err)
+	}
+}
+
+func TestParseServer(t *testing.T) {
+	tests := []struct {
+		dsn     string
+		want    Server
+		wantErr bool
+	}{
+		{dsn: "imaps://imap.mail.ru", want: Server{Host: "imap.mail.ru", Port: "993", Security: SecurityTLS}},
+		{dsn: "imap+insecure://localhost:1143", want: Server{Host: "localhost", Port: "1143", Security: SecurityNone}},
+		{dsn: "smtp://smtp.mail.ru", want: Server{Host: "smtp.mail.ru", Port: "587", Security: SecurityStartTLS}},
+		{dsn: "pop3://mail.ru", wantErr: true},
+		{dsn: "imaps://", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			got, err := ParseServer(tt.dsn)
+			if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+				t.Errorf("ParseServer() = %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package mailbox
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	imapMaxMessage = 25 << 20
+	imapDateLayout = "_2-Jan-2006 15:04:05 -0700"
+)
+
+var (
+	ErrIMAP = errors.New("imap")
+
+	imapLiteral     = regexp.MustCompile(`\{(\d+)\}$`)
+	imapUIDValidity = regexp.MustCompile(`(?i)\[UIDVALIDITY (\d+)\]`)
+	imapUID         = regexp.MustCompile(`(?i)\bUID (\d+)`)
+	imapDate        = regexp.MustCompile(`(?i)INTERNALDATE "([^"]+)"`)
+)
+
+// imapClient - минимальный клиент IMAP4rev1: вход, выбор INBOX, поиск и загрузка писем по UID.
+type imapClient struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	tag     int
+	timeout time.Duration
+}
+
+// imapResponse - строка ответа сервера и литералы {n}, встреченные в ней.
+type imapResponse struct {
+	Line     string
+	Literals [][]byte
+}
+
+// imapMessage - письмо INBOX целиком (RFC 822).
+type imapMessage struct {
+	UID        uint32
+	ReceivedAt time.Time
+	Raw        []byte
+}
+
+func dialIMAP(s Server, timeout time.Duration) (*imapClient, error) {
+	dialer := s.dialer(timeout)
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if s.Security == SecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", s.Addr(), s.tlsConfig())
+	} else {
+		conn, err = dialer.Dial("tcp", s.Addr())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &imapClient{conn: conn, r: bufio.NewReader(conn), timeout: timeout}
+
+	greeting, err := c.read()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if !strings.HasPrefix(strings.ToUpper(greeting.Line), "* OK") && !strings.HasPrefix(strings.ToUpper(greeting.Line), "* PREAUTH") {
+		conn.Close()
+		return nil, fmt.Errorf("%w: %s", ErrIMAP, greeting.Line)
+	}
+
+	if s.Security == SecurityStartTLS {
+		if _, err = c.cmd("STARTTLS"); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		tlsConn := tls.Client(conn, s.tlsConfig())
+		if err = tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		c.conn, c.r = tlsConn, bufio.NewReader(tlsConn)
+	}
+
+	return c, nil
+}
+
+func (c *imapClient) Close() error {
+	_, _ = c.cmd("LOGOUT")
+
+	return c.conn.Close()
+}
+
+// Login - пароль с символами вне ASCII передается литералом.
+func (c *imapClient) Login(user, password string) error {
+	if imapQuotable(password) {
+		_, err := c.cmd("LOGIN " + imapQuote(user) + " " + imapQuote(password))
+		return err
+	}
+
+	tag, err := c.send(fmt.Sprintf("LOGIN %s {%d}", imapQuote(user), len(password)))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.read()
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(resp.Line, "+") {
+		return fmt.Errorf("%w: %s", ErrIMAP, resp.Line)
+	}
+
+	if _, err = io.WriteString(c.conn, password+"\r\n"); err != nil {
+		return err
+	}
+
+	_, err = c.wait(tag)
+
+	return err
+}
+
+// SelectInbox - UIDVALIDITY: если изменился, прежние UID писем недействительны.
+func (c *imapClient) SelectInbox() (uint32, error) {
+	untagged, err := c.cmd("SELECT INBOX")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, resp := range untagged {
+		if sub := imapUIDValidity.FindStringSubmatch(resp.Line); sub != nil {
+			v, _ := strconv.ParseUint(sub[1], 10, 32)
+			return uint32(v), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// SearchUIDs - UID писем после lastUID, при первой загрузке - полученных после since, по возрастанию.
+func (c *imapClient) SearchUIDs(lastUID uint32, since time.Time) ([]uint32, error) {
+	query := fmt.Sprintf("UID SEARCH UID %d:*", lastUID+1)
+	if lastUID == 0 {
+		query = "UID SEARCH SINCE " + since.Format("2-Jan-2006")
+	}
+
+	untagged, err := c.cmd(query)
+	if err != nil {
+		return nil, err
+	}
+
+	uids := []uint32{}
+	for _, resp := range untagged {
+		fields := strings.Fields(resp.Line)
+		if len(fields) < 2 || !strings.EqualFold(fields[1], "SEARCH") {
+			continue
+		}
+
+		for _, f := range fields[2:] {
+			uid, err := strconv.ParseUint(f, 10, 32)
+			// n:* всегда возвращает последнее письмо, даже если его UID меньше n
+			if err == nil && uint32(uid) > lastUID {
+				uids = append(uids, uint32(uid))
+			}
+		}
+	}
+
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	return uids, nil
+}
+
+// Fetch - письмо целиком без отметки прочитанным (BODY.PEEK).
+func (c *imapClient) Fetch(uid uint32) (imapMessage, error) {
+	untagged, err := c.cmd(fmt.Sprintf("UID FETCH %d (UID INTERNALDATE BODY.PEEK[])", uid))
+	if err != nil {
+		return imapMessage{}, err
+	}
+
+	for _, resp := range untagged {
+		if !strings.Contains(strings.ToUpper(resp.Line), "FETCH") || len(resp.Literals) == 0 {
+			continue
+		}
+
+		m := imapMessage{UID: uid, Raw: resp.Literals[0], ReceivedAt: time.Now()}
+
+		if sub := imapUID.FindStringSubmatch(resp.Line); sub != nil {
+			v, _ := strconv.ParseUint(sub[1], 10, 32)
+			m.UID = uint32(v)
+		}
+
+		if sub := imapDate.FindStringSubmatch(resp.Line); sub != nil {
+			if t, err := time.Parse(imapDateLayout, sub[1]); err == nil {
+				m.ReceivedAt = t
+			}
+		}
+
+		if m.Raw == nil {
+			return m, fmt.Errorf("%w: message %d is larger than %d bytes", ErrIMAP, uid, imapMaxMessage)
+		}
+
+		return m, nil
+	}
+
+	return imapMessage{}, fmt.Errorf("%w: message %d not found", ErrIMAP, uid)
+}
+
+func (c *imapClient) cmd(command string) ([]imapResponse, error) {
+	tag, err := c.send(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.wait(tag)
+}
+
+func (c *imapClient) send(command string) (string, error) {
+	c.tag++
+	tag := fmt.Sprintf("A%03d", c.tag)
+
+	_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+
+	_, err := io.WriteString(c.conn, tag+" "+command+"\r\n")
+
+	return tag, err
+}
+
+// wait - ответы до строки с тегом команды: OK - успех, NO и BAD - ошибка.
+func (c *imapClient) wait(tag string) ([]imapResponse, error) {
+	untagged := []imapResponse{}
+
+	for {
+		resp, err := c.read()
+		if err != nil {
+			return untagged, err
+		}
+
+		if !strings.HasPrefix(resp.Line, tag+" ") {
+			untagged = append(untagged, resp)
+			continue
+		}
+
+		status := strings.TrimPrefix(resp.Line, tag+" ")
+		if !strings.HasPrefix(strings.ToUpper(status), "OK") {
+			return untagged, fmt.Errorf("%w: %s", ErrIMAP, status)
+		}
+
+		return untagged, nil
+	}
+}
+
+// read - строка ответа вместе с литералами; литерал больше imapMaxMessage пропускается (nil).
+func (c *imapClient) read() (imapResponse, error) {
+	resp := imapResponse{}
+
+	for {
+		_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			return resp, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		sub := imapLiteral.FindStringSubmatch(line)
+		if sub == nil {
+			resp.Line += line
+			return resp, nil
+		}
+
+		resp.Line += line[:len(line)-len(sub[0])]
+
+		n, err := strconv.Atoi(sub[1])
+		if err != nil {
+			return resp, fmt.Errorf("%w: bad literal %s", ErrIMAP, sub[0])
+		}
+
+		if n > imapMaxMessage {
+			if _, err = io.CopyN(io.Discard, c.r, int64(n)); err != nil {
+				return resp, err
+			}
+
+			resp.Literals = append(resp.Literals, nil)
+
+			continue
+		}
+
+		data := make([]byte, n)
+		if _, err = io.ReadFull(c.r, data); err != nil {
+			return resp, err
+		}
+
+		resp.Literals = append(resp.Literals, data)
+	}
+}
+
+func imapQuotable(s string) bool {
+	for _, r := range s {
+		if r > 0x7e || r < 0x20 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func imapQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
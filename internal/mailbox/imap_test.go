@@ -0,0 +1,184 @@
+package mailbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisch/crm-backend/domain"
+)
+
+// fakeIMAP - локальный IMAP сервер: INBOX с письмами по UID, вход только с password.
+func fakeIMAP(t *testing.T, password string, validity uint32, inbox map[uint32]string) Server {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveIMAP(conn, password, validity, inbox)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+
+	return Server{Host: host, Port: port, Security: SecurityNone}
+}
+
+func serveIMAP(conn net.Conn, password string, validity uint32, inbox map[uint32]string) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "* OK IMAP4rev1 ready\r\n")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		tag, cmd := fields[0], strings.ToUpper(strings.Join(fields[1:min(3, len(fields))], " "))
+
+		switch {
+		case strings.HasPrefix(cmd, "LOGIN"):
+			pass := strings.Trim(fields[3], `"`)
+			if strings.HasPrefix(fields[3], "{") {
+				fmt.Fprint(conn, "+ go ahead\r\n")
+
+				var n int
+				fmt.Sscanf(fields[3], "{%d}", &n)
+				buf := make([]byte, n+2)
+				_, _ = io.ReadFull(r, buf)
+				pass = string(buf[:n])
+			}
+
+			if pass != password {
+				fmt.Fprintf(conn, "%s NO [AUTHENTICATIONFAILED] invalid credentials\r\n", tag)
+				continue
+			}
+
+			fmt.Fprintf(conn, "%s OK LOGIN completed\r\n", tag)
+		case strings.HasPrefix(cmd, "SELECT"):
+			fmt.Fprintf(conn, "* %d EXISTS\r\n* OK [UIDVALIDITY %d] UIDs valid\r\n%s OK [READ-WRITE] SELECT completed\r\n", len(inbox), validity, tag)
+		case cmd == "UID SEARCH":
+			uids := []string{}
+			for uid := range inbox {
+				uids = append(uids, fmt.Sprint(uid))
+			}
+
+			fmt.Fprintf(conn, "* SEARCH %s\r\n%s OK SEARCH completed\r\n", strings.Join(uids, " "), tag)
+		case cmd == "UID FETCH":
+			var uid uint32
+			fmt.Sscanf(fields[3], "%d", &uid)
+
+			raw := inbox[uid]
+			fmt.Fprintf(conn, "* 1 FETCH (UID %d INTERNALDATE \" 2-Mar-2024 10:15:00 +0300\" BODY[] {%d}\r\n%s)\r\n", uid, len(raw), raw)
+			fmt.Fprintf(conn, "%s OK FETCH completed\r\n", tag)
+		case cmd == "LOGOUT":
+			fmt.Fprintf(conn, "* BYE\r\n%s OK LOGOUT completed\r\n", tag)
+			return
+		default:
+			fmt.Fprintf(conn, "%s BAD unknown command\r\n", tag)
+		}
+	}
+}
+
+func TestFetchInbox(t *testing.T) {
+	key := passwordKey("solt")
+	encrypted, _ := encryptPassword(key, "пароль")
+
+	server := fakeIMAP(t, "пароль", 7, map[uint32]string{
+		3: "From: ivan@mail.ru\r\nSubject: first\r\n\r\nbody {1}\r\n",
+		5: "From: ivan@mail.ru\r\nSubject: second\r\n\r\nbody\r\n",
+	})
+
+	s := &Service{key: key, imap: &server, months: 1}
+
+	tests := []struct {
+		name     string
+		mailbox  domain.Mailbox
+		wantUIDs []uint32
+	}{
+		{name: "first fetch", mailbox: domain.Mailbox{Email: "ivan@mail.ru"}, wantUIDs: []uint32{3, 5}},
+		{name: "after last uid", mailbox: domain.Mailbox{Email: "ivan@mail.ru", UIDValidity: 7, LastUID: 3}, wantUIDs: []uint32{5}},
+		{name: "nothing new", mailbox: domain.Mailbox{Email: "ivan@mail.ru", UIDValidity: 7, LastUID: 5}, wantUIDs: []uint32{}},
+		{name: "uid validity changed", mailbox: domain.Mailbox{Email: "ivan@mail.ru", UIDValidity: 6, LastUID: 5}, wantUIDs: []uint32{3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []uint32{}
+
+			validity, lastUID, err := s.fetchInbox(tt.mailbox, encrypted, func(msg imapMessage) error {
+				if !strings.HasPrefix(string(msg.Raw), "From: ivan@mail.ru") {
+					t.Errorf("fetchInbox() raw = %q", msg.Raw)
+				}
+
+				if msg.ReceivedAt.Format(time.RFC3339) != "2024-03-02T10:15:00+03:00" {
+					t.Errorf("fetchInbox() received at = %v", msg.ReceivedAt)
+				}
+
+				got = append(got, msg.UID)
+
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if validity != 7 || fmt.Sprint(got) != fmt.Sprint(tt.wantUIDs) || (len(got) > 0 && lastUID != got[len(got)-1]) {
+				t.Errorf("fetchInbox() = %d, %d, %v, want %v", validity, lastUID, got, tt.wantUIDs)
+			}
+		})
+	}
+}
+
+func TestFetchInboxWrongPassword(t *testing.T) {
+	key := passwordKey("solt")
+	encrypted, _ := encryptPassword(key, "wrong")
+
+	server := fakeIMAP(t, "secret", 1, map[uint32]string{})
+	s := &Service{key: key, imap: &server, months: 1}
+
+	_, _, err := s.fetchInbox(domain.Mailbox{Email: "ivan@mail.ru"}, encrypted, func(imapMessage) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "AUTHENTICATIONFAILED") {
+		t.Errorf("fetchInbox() error = %v", err)
+	}
+}
+
+func TestDialIMAPExternal(t *testing.T) {
+	server := fakeIMAP(t, "secret", 1, map[uint32]string{})
+
+	c, err := dialIMAP(server, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	// imap.<домен пользователя>, указывающий на localhost
+	server.External = true
+
+	_, err = dialIMAP(server, time.Second)
+	if !errors.Is(err, ErrServerAddress) || publicError(server, err) != ErrServerAddress {
+		t.Errorf("dialIMAP() external loopback error = %v, want %v", err, ErrServerAddress)
+	}
+}
@@ -0,0 +1,551 @@
+package mailbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/emails"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	mailboxTimeout    = time.Minute
+	mailboxFetchBatch = 200
+	mailboxMaxBody    = 100000
+)
+
+var (
+	ErrMailboxDisabled = errors.New("интеграция с почтой отключена")
+	ErrMailboxConnect  = errors.New("не удалось подключиться к почтовому ящику")
+	ErrMailboxPaused   = errors.New("почтовый ящик приостановлен")
+)
+
+// Linker - агенты и задачи, связанные с письмом ящика по адресам.
+type Linker func(mb domain.Mailbox, m emails.InboundMail) (agents, tasks []uuid.UUID)
+
+type Service struct {
+	repo  *Repository
+	queue Queue
+	key   []byte
+
+	imap *Server
+	smtp *Server
+
+	months  int
+	enabled bool
+}
+
+func New(conf *configs.Configs, repo *Repository) (*Service, error) {
+	s := &Service{
+		repo:    repo,
+		key:     passwordKey(conf.SOLT),
+		months:  max(conf.MAX_EMAIL_MONTHS, 1),
+		enabled: conf.EMAILS_INTEGRATION_ENABLED,
+	}
+
+	var err error
+
+	if conf.MAILBOX_IMAP_ADDR != "" {
+		if s.imap, err = parseServerPtr(conf.MAILBOX_IMAP_ADDR); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.MAILBOX_SMTP_ADDR != "" {
+		if s.smtp, err = parseServerPtr(conf.MAILBOX_SMTP_ADDR); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.enabled {
+		s.queue = NewQueue(conf)
+	}
+
+	return s, nil
+}
+
+func (s *Service) Enabled() bool {
+	return s.enabled
+}
+
+func (s *Service) Mailboxes(userUUID uuid.UUID) ([]domain.Mailbox, error) {
+	orms, err := s.repo.GetMailboxes(userUUID)
+
+	return lo.Map(orms, func(orm Mailbox, _ int) domain.Mailbox {
+		return mailboxToDomain(orm)
+	}), err
+}
+
+// Mailbox - ящик пользователя, чужой ящик не найден.
+func (s *Service) Mailbox(userUUID, uid uuid.UUID) (domain.Mailbox, error) {
+	orm, err := s.repo.GetMailbox(uid)
+	if err == nil && orm.UserUUID != userUUID {
+		err = dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	return mailboxToDomain(orm), err
+}
+
+// Connect - ящик сохраняется, только если вход по IMAP удался; почта забирается сразу.
+func (s *Service) Connect(ctx context.Context, mb domain.Mailbox) (domain.Mailbox, error) {
+	if !s.enabled {
+		return mb, ErrMailboxDisabled
+	}
+
+	err := s.check(mb.Domain, mb.Email, mb.Password)
+	if err != nil {
+		return mb, err
+	}
+
+	password, err := encryptPassword(s.key, mb.Password)
+	if err != nil {
+		return mb, err
+	}
+
+	orm, err := s.repo.CreateMailbox(Mailbox{
+		UUID:     mb.UUID,
+		UserUUID: mb.UserUUID,
+		Email:    mb.Email,
+		Password: password,
+		Domain:   mb.Domain,
+		Status:   mb.Status,
+	})
+	if err != nil {
+		return mb, err
+	}
+
+	s.publish(ctx, Job{Type: JobFetch, MailboxUUID: orm.UUID})
+
+	return mailboxToDomain(orm), nil
+}
+
+// Update - при смене домена или пароля вход проверяется заново, загрузка почты начинается сначала.
+func (s *Service) Update(ctx context.Context, userUUID, uid uuid.UUID, settings dto.UserEmailDTOSettings, password *string) (domain.Mailbox, error) {
+	mb, err := s.Mailbox(userUUID, uid)
+	if err != nil {
+		return mb, err
+	}
+
+	values := map[string]interface{}{}
+
+	if settings.Domain != nil || password != nil {
+		if !s.enabled {
+			return mb, ErrMailboxDisabled
+		}
+
+		domainName, err := domain.MailboxDomain(mb.Email, lo.FromPtr(settings.Domain))
+		if err != nil {
+			return mb, err
+		}
+
+		orm, err := s.repo.GetMailbox(uid)
+		if err != nil {
+			return mb, err
+		}
+
+		plain, err := decryptPassword(s.key, orm.Password)
+		if password != nil {
+			plain, err = *password, nil
+		}
+
+		if err != nil {
+			return mb, err
+		}
+
+		if err = s.check(domainName, mb.Email, plain); err != nil {
+			return mb, err
+		}
+
+		if values["password"], err = encryptPassword(s.key, plain); err != nil {
+			return mb, err
+		}
+
+		if domainName != mb.Domain {
+			values["domain"] = domainName
+			values["uid_validity"] = 0
+			values["last_uid"] = 0
+		}
+
+		values["last_error"] = ""
+	}
+
+	if settings.Status != nil {
+		values["status"] = *settings.Status
+	}
+
+	if len(values) > 0 {
+		if err = s.repo.UpdateMailbox(uid, values); err != nil {
+			return mb, err
+		}
+	}
+
+	if settings.Status != nil && *settings.Status == domain.MailboxActive && mb.Status != domain.MailboxActive {
+		s.publish(ctx, Job{Type: JobFetch, MailboxUUID: uid})
+	}
+
+	return s.Mailbox(userUUID, uid)
+}
+
+// Delete - письма удаленного ящика больше не показываются.
+func (s *Service) Delete(userUUID, uid uuid.UUID) error {
+	if _, err := s.Mailbox(userUUID, uid); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteMailbox(uid)
+}
+
+// RequestFetch - забрать почту ящика вне расписания.
+func (s *Service) RequestFetch(ctx context.Context, userUUID, uid uuid.UUID) error {
+	if !s.enabled {
+		return ErrMailboxDisabled
+	}
+
+	mb, err := s.Mailbox(userUUID, uid)
+	if err != nil {
+		return err
+	}
+
+	if mb.Status != domain.MailboxActive {
+		return ErrMailboxPaused
+	}
+
+	return s.queue.Publish(ctx, Job{Type: JobFetch, MailboxUUID: uid})
+}
+
+// ScheduleFetch - задачи загрузки почты для ящиков, которые не обновлялись дольше interval.
+func (s *Service) ScheduleFetch(ctx context.Context, interval time.Duration) (int, error) {
+	orms, err := s.repo.DueMailboxes(interval)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, orm := range orms {
+		if err = s.queue.Publish(ctx, Job{Type: JobFetch, MailboxUUID: orm.UUID}); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(orms), nil
+}
+
+// Consume - обработка задач очереди до отмены ctx.
+func (s *Service) Consume(ctx context.Context, link Linker) error {
+	return s.queue.Consume(ctx, func(job Job) error {
+		switch job.Type {
+		case JobFetch:
+			_, err := s.Fetch(job.MailboxUUID, link)
+			return err
+		case JobSend:
+			if job.SentUUID == nil {
+				return fmt.Errorf("send job without sent_uuid for mailbox %s", job.MailboxUUID)
+			}
+
+			return s.Deliver(*job.SentUUID)
+		default:
+			return fmt.Errorf("unknown mailbox job type %s", job.Type)
+		}
+	})
+}
+
+// Fetch - новые письма INBOX, не больше mailboxFetchBatch за раз; ошибка сохраняется в ящике.
+func (s *Service) Fetch(uid uuid.UUID, link Linker) (int, error) {
+	orm, err := s.repo.GetMailbox(uid)
+	if err != nil {
+		var notFoundErr dto.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return 0, nil // ящик удален, пока задача была в очереди
+		}
+
+		return 0, err
+	}
+
+	if orm.Status != domain.MailboxActive {
+		return 0, nil
+	}
+
+	mb := mailboxToDomain(orm)
+
+	stored := 0
+	validity, lastUID, err := s.fetchInbox(mb, orm.Password, func(msg imapMessage) error {
+		ok, err := s.store(mb, msg, link)
+		if ok {
+			stored++
+		}
+
+		return err
+	})
+
+	values := map[string]interface{}{
+		"uid_validity":    int64(validity),
+		"last_uid":        int64(lastUID),
+		"last_fetched_at": time.Now(),
+		"last_error":      "",
+	}
+
+	if err != nil {
+		server, _ := s.servers(mb.Domain)
+		values["last_error"] = publicError(server, err).Error()
+	}
+
+	if updateErr := s.repo.UpdateMailbox(uid, values); updateErr != nil {
+		return stored, updateErr
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"mailbox": uid,
+		"stored":  stored,
+	}).Debug("mailbox fetched")
+
+	return stored, err
+}
+
+// fetchInbox - письма после LastUID передаются в handle по возрастанию UID, возвращается, докуда дошли.
+func (s *Service) fetchInbox(mb domain.Mailbox, encrypted string, handle func(imapMessage) error) (uint32, uint32, error) {
+	password, err := decryptPassword(s.key, encrypted)
+	if err != nil {
+		return mb.UIDValidity, mb.LastUID, err
+	}
+
+	server, _ := s.servers(mb.Domain)
+
+	c, err := dialIMAP(server, mailboxTimeout)
+	if err != nil {
+		return mb.UIDValidity, mb.LastUID, err
+	}
+	defer c.Close()
+
+	if err = c.Login(mb.Email, password); err != nil {
+		return mb.UIDValidity, mb.LastUID, err
+	}
+
+	validity, err := c.SelectInbox()
+	if err != nil {
+		return mb.UIDValidity, mb.LastUID, err
+	}
+
+	lastUID := mb.LastUID
+	if validity != mb.UIDValidity {
+		lastUID = 0
+	}
+
+	uids, err := c.SearchUIDs(lastUID, time.Now().AddDate(0, -s.months, 0))
+	if err != nil {
+		return validity, lastUID, err
+	}
+
+	for _, uid := range lo.Subset(uids, 0, mailboxFetchBatch) {
+		msg, err := c.Fetch(uid)
+		if err == nil {
+			err = handle(msg)
+		}
+
+		if err != nil && !errors.Is(err, ErrIMAP) {
+			return validity, lastUID, err
+		}
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"mailbox": mb.UUID,
+				"uid":     uid,
+			}).Error("mailbox message skipped: ", err)
+		}
+
+		lastUID = uid
+	}
+
+	return validity, lastUID, nil
+}
+
+// store - письмо, которое не удалось разобрать, пропускается.
+func (s *Service) store(mb domain.Mailbox, msg imapMessage, link Linker) (bool, error) {
+	m, err := emails.ParseInbound(msg.Raw)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"mailbox": mb.UUID,
+			"uid":     msg.UID,
+		}).Error("mailbox message parse error: ", err)
+
+		return false, nil
+	}
+
+	body := m.Body
+	if r := []rune(body); len(r) > mailboxMaxBody {
+		body = string(r[:mailboxMaxBody])
+	}
+
+	agents, tasks := []uuid.UUID{}, []uuid.UUID{}
+	if link != nil {
+		agents, tasks = link(mb, m)
+	}
+
+	return s.repo.StoreEmail(MailboxEmail{
+		MailboxUUID: mb.UUID,
+		UserUUID:    mb.UserUUID,
+		Email:       mb.Email,
+		Sender:      m.From,
+		Recipients:  m.RecipientAddresses(),
+		Subject:     m.Subject,
+		BodyText:    body,
+		MessageID:   m.MessageID,
+		BodyHash:    domain.MailboxEmailHash(m.MessageID, m.From, m.Subject, m.Body),
+		AgentUUIDs:  uuidsToArray(agents),
+		TaskUUIDs:   uuidsToArray(tasks),
+		ReceivedAt:  msg.ReceivedAt,
+	})
+}
+
+// check - вход по IMAP с указанными данными.
+func (s *Service) check(domainName, email, password string) error {
+	server, _ := s.servers(domainName)
+
+	c, err := dialIMAP(server, mailboxTimeout/4)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrMailboxConnect, publicError(server, err))
+	}
+	defer c.Close()
+
+	if err = c.Login(email, password); err != nil {
+		return fmt.Errorf("%w: %s", ErrMailboxConnect, publicError(server, err))
+	}
+
+	return nil
+}
+
+// Send - письмо ставится в очередь, отправляется через SMTP ящика пользователя (см. Deliver).
+func (s *Service) Send(ctx context.Context, userUUID, uid uuid.UUID, recipient, subject, body string) (domain.SentEmail, error) {
+	if !s.enabled {
+		return domain.SentEmail{}, ErrMailboxDisabled
+	}
+
+	if strings.ContainsAny(subject, "\r\n") {
+		return domain.SentEmail{}, emails.ErrMailHeader
+	}
+
+	mb, err := s.Mailbox(userUUID, uid)
+	if err != nil {
+		return domain.SentEmail{}, err
+	}
+
+	if mb.Status != domain.MailboxActive {
+		return domain.SentEmail{}, ErrMailboxPaused
+	}
+
+	orm, err := s.repo.CreateSentEmail(MailboxSentEmail{
+		MailboxUUID:    mb.UUID,
+		UserUUID:       userUUID,
+		SenderEmail:    mb.Email,
+		RecipientEmail: strings.TrimSpace(recipient),
+		Subject:        subject,
+		Body:           body,
+		Status:         domain.SentEmailQueued,
+	})
+	if err != nil {
+		return domain.SentEmail{}, err
+	}
+
+	err = s.queue.Publish(ctx, Job{Type: JobSend, MailboxUUID: mb.UUID, SentUUID: &orm.UUID})
+	if err != nil {
+		_ = s.repo.UpdateSentEmail(orm.UUID, domain.SentEmailFailed, err.Error())
+		return domain.SentEmail{}, err
+	}
+
+	return sentEmailToDomain(orm), nil
+}
+
+// Deliver - отправка письма из очереди; уже отправленное (повторная доставка задачи) не отправляется.
+func (s *Service) Deliver(sentUUID uuid.UUID) error {
+	sent, err := s.repo.GetSentEmail(sentUUID)
+	if err != nil || sent.Status != domain.SentEmailQueued {
+		return err
+	}
+
+	err = s.deliver(sent)
+	if err != nil {
+		return s.repo.UpdateSentEmail(sent.UUID, domain.SentEmailFailed, err.Error())
+	}
+
+	return s.repo.UpdateSentEmail(sent.UUID, domain.SentEmailSent, "")
+}
+
+func (s *Service) deliver(sent MailboxSentEmail) error {
+	mb, err := s.repo.GetMailbox(sent.MailboxUUID)
+	if err != nil {
+		return err
+	}
+
+	password, err := decryptPassword(s.key, mb.Password)
+	if err != nil {
+		return err
+	}
+
+	_, server := s.servers(mb.Domain)
+
+	return sendSMTP(server, dto.SendEmailDTO{
+		SenderEmail:    mb.Email,
+		Password:       password,
+		RecipientEmail: sent.RecipientEmail,
+		Subject:        sent.Subject,
+		Body:           sent.Body,
+	})
+}
+
+// sendSMTP - текст письма отправляется как html с переносами строк.
+func sendSMTP(server Server, d dto.SendEmailDTO) error {
+	t := &emails.SMTPTransport{
+		User:     d.SenderEmail,
+		Password: d.Password,
+		Host:     server.Host,
+		Port:     server.Port,
+		StartTLS: server.Security == SecurityStartTLS,
+		Insecure: server.Security == SecurityNone,
+		Dialer:   server.dialer(mailboxTimeout / 4),
+	}
+
+	err := t.Send(domain.Mail{
+		From:    d.SenderEmail,
+		To:      []string{d.RecipientEmail},
+		Subject: d.Subject,
+		Body:    strings.ReplaceAll(html.EscapeString(d.Body), "\n", "<br>\n"),
+	})
+	if errors.Is(err, emails.ErrMailHeader) {
+		return err
+	}
+
+	return publicError(server, err)
+}
+
+func (s *Service) Emails(filter dto.EmailSearchDTO) ([]domain.MailboxEmail, int64, error) {
+	orms, total, err := s.repo.SearchEmails(filter)
+
+	return lo.Map(orms, func(orm MailboxEmail, _ int) domain.MailboxEmail {
+		return emailToDomain(orm)
+	}), total, err
+}
+
+func (s *Service) SentEmails(userUUID, uid uuid.UUID, offset, limit int) ([]domain.SentEmail, int64, error) {
+	if _, err := s.Mailbox(userUUID, uid); err != nil {
+		return nil, 0, err
+	}
+
+	orms, total, err := s.repo.GetSentEmails(uid, offset, limit)
+
+	return lo.Map(orms, func(orm MailboxSentEmail, _ int) domain.SentEmail {
+		return sentEmailToDomain(orm)
+	}), total, err
+}
+
+func (s *Service) publish(ctx context.Context, job Job) {
+	if err := s.queue.Publish(ctx, job); err != nil {
+		logrus.WithField("mailbox", job.MailboxUUID).Error("mailbox job publish error: ", err)
+	}
+}
@@ -0,0 +1,63 @@
+package mailbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Mailbox - почтовый ящик пользователя, пароль зашифрован (см. encryptPassword).
+type Mailbox struct {
+	UUID     uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	UserUUID uuid.UUID `gorm:"type:uuid;not null"`
+	Email    string    `gorm:"type:varchar(100);not null"`
+	Password string    `gorm:"type:text;not null"`
+	Domain   string    `gorm:"type:varchar(100);not null"`
+	Status   int       `gorm:"type:int;default:1;not null"`
+
+	UIDValidity   int64      `gorm:"type:bigint;default:0;not null"`
+	LastUID       int64      `gorm:"type:bigint;default:0;not null"`
+	LastFetchedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	LastError     string     `gorm:"type:text;default:'';not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
+
+type MailboxEmail struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	MailboxUUID uuid.UUID `gorm:"type:uuid;not null"`
+	UserUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	Email       string    `gorm:"type:varchar(100);not null"`
+
+	Sender     string         `gorm:"type:text;default:'';not null"`
+	Recipients pq.StringArray `gorm:"type:text[];default:'{}';not null;"`
+	Subject    string         `gorm:"type:text;default:'';not null"`
+	BodyText   string         `gorm:"type:text;default:'';not null"`
+	MessageID  string         `gorm:"type:text;default:'';not null"`
+	BodyHash   string         `gorm:"type:varchar(64);not null"`
+
+	AgentUUIDs pq.StringArray `gorm:"column:agent_uuids;type:uuid[];default:'{}';not null;"`
+	TaskUUIDs  pq.StringArray `gorm:"column:task_uuids;type:uuid[];default:'{}';not null;"`
+
+	ReceivedAt time.Time `gorm:"type:timestamptz;not null"`
+	CreatedAt  time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type MailboxSentEmail struct {
+	UUID           uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	MailboxUUID    uuid.UUID `gorm:"type:uuid;not null"`
+	UserUUID       uuid.UUID `gorm:"type:uuid;not null"`
+	SenderEmail    string    `gorm:"type:varchar(100);not null"`
+	RecipientEmail string    `gorm:"type:varchar(100);not null"`
+	Subject        string    `gorm:"type:varchar(200);not null"`
+	Body           string    `gorm:"type:text;not null"`
+
+	Status string `gorm:"type:varchar(20);default:'queued';not null"`
+	Error  string `gorm:"type:text;default:'';not null"`
+
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	SentAt    *time.Time `gorm:"type:timestamptz;default:NULL;"`
+}
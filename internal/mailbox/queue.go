@@ -0,0 +1,119 @@
+package mailbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+// Типы задач очереди почтовых ящиков.
+const (
+	JobFetch = "fetch"
+	JobSend  = "send"
+)
+
+// Job - задача очереди: забрать почту ящика или отправить письмо из него.
+type Job struct {
+	Type        string     `json:"type"`
+	MailboxUUID uuid.UUID  `json:"mailbox_uuid"`
+	SentUUID    *uuid.UUID `json:"sent_uuid,omitempty"`
+}
+
+// Queue - очередь задач: Kafka или память процесса (KAFKA_BROKERS=memory://) для локальных стендов.
+type Queue interface {
+	Publish(ctx context.Context, job Job) error
+	Consume(ctx context.Context, handle func(Job) error) error
+}
+
+func NewQueue(conf *configs.Configs) Queue {
+	if len(conf.KAFKA_BROKERS) == 1 && conf.KAFKA_BROKERS[0] == "memory://" {
+		return NewMemoryQueue()
+	}
+
+	return &KafkaQueue{
+		writer: conf.NewKafkaWriter(),
+		reader: conf.NewKafkaReader(),
+	}
+}
+
+// KafkaQueue - задачи одного ящика в одной партиции (ключ - uuid ящика), обрабатываются по порядку.
+type KafkaQueue struct {
+	writer *kafka.Writer
+	reader *kafka.Reader
+}
+
+func (q *KafkaQueue) Publish(ctx context.Context, job Job) error {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	return q.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(job.MailboxUUID.String()),
+		Value: b,
+	})
+}
+
+// Consume - ошибка обработки сохраняется в ящике или письме, сообщение все равно подтверждается.
+func (q *KafkaQueue) Consume(ctx context.Context, handle func(Job) error) error {
+	for {
+		msg, err := q.reader.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+
+		job := Job{}
+		if err = json.Unmarshal(msg.Value, &job); err != nil {
+			logrus.WithField("offset", msg.Offset).Error("mailbox job decode error: ", err)
+		} else if err = handle(job); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"type":    job.Type,
+				"mailbox": job.MailboxUUID,
+			}).Error("mailbox job error: ", err)
+		}
+
+		if err = q.reader.CommitMessages(ctx, msg); err != nil {
+			return err
+		}
+	}
+}
+
+// MemoryQueue - очередь в памяти процесса, задачи теряются при перезапуске.
+type MemoryQueue struct {
+	jobs chan Job
+}
+
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{
+		jobs: make(chan Job, 1000),
+	}
+}
+
+func (q *MemoryQueue) Publish(ctx context.Context, job Job) error {
+	select {
+	case q.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *MemoryQueue) Consume(ctx context.Context, handle func(Job) error) error {
+	for {
+		select {
+		case job := <-q.jobs:
+			if err := handle(job); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"type":    job.Type,
+					"mailbox": job.MailboxUUID,
+				}).Error("mailbox job error: ", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package mailbox
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrMailboxExists = errors.New("почтовый ящик уже подключен")
+
+type Repository struct {
+	gorm *postgres.GDB
+}
+
+func NewRepository(db *postgres.GDB) *Repository {
+	return &Repository{
+		gorm: db,
+	}
+}
+
+func (r *Repository) CreateMailbox(orm Mailbox) (Mailbox, error) {
+	var used int64
+
+	err := r.gorm.DB.Model(&Mailbox{}).
+		Where("user_uuid = ?", orm.UserUUID).
+		Where("lower(email) = ?", strings.ToLower(orm.Email)).
+		Where("deleted_at is null").
+		Count(&used).
+		Error
+	if err != nil {
+		return orm, err
+	}
+
+	if used > 0 {
+		return orm, ErrMailboxExists
+	}
+
+	err = r.gorm.DB.Create(&orm).Error
+
+	return orm, err
+}
+
+func (r *Repository) GetMailbox(uid uuid.UUID) (orm Mailbox, err error) {
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("почтовый ящик не найден")
+	}
+
+	return orm, err
+}
+
+func (r *Repository) GetMailboxes(userUUID uuid.UUID) (orms []Mailbox, err error) {
+	err = r.gorm.DB.
+		Where("user_uuid = ?", userUUID).
+		Where("deleted_at is null").
+		Order("created_at").
+		Find(&orms).
+		Error
+
+	return orms, err
+}
+
+// DueMailboxes - активные ящики, почту которых не забирали дольше interval.
+func (r *Repository) DueMailboxes(interval time.Duration) (orms []Mailbox, err error) {
+	err = r.gorm.DB.
+		Where("status = ?", domain.MailboxActive).
+		Where("deleted_at is null").
+		Where("last_fetched_at is null or last_fetched_at < ?", time.Now().Add(-interval)).
+		Find(&orms).
+		Error
+
+	return orms, err
+}
+
+func (r *Repository) UpdateMailbox(uid uuid.UUID, values map[string]interface{}) error {
+	values["updated_at"] = gorm.Expr("now()")
+
+	return r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Updates(values).
+		Error
+}
+
+func (r *Repository) DeleteMailbox(uid uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&Mailbox{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Update("deleted_at", gorm.Expr("now()")).
+		Error
+}
+
+// StoreEmail - письмо с тем же хэшем в ящике уже есть: false.
+func (r *Repository) StoreEmail(orm MailboxEmail) (bool, error) {
+	res := r.gorm.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&orm)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *Repository) SearchEmails(filter dto.EmailSearchDTO) (orms []MailboxEmail, total int64, err error) {
+	q := r.gorm.DB.Model(&MailboxEmail{}).
+		Where("user_uuid = ?", filter.UserUUID).
+		Where("mailbox_uuid in (?)", r.gorm.DB.Model(&Mailbox{}).Select("uuid").Where("deleted_at is null"))
+
+	if filter.MailboxUUID != nil {
+		q = q.Where("mailbox_uuid = ?", *filter.MailboxUUID)
+	}
+
+	if filter.AgentUUID != nil {
+		q = q.Where("? = any(agent_uuids)", *filter.AgentUUID)
+	}
+
+	if filter.TaskUUID != nil {
+		q = q.Where("? = any(task_uuids)", *filter.TaskUUID)
+	}
+
+	err = q.Count(&total).Error
+	if err != nil {
+		return orms, total, err
+	}
+
+	err = q.
+		Order("received_at desc").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&orms).
+		Error
+
+	return orms, total, err
+}
+
+func (r *Repository) CreateSentEmail(orm MailboxSentEmail) (MailboxSentEmail, error) {
+	err := r.gorm.DB.Create(&orm).Error
+
+	return orm, err
+}
+
+func (r *Repository) GetSentEmail(uid uuid.UUID) (orm MailboxSentEmail, err error) {
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Take(&orm).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return orm, dto.NotFoundErr("письмо не найдено")
+	}
+
+	return orm, err
+}
+
+func (r *Repository) GetSentEmails(mailboxUUID uuid.UUID, offset, limit int) (orms []MailboxSentEmail, total int64, err error) {
+	q := r.gorm.DB.Model(&MailboxSentEmail{}).
+		Where("mailbox_uuid = ?", mailboxUUID)
+
+	err = q.Count(&total).Error
+	if err != nil {
+		return orms, total, err
+	}
+
+	err = q.
+		Order("created_at desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&orms).
+		Error
+
+	return orms, total, err
+}
+
+func (r *Repository) UpdateSentEmail(uid uuid.UUID, status, errText string) error {
+	return r.gorm.DB.
+		Model(&MailboxSentEmail{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"error":   errText,
+			"sent_at": lo.Ternary[interface{}](status == domain.SentEmailSent, gorm.Expr("now()"), nil),
+		}).
+		Error
+}
+
+func mailboxToDomain(orm Mailbox) domain.Mailbox {
+	return domain.Mailbox{
+		UUID:          orm.UUID,
+		UserUUID:      orm.UserUUID,
+		Email:         orm.Email,
+		Domain:        orm.Domain,
+		Status:        orm.Status,
+		UIDValidity:   uint32(orm.UIDValidity),
+		LastUID:       uint32(orm.LastUID),
+		LastFetchedAt: orm.LastFetchedAt,
+		LastError:     orm.LastError,
+		CreatedAt:     orm.CreatedAt,
+		UpdatedAt:     orm.UpdatedAt,
+	}
+}
+
+func emailToDomain(orm MailboxEmail) domain.MailboxEmail {
+	return domain.MailboxEmail{
+		ID:          orm.ID,
+		MailboxUUID: orm.MailboxUUID,
+		UserUUID:    orm.UserUUID,
+		Email:       orm.Email,
+		Sender:      orm.Sender,
+		Recipients:  orm.Recipients,
+		Subject:     orm.Subject,
+		BodyText:    orm.BodyText,
+		MessageID:   orm.MessageID,
+		BodyHash:    orm.BodyHash,
+		AgentUUIDs:  parseUUIDs(orm.AgentUUIDs),
+		TaskUUIDs:   parseUUIDs(orm.TaskUUIDs),
+		ReceivedAt:  orm.ReceivedAt,
+		CreatedAt:   orm.CreatedAt,
+	}
+}
+
+func sentEmailToDomain(orm MailboxSentEmail) domain.SentEmail {
+	return domain.SentEmail{
+		UUID:           orm.UUID,
+		MailboxUUID:    orm.MailboxUUID,
+		UserUUID:       orm.UserUUID,
+		SenderEmail:    orm.SenderEmail,
+		RecipientEmail: orm.RecipientEmail,
+		Subject:        orm.Subject,
+		Body:           orm.Body,
+		Status:         orm.Status,
+		Error:          orm.Error,
+		CreatedAt:      orm.CreatedAt,
+		SentAt:         orm.SentAt,
+	}
+}
+
+func parseUUIDs(a pq.StringArray) []uuid.UUID {
+	return lo.FilterMap(a, func(s string, _ int) (uuid.UUID, bool) {
+		uid, err := uuid.Parse(s)
+		return uid, err == nil
+	})
+}
+
+func uuidsToArray(uids []uuid.UUID) pq.StringArray {
+	return lo.Map(lo.Uniq(uids), func(uid uuid.UUID, _ int) string {
+		return uid.String()
+	})
+}
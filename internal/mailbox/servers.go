@@ -0,0 +1,181 @@
+package mailbox
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Защита соединения с почтовым сервером.
+const (
+	SecurityTLS      = "tls"      // неявный TLS: imaps 993, smtps 465
+	SecurityStartTLS = "starttls" // STARTTLS: imap 143, smtp 587
+	SecurityNone     = "none"     // без шифрования, только для локальных стендов
+)
+
+var (
+	ErrInvalidServer = errors.New("invalid mailbox server address")
+	ErrServerAddress = errors.New("почтовый сервер должен быть во внешней сети")
+	ErrServerConnect = errors.New("ошибка соединения с почтовым сервером")
+
+	// reservedNets - служебные сети, не покрытые методами net.IP
+	reservedNets = []*net.IPNet{
+		mustCIDR("0.0.0.0/8"),
+		mustCIDR("100.64.0.0/10"),
+		mustCIDR("198.18.0.0/15"),
+	}
+)
+
+// Server - адрес IMAP или SMTP сервера почтового ящика.
+// External - адрес выведен из домена пользователя, соединение разрешено только с публичными IP.
+type Server struct {
+	Host     string
+	Port     string
+	Security string
+	External bool
+}
+
+func (s Server) Addr() string {
+	return s.Host + ":" + s.Port
+}
+
+// dialer - для External адрес проверяется после разрешения имени, при каждом соединении:
+// imap.<домен>, указывающий во внутреннюю сеть, не дает к ней доступа.
+func (s Server) dialer(timeout time.Duration) *net.Dialer {
+	d := &net.Dialer{Timeout: timeout}
+	if !s.External {
+		return d
+	}
+
+	d.Control = func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+
+		if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+			return ErrServerAddress
+		}
+
+		return nil
+	}
+
+	return d
+}
+
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustCIDR(cidr string) *net.IPNet {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return n
+}
+
+// publicError - ошибка для пользователя и last_error: ответы IMAP и SMTP сервера как есть,
+// ошибки сети без адресов и текста (они только в логе).
+func publicError(s Server, err error) error {
+	var smtpErr *textproto.Error
+	if err == nil || errors.Is(err, ErrIMAP) || errors.As(err, &smtpErr) {
+		return err
+	}
+
+	if errors.Is(err, ErrServerAddress) {
+		return ErrServerAddress
+	}
+
+	logrus.WithField("server", s.Addr()).Warn("mailbox server error: ", err)
+
+	return ErrServerConnect
+}
+
+func (s Server) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: s.Host,
+	}
+}
+
+// ParseServer - адрес сервера в виде строки подключения:
+//
+//	imaps://imap.mail.ru:993       - IMAP с неявным TLS
+//	imap://imap.mail.ru:143        - IMAP с STARTTLS
+//	imap+insecure://localhost:1143 - IMAP без шифрования
+//	smtps://smtp.mail.ru:465       - SMTP с неявным TLS
+//	smtp://smtp.mail.ru:587        - SMTP с STARTTLS
+//	smtp+insecure://localhost:1025 - SMTP без шифрования
+func ParseServer(dsn string) (Server, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return Server{}, fmt.Errorf("%w: %s", ErrInvalidServer, err)
+	}
+
+	if u.Hostname() == "" {
+		return Server{}, fmt.Errorf("%w: host is empty", ErrInvalidServer)
+	}
+
+	ports := map[string][2]string{
+		"imaps":         {SecurityTLS, "993"},
+		"imap":          {SecurityStartTLS, "143"},
+		"imap+insecure": {SecurityNone, "143"},
+		"smtps":         {SecurityTLS, "465"},
+		"smtp":          {SecurityStartTLS, "587"},
+		"smtp+insecure": {SecurityNone, "25"},
+	}
+
+	p, ok := ports[u.Scheme]
+	if !ok {
+		return Server{}, fmt.Errorf("%w: unknown scheme %s", ErrInvalidServer, u.Scheme)
+	}
+
+	s := Server{Host: u.Hostname(), Port: u.Port(), Security: p[0]}
+	if s.Port == "" {
+		s.Port = p[1]
+	}
+
+	return s, nil
+}
+
+func parseServerPtr(dsn string) (*Server, error) {
+	s, err := ParseServer(dsn)
+
+	return &s, err
+}
+
+// servers - IMAP и SMTP ящика: заданные в конфигурации или imap.<домен> и smtp.<домен> с неявным TLS.
+// Домен задает пользователь, поэтому такие серверы External.
+func (s *Service) servers(domain string) (imap, smtp Server) {
+	imap = Server{Host: "imap." + domain, Port: "993", Security: SecurityTLS, External: true}
+	if s.imap != nil {
+		imap = *s.imap
+	}
+
+	smtp = Server{Host: "smtp." + domain, Port: "465", Security: SecurityTLS, External: true}
+	if s.smtp != nil {
+		smtp = *s.smtp
+	}
+
+	return imap, smtp
+}
@@ -0,0 +1,231 @@
+package notifications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Взятая в отправку доставка, не завершенная за это время (упал инстанс), отправляется повторно.
+const deliverySendingTimeout = 10 * time.Minute
+
+// EnqueueDelivery - пока доставка ждет окна, новые события по той же сущности накапливаются в ней.
+// Срочность и ранний срок доставки сохраняются. db - транзакция вызывающего или r.gorm.DB.
+func (r *Repository) EnqueueDelivery(db *gorm.DB, dm domain.NotificationDelivery) error {
+	events, err := json.Marshal(dm.Events)
+	if err != nil {
+		return err
+	}
+
+	orm := NotificationDelivery{
+		UUID:        dm.UUID,
+		UserEmail:   dm.UserEmail,
+		Channel:     dm.Channel,
+		Type:        dm.Type,
+		EntityUUID:  dm.EntityUUID,
+		ProjectUUID: dm.ProjectUUID,
+		CompanyUUID: dm.CompanyUUID,
+		Events:      events,
+		Subject:     dm.Subject,
+		URL:         dm.URL,
+		Urgent:      dm.Urgent,
+		Status:      domain.DeliveryPending,
+		DeliverAt:   dm.DeliverAt,
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "user_email"}, {Name: "channel"}, {Name: "type"}, {Name: "entity_uuid"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "status", Value: domain.DeliveryPending}}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"events":     gorm.Expr("(select jsonb_agg(distinct e) from jsonb_array_elements(notification_deliveries.events || excluded.events) e)"),
+			"subject":    gorm.Expr("excluded.subject"),
+			"url":        gorm.Expr("excluded.url"),
+			"urgent":     gorm.Expr("notification_deliveries.urgent or excluded.urgent"),
+			"deliver_at": gorm.Expr("least(notification_deliveries.deliver_at, excluded.deliver_at)"),
+			"updated_at": gorm.Expr("now()"),
+		}),
+	}).Create(&orm).Error
+}
+
+// ClaimDueDeliveries - берет в отправку доставки, срок которых наступил. Несколько инстансов не возьмут одну доставку дважды.
+// Зависшая в отправке доставка берется повторно, пока не исчерпаны попытки, затем помечается неудачной.
+func (r *Repository) ClaimDueDeliveries(now time.Time, limit int) (orms []NotificationDelivery, err error) {
+	err = r.gorm.DB.Exec(`
+		UPDATE notification_deliveries
+		SET status = ?, error = ?, updated_at = now()
+		WHERE status = ? AND updated_at < ? AND attempts >= ?`,
+		domain.DeliveryFailed, "отправка прервана, попытки исчерпаны",
+		domain.DeliverySending, now.Add(-deliverySendingTimeout), domain.DeliveryMaxAttempts,
+	).Error
+	if err != nil {
+		return orms, err
+	}
+
+	err = r.gorm.DB.Raw(`
+		UPDATE notification_deliveries
+		SET status = ?, attempts = attempts + 1, updated_at = now()
+		WHERE uuid IN (
+			SELECT uuid FROM notification_deliveries
+			WHERE (status = ? AND deliver_at <= ?) OR (status = ? AND updated_at < ? AND attempts < ?)
+			ORDER BY deliver_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.DeliverySending,
+		domain.DeliveryPending, now,
+		domain.DeliverySending, now.Add(-deliverySendingTimeout), domain.DeliveryMaxAttempts,
+		limit,
+	).Scan(&orms).Error
+
+	return orms, err
+}
+
+func (r *Repository) MarkDeliverySent(uid uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&NotificationDelivery{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"status":     domain.DeliverySent,
+			"error":      "",
+			"sent_at":    gorm.Expr("now()"),
+			"updated_at": gorm.Expr("now()"),
+		}).
+		Error
+}
+
+// MarkDeliveryFailed - возвращает доставку в очередь до retryAt. Если попытки исчерпаны
+// или по сущности уже ждет более свежая доставка, доставка помечается неудачной.
+func (r *Repository) MarkDeliveryFailed(uid uuid.UUID, reason string, retry bool, retryAt time.Time) error {
+	return r.gorm.DB.Exec(`
+		UPDATE notification_deliveries d
+		SET status = CASE WHEN ? AND NOT EXISTS (
+				SELECT 1 FROM notification_deliveries p
+				WHERE p.status = ? AND p.user_email = d.user_email AND p.channel = d.channel
+					AND p.type = d.type AND p.entity_uuid = d.entity_uuid
+			) THEN ? ELSE ? END,
+			error = ?, deliver_at = ?, updated_at = now()
+		WHERE uuid = ?`,
+		retry,
+		domain.DeliveryPending,
+		domain.DeliveryPending, domain.DeliveryFailed,
+		reason, retryAt,
+		uid,
+	).Error
+}
+
+func (r *Repository) SearchDeliveries(filter dto.NotificationDeliverySearchDTO) (orms []NotificationDelivery, total int64, err error) {
+	q := r.gorm.DB.Model(&NotificationDelivery{})
+
+	if filter.Email != nil {
+		q = q.Where("user_email = ?", *filter.Email)
+	}
+
+	if filter.CompanyUUID != nil {
+		q = q.Where("company_uuid = ?", *filter.CompanyUUID)
+	}
+
+	if filter.Status != nil {
+		q = q.Where("status = ?", *filter.Status)
+	}
+
+	if filter.Channel != nil {
+		q = q.Where("channel = ?", *filter.Channel)
+	}
+
+	err = q.Count(&total).Error
+	if err != nil {
+		return orms, total, err
+	}
+
+	err = q.
+		Order("created_at desc").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&orms).
+		Error
+
+	return orms, total, err
+}
+
+func deliveryToDomain(orm NotificationDelivery) domain.NotificationDelivery {
+	var events []string
+	_ = json.Unmarshal(orm.Events, &events)
+
+	return domain.NotificationDelivery{
+		UUID:        orm.UUID,
+		UserEmail:   orm.UserEmail,
+		Channel:     orm.Channel,
+		Type:        orm.Type,
+		EntityUUID:  orm.EntityUUID,
+		ProjectUUID: orm.ProjectUUID,
+		CompanyUUID: orm.CompanyUUID,
+		Events:      events,
+		Subject:     orm.Subject,
+		URL:         orm.URL,
+		Urgent:      orm.Urgent,
+		Status:      orm.Status,
+		Attempts:    orm.Attempts,
+		Error:       orm.Error,
+		DeliverAt:   orm.DeliverAt,
+		SentAt:      orm.SentAt,
+		CreatedAt:   orm.CreatedAt,
+		UpdatedAt:   orm.UpdatedAt,
+	}
+}
+
+func (s *Service) EnqueueDelivery(dm domain.NotificationDelivery) error {
+	return s.EnqueueDeliveries(s.repo.gorm.DB, []domain.NotificationDelivery{dm})
+}
+
+// EnqueueDeliveries - ставит доставки в очередь в транзакции tx вызывающего.
+func (s *Service) EnqueueDeliveries(tx *gorm.DB, dms []domain.NotificationDelivery) error {
+	for _, dm := range dms {
+		if dm.UUID == uuid.Nil {
+			dm.UUID = uuid.New()
+		}
+
+		err := s.repo.EnqueueDelivery(tx, dm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) ClaimDueDeliveries(now time.Time, limit int) ([]domain.NotificationDelivery, error) {
+	orms, err := s.repo.ClaimDueDeliveries(now, limit)
+
+	return lo.Map(orms, func(orm NotificationDelivery, _ int) domain.NotificationDelivery {
+		return deliveryToDomain(orm)
+	}), err
+}
+
+func (s *Service) MarkDeliverySent(uid uuid.UUID) error {
+	return s.repo.MarkDeliverySent(uid)
+}
+
+func (s *Service) MarkDeliveryFailed(dm domain.NotificationDelivery, reason error, now time.Time) error {
+	retry := dm.Attempts < domain.DeliveryMaxAttempts
+
+	return s.repo.MarkDeliveryFailed(dm.UUID, reason.Error(), retry, helpers.If(retry, dm.RetryAt(now), dm.DeliverAt))
+}
+
+func (s *Service) SearchDeliveries(filter dto.NotificationDeliverySearchDTO) ([]dto.NotificationDeliveryDTO, int64, error) {
+	orms, total, err := s.repo.SearchDeliveries(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lo.Map(orms, func(orm NotificationDelivery, _ int) dto.NotificationDeliveryDTO {
+		return dto.NewNotificationDeliveryDTO(deliveryToDomain(orm))
+	}), total, nil
+}
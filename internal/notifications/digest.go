@@ -0,0 +1,61 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/sirupsen/logrus"
+)
+
+// UnreadTaskStates - непрочитанные задачи пользователя: открытые задачи удаляются из уведомлений при открытии.
+func (s *Service) UnreadTaskStates(email string) (map[uuid.UUID]aggregates.StateDiff, error) {
+	dtos, err := s.repo.GetNotification(email)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[uuid.UUID]aggregates.StateDiff)
+	for _, d := range dtos {
+		if d.Type != "task" {
+			continue
+		}
+
+		uid, err := uuid.Parse(d.UUID)
+		if err != nil {
+			continue
+		}
+
+		state, _, err := s.GetTaskState(email, uid)
+		if err != nil {
+			logrus.Error("GetTaskState error: ", err)
+			continue
+		}
+
+		states[uid] = state
+	}
+
+	return states, nil
+}
+
+func (s *Service) LockDigest(email, periodKey string) (bool, error) {
+	return s.repo.LockDigest(email, periodKey)
+}
+
+func (s *Service) UnlockDigest(email, periodKey string) error {
+	return s.repo.UnlockDigest(email, periodKey)
+}
+
+func (s *Service) LastDigest(email string) (t time.Time, found bool, err error) {
+	return s.repo.GetDigestTime(email)
+}
+
+func (s *Service) StoreDigestTime(email string, t time.Time) error {
+	return s.repo.StoreDigestTime(email, t)
+}
+
+func (s *Service) HasNotifications(email string) bool {
+	count, err := s.repo.Count(email)
+
+	return err == nil && count > 0
+}
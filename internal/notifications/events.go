@@ -0,0 +1,8 @@
+package notifications
+
+import "github.com/krisch/crm-backend/dto"
+
+// OnTaskEvents - вызывается для каждого получателя с событиями задачи, для доставки по внешним каналам.
+func (s *Service) OnTaskEvents(fn func(string, dto.TaskDTO, []string) error) {
+	s.onTaskEvents = fn
+}
@@ -0,0 +1,216 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Redis - кэш счетчиков и непрочитанных, Postgres - постоянная история. Пока ключ есть, кэш считается актуальным.
+const cacheMarkerKey = "notifications:cache"
+
+type notificationWithTask struct {
+	Notification
+
+	TaskID   int
+	TaskName string
+}
+
+// UpsertNotification - новая активность снова делает уведомление непрочитанным и видимым.
+func (r *Repository) UpsertNotification(email, kind string, uid uuid.UUID, projectUUID *uuid.UUID, events []string, state aggregates.StateDiff) error {
+	jsEvents, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	jsState, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	orm := Notification{
+		UserEmail:   email,
+		Type:        kind,
+		EntityUUID:  uid,
+		ProjectUUID: projectUUID,
+		Events:      jsEvents,
+		State:       jsState,
+		UpdatedAt:   state.UpdatedAt,
+	}
+
+	return r.gorm.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_email"}, {Name: "type"}, {Name: "entity_uuid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"project_uuid": orm.ProjectUUID,
+			"events":       orm.Events,
+			"state":        orm.State,
+			"updated_at":   orm.UpdatedAt,
+			"read_at":      nil,
+			"hidden_at":    nil,
+		}),
+	}).Create(&orm).Error
+}
+
+func (r *Repository) MarkNotificationRead(email, kind string, uid uuid.UUID, read bool) error {
+	var readAt interface{}
+	if read {
+		readAt = gorm.Expr("now()")
+	}
+
+	return r.gorm.DB.
+		Model(&Notification{}).
+		Where("user_email = ? and type = ? and entity_uuid = ?", email, kind, uid).
+		Update("read_at", readAt).
+		Error
+}
+
+func (r *Repository) GetNotificationByEntity(email, kind string, uid uuid.UUID) (orm Notification, err error) {
+	err = r.gorm.DB.
+		Model(&Notification{}).
+		Where("user_email = ? and type = ? and entity_uuid = ?", email, kind, uid).
+		Take(&orm).
+		Error
+
+	return orm, err
+}
+
+func (r *Repository) MarkNotificationHidden(email, kind string, uid uuid.UUID) error {
+	return r.gorm.DB.
+		Model(&Notification{}).
+		Where("user_email = ? and type = ? and entity_uuid = ?", email, kind, uid).
+		Updates(map[string]interface{}{
+			"read_at":   gorm.Expr("coalesce(read_at, now())"),
+			"hidden_at": gorm.Expr("now()"),
+		}).
+		Error
+}
+
+func (r *Repository) HideAllNotifications(email string) error {
+	return r.gorm.DB.
+		Model(&Notification{}).
+		Where("user_email = ? and hidden_at is null", email).
+		Updates(map[string]interface{}{
+			"read_at":   gorm.Expr("coalesce(read_at, now())"),
+			"hidden_at": gorm.Expr("now()"),
+		}).
+		Error
+}
+
+func (r *Repository) SetNotificationStarred(email, kind string, uid uuid.UUID, star bool) error {
+	return r.gorm.DB.
+		Model(&Notification{}).
+		Where("user_email = ? and type = ? and entity_uuid = ?", email, kind, uid).
+		Update("starred", star).
+		Error
+}
+
+func (r *Repository) SearchNotifications(filter dto.NotificationSearchDTO) (orms []notificationWithTask, total int64, err error) {
+	q := r.gorm.DB.
+		Table("notifications").
+		Joins("left join tasks on tasks.uuid = notifications.entity_uuid and notifications.type = 'task'").
+		Where("notifications.user_email = ?", filter.Email)
+
+	if filter.Type != nil {
+		q = q.Where("notifications.type = ?", *filter.Type)
+	}
+
+	if filter.Status != nil {
+		q = q.Where(helpers.If(*filter.Status == "unread", "notifications.read_at is null", "notifications.read_at is not null"))
+	}
+
+	if filter.Starred != nil {
+		q = q.Where("notifications.starred = ?", *filter.Starred)
+	}
+
+	if filter.Hidden != nil && *filter.Hidden {
+		q = q.Where("notifications.hidden_at is not null")
+	} else {
+		q = q.Where("notifications.hidden_at is null")
+	}
+
+	if filter.ProjectUUID != nil {
+		q = q.Where("notifications.project_uuid = ?", *filter.ProjectUUID)
+	}
+
+	if filter.Search != nil && *filter.Search != "" {
+		q = q.Where("tasks.name ilike ?", "%"+*filter.Search+"%")
+	}
+
+	err = q.Count(&total).Error
+	if err != nil {
+		return orms, total, err
+	}
+
+	err = q.
+		Select("notifications.*, tasks.id as task_id, tasks.name as task_name").
+		Order("notifications.updated_at desc").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Scan(&orms).
+		Error
+
+	return orms, total, err
+}
+
+// UnreadNotifications - непрочитанные и не скрытые уведомления, из них восстанавливается redis.
+func (r *Repository) UnreadNotifications(email *string) (orms []Notification, err error) {
+	q := r.gorm.DB.
+		Model(&Notification{}).
+		Where("read_at is null and hidden_at is null")
+
+	if email != nil {
+		q = q.Where("user_email = ?", *email)
+	}
+
+	err = q.Find(&orms).Error
+
+	return orms, err
+}
+
+func (r *Repository) IsCacheBuilt(ctx context.Context) (bool, error) {
+	v, err := r.rds.GetStr(ctx, cacheMarkerKey)
+
+	return v != "", err
+}
+
+func (r *Repository) MarkCacheBuilt(ctx context.Context) error {
+	return r.rds.SetStr(ctx, cacheMarkerKey, time.Now().Format(time.RFC3339), 0)
+}
+
+// RestoreNotification - возвращает уведомление из Postgres в redis.
+func (r *Repository) RestoreNotification(ctx context.Context, orm Notification) error {
+	kindWithUUID := orm.Type + ":" + orm.EntityUUID.String()
+	key := fmt.Sprintf("notifications:%s:%s", orm.UserEmail, kindWithUUID)
+
+	err := r.rds.HSET(ctx, key, "state", []byte(orm.State))
+	if err != nil {
+		return err
+	}
+
+	err = r.rds.HSET(ctx, key, "star", helpers.If(orm.Starred, "1", "0"))
+	if err != nil {
+		return err
+	}
+
+	lastOpen, err := r.rds.HGet(ctx, key, "last_open")
+	if err != nil {
+		return err
+	}
+
+	if lastOpen == "" {
+		err = r.rds.HSET(ctx, key, "last_open", orm.CreatedAt.UnixMicro())
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.rds.ZADD(ctx, fmt.Sprintf("notifications:%s", orm.UserEmail), kindWithUUID, orm.UpdatedAt.UnixMicro())
+}
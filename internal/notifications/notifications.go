@@ -2,27 +2,81 @@ package notifications
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/krisch/crm-backend/internal/dictionary"
+	"github.com/krisch/crm-backend/internal/profile"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 type Service struct {
-	repo *Repository
-	dict *dictionary.Service
-	aggs *aggregates.Service
+	repo    *Repository
+	dict    *dictionary.Service
+	aggs    *aggregates.Service
+	profile *profile.Service
+
+	onTaskEvents func(string, dto.TaskDTO, []string) error
 }
 
-func New(repo *Repository, aggs *aggregates.Service, dict *dictionary.Service) *Service {
+func New(repo *Repository, aggs *aggregates.Service, dict *dictionary.Service, prfl *profile.Service) *Service {
 	return &Service{
-		repo: repo,
-		aggs: aggs,
-		dict: dict,
+		repo:    repo,
+		aggs:    aggs,
+		dict:    dict,
+		profile: prfl,
 	}
 }
 
+// Allowed - проверка настроек уведомлений пользователя, должна вызываться перед отправкой по любому каналу.
+// Для нескольких получателей настройки загружаются одним запросом через UsersNotificationSettings.
+func (s *Service) Allowed(email string, projectUUID uuid.UUID, channel string, events ...string) bool {
+	settings, err := s.UsersNotificationSettings([]string{email})
+	if err != nil {
+		logrus.Error("notification settings error: ", err)
+		return true
+	}
+
+	return allowed(settings, email, projectUUID, channel, events...)
+}
+
+// UsersNotificationSettings - настройки уведомлений пользователей по email; неизвестных пользователей в ответе нет.
+func (s *Service) UsersNotificationSettings(emails []string) (map[string]domain.NotificationSettings, error) {
+	users := make(map[uuid.UUID]string, len(emails))
+	for _, email := range emails {
+		if user, ok := s.dict.FindUser(email); ok {
+			users[user.UUID] = email
+		}
+	}
+
+	prefs, err := s.profile.UsersPreferences(lo.Keys(users))
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]domain.NotificationSettings, len(users))
+	for uid, email := range users {
+		settings[email] = lo.FromPtr(prefs[uid].Notifications)
+	}
+
+	return settings, nil
+}
+
+func allowed(settings map[string]domain.NotificationSettings, email string, projectUUID uuid.UUID, channel string, events ...string) bool {
+	userSettings, ok := settings[email]
+	if !ok {
+		return false
+	}
+
+	return userSettings.AllowedAny(projectUUID, events, channel)
+}
+
 func (s *Service) GetNotification(email string) ([]dto.NotificationDTO, error) {
 	dtos, err := s.repo.GetNotification(email)
 
@@ -35,14 +89,20 @@ func (s *Service) GetNotificationCount(email, kind string, uid uuid.UUID) (map[s
 
 func (s *Service) RemoveNotification(email, kind string, uid uuid.UUID) error {
 	err := s.repo.RemoveNotification(email, kind+":"+uid.String())
+	if err != nil {
+		return err
+	}
 
-	return err
+	return s.repo.MarkNotificationRead(email, kind, uid, true)
 }
 
 func (s *Service) RemoveNotifications(ctx context.Context, email string) error {
 	err := s.repo.RemoveNotifications(ctx, email)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return s.repo.HideAllNotifications(email)
 }
 
 func (s *Service) Count(ctx context.Context, email string) (int64, error) {
@@ -52,10 +112,151 @@ func (s *Service) Count(ctx context.Context, email string) (int64, error) {
 
 func (s *Service) ToggleStarNotification(ctx context.Context, typeName, email string, uid uuid.UUID, star bool) error {
 	defer Span(NewSpan(ctx, "ToggleStarNotification"))()
-	return s.repo.ToggleStarNotification(email, typeName+":"+uid.String(), star)
+	err := s.repo.ToggleStarNotification(email, typeName+":"+uid.String(), star)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.SetNotificationStarred(email, typeName, uid, star)
 }
 
 func (s *Service) HideNotification(ctx context.Context, typeName, email string, uid uuid.UUID) error {
 	defer Span(NewSpan(ctx, "HideNotification"))()
-	return s.repo.HideNotification(email, typeName+":"+uid.String())
+	err := s.repo.HideNotification(email, typeName+":"+uid.String())
+	if err != nil {
+		return err
+	}
+
+	return s.repo.MarkNotificationHidden(email, typeName, uid)
+}
+
+// SearchNotifications - история уведомлений из Postgres с фильтрами и пагинацией.
+func (s *Service) SearchNotifications(ctx context.Context, filter dto.NotificationSearchDTO) (dtos []dto.NotificationHistoryDTO, total int64, err error) {
+	defer Span(NewSpan(ctx, "SearchNotifications"))()
+
+	orms, total, err := s.repo.SearchNotifications(filter)
+	if err != nil {
+		return dtos, total, err
+	}
+
+	dtos = make([]dto.NotificationHistoryDTO, 0, len(orms))
+	for _, orm := range orms {
+		events := []string{}
+		state := aggregates.StateDiff{}
+
+		if err := json.Unmarshal(orm.Events, &events); err != nil {
+			logrus.Warn("notification events: ", err)
+		}
+
+		if err := json.Unmarshal(orm.State, &state); err != nil {
+			logrus.Warn("notification state: ", err)
+		}
+
+		dtos = append(dtos, dto.NotificationHistoryDTO{
+			UUID:        orm.UUID,
+			Type:        orm.Type,
+			EntityUUID:  orm.EntityUUID,
+			ProjectUUID: orm.ProjectUUID,
+			TaskID:      orm.TaskID,
+			Name:        orm.TaskName,
+
+			Events: events,
+			Count:  StateCount(state),
+
+			Starred:  orm.Starred,
+			Read:     orm.ReadAt != nil,
+			Hidden:   orm.HiddenAt != nil,
+			ReadAt:   orm.ReadAt,
+			HiddenAt: orm.HiddenAt,
+
+			CreatedAt: orm.CreatedAt,
+			UpdatedAt: orm.UpdatedAt,
+		})
+	}
+
+	return dtos, total, nil
+}
+
+// MarkNotificationUnread - возвращает уведомление в непрочитанные, в том числе в redis.
+func (s *Service) MarkNotificationUnread(ctx context.Context, typeName, email string, uid uuid.UUID) error {
+	defer Span(NewSpan(ctx, "MarkNotificationUnread"))()
+
+	orm, err := s.repo.GetNotificationByEntity(email, typeName, uid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.NotFoundErr("уведомление не найдено")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.MarkNotificationRead(email, typeName, uid, false)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.RestoreNotification(ctx, orm)
+}
+
+// RebuildCache - восстанавливает непрочитанные уведомления в redis из Postgres.
+// Без force выполняется, только если redis был очищен.
+func (s *Service) RebuildCache(ctx context.Context, force bool) error {
+	if !force {
+		built, err := s.repo.IsCacheBuilt(ctx)
+		if err != nil || built {
+			return err
+		}
+	}
+
+	orms, err := s.repo.UnreadNotifications(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, orm := range orms {
+		err = s.repo.RestoreNotification(ctx, orm)
+		if err != nil {
+			return err
+		}
+	}
+
+	logrus.WithField("count", len(orms)).Info("notifications cache rebuilt")
+
+	return s.repo.MarkCacheBuilt(ctx)
+}
+
+// StateCount - счетчики непрочитанного по задаче.
+func StateCount(state aggregates.StateDiff) map[string]interface{} {
+	return map[string]interface{}{
+		"comment":          len(state.NewComments),
+		"upload":           len(state.NewUploads),
+		"mensions":         state.NewMentions,
+		"comment_like":     state.NewLikes,
+		"comment_reaction": state.NewReactions,
+		"reminders":        len(state.NewReminders),
+	}
+}
+
+// RebuildUserCache - пересобирает список непрочитанных пользователя в redis из Postgres.
+func (s *Service) RebuildUserCache(ctx context.Context, email string) error {
+	defer Span(NewSpan(ctx, "RebuildUserCache"))()
+
+	orms, err := s.repo.UnreadNotifications(&email)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RemoveNotifications(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	for _, orm := range orms {
+		err = s.repo.RestoreNotification(ctx, orm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type Notification struct {
+	UUID        uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	UserEmail   string     `gorm:"type:varchar(100);not null;uniqueIndex:notifications_user_entity_idx"`
+	Type        string     `gorm:"type:varchar(20);not null;uniqueIndex:notifications_user_entity_idx"`
+	EntityUUID  uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:notifications_user_entity_idx"`
+	ProjectUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	Events datatypes.JSON `gorm:"type:jsonb;default:'[]';not null;"`
+	State  datatypes.JSON `gorm:"type:jsonb;default:'{}';not null;"`
+
+	Starred  bool       `gorm:"type:bool;default:false;not null"`
+	ReadAt   *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	HiddenAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type NotificationDelivery struct {
+	UUID        uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	UserEmail   string     `gorm:"type:varchar(100);not null"`
+	Channel     string     `gorm:"type:varchar(20);not null"`
+	Type        string     `gorm:"type:varchar(20);not null"`
+	EntityUUID  uuid.UUID  `gorm:"type:uuid;not null"`
+	ProjectUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+	CompanyUUID *uuid.UUID `gorm:"type:uuid;default:NULL;"`
+
+	Events  datatypes.JSON `gorm:"type:jsonb;default:'[]';not null;"`
+	Subject string         `gorm:"type:text;default:'';not null"`
+	URL     string         `gorm:"type:text;default:'';not null"`
+	Urgent  bool           `gorm:"type:bool;default:false;not null"`
+
+	Status   string `gorm:"type:varchar(20);default:'pending';not null"`
+	Attempts int    `gorm:"type:int;default:0;not null"`
+	Error    string `gorm:"type:text;default:'';not null"`
+
+	DeliverAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	SentAt    *time.Time `gorm:"type:timestamptz;default:NULL;"`
+	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+}
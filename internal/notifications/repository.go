@@ -13,18 +13,21 @@ import (
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/aggregates"
 	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/krisch/crm-backend/pkg/redis"
 	v9 "github.com/redis/go-redis/v9"
 	"github.com/samber/lo"
 )
 
 type Repository struct {
-	rds *redis.RDS
+	gorm *postgres.GDB
+	rds  *redis.RDS
 }
 
-func NewRepository(rds *redis.RDS) *Repository {
+func NewRepository(gdb *postgres.GDB, rds *redis.RDS) *Repository {
 	return &Repository{
-		rds: rds,
+		gorm: gdb,
+		rds:  rds,
 	}
 }
 
@@ -68,6 +71,23 @@ func (r *Repository) StoreTaskState(email, kindWithUUID string, state aggregates
 	return nil
 }
 
+// StoreTaskSnapshot - статус и назначение пользователя на момент последней проверки, чтобы определить что изменилось.
+func (r *Repository) StoreTaskSnapshot(email, kindWithUUID string, status int, assigned bool) error {
+	key := fmt.Sprintf("notifications:%s:%s", email, kindWithUUID)
+
+	err := r.rds.HSET(context.Background(), key, "status", status)
+	if err != nil {
+		return err
+	}
+
+	err = r.rds.HSET(context.Background(), key, "assigned", helpers.If(assigned, "1", "0"))
+	if err != nil {
+		return err
+	}
+
+	return r.rds.HSET(context.Background(), key, "checked_at", time.Now().UnixMicro())
+}
+
 func (r *Repository) ToggleStarNotification(email, kindWithUUID string, star bool) error {
 	key := fmt.Sprintf("notifications:%s:%s", email, kindWithUUID)
 
@@ -218,3 +238,32 @@ func (r *Repository) Count(email string) (v int64, err error) {
 
 	return v, err
 }
+
+// LockDigest - дайджест за период отправляется один раз, даже если запущено несколько инстансов.
+func (r *Repository) LockDigest(email, periodKey string) (bool, error) {
+	key := fmt.Sprintf("notifications:%s:digest:%s", email, periodKey)
+
+	return r.rds.SetNX(context.Background(), key, "1", 8*24*60*60)
+}
+
+func (r *Repository) UnlockDigest(email, periodKey string) error {
+	return r.rds.Del(context.Background(), fmt.Sprintf("notifications:%s:digest:%s", email, periodKey))
+}
+
+func (r *Repository) GetDigestTime(email string) (t time.Time, found bool, err error) {
+	v, err := r.rds.GetStr(context.Background(), fmt.Sprintf("notifications:%s:digest_at", email))
+	if err != nil || v == "" {
+		return t, false, err
+	}
+
+	at, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return t, false, err
+	}
+
+	return time.UnixMicro(at), true, nil
+}
+
+func (r *Repository) StoreDigestTime(email string, t time.Time) error {
+	return r.rds.SetStr(context.Background(), fmt.Sprintf("notifications:%s:digest_at", email), strconv.FormatInt(t.UnixMicro(), 10), 0)
+}
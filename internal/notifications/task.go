@@ -3,10 +3,14 @@ package notifications
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/aggregates"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -17,6 +21,11 @@ func (s *Service) CreateTaskState(uid uuid.UUID, people []string) error {
 		return err
 	}
 
+	settings, settingsErr := s.UsersNotificationSettings(people)
+	if settingsErr != nil {
+		logrus.Error("notification settings error: ", settingsErr)
+	}
+
 	for _, p := range people {
 		user, ok := s.dict.FindUser(p)
 		if !ok {
@@ -29,6 +38,11 @@ func (s *Service) CreateTaskState(uid uuid.UUID, people []string) error {
 			if err != nil {
 				logrus.Error("RemoveNotification error: ", err)
 			}
+
+			err = s.repo.MarkNotificationHidden(p, "task", uid)
+			if err != nil {
+				logrus.Error("MarkNotificationHidden error: ", err)
+			}
 			continue
 		}
 
@@ -42,17 +56,112 @@ func (s *Service) CreateTaskState(uid uuid.UUID, people []string) error {
 			t = time.Unix(0, 0)
 		}
 
+		prev, err := s.repo.GetTaskStateNotification(p, "task:"+uid.String())
+		if err != nil {
+			logrus.Error("GetTaskStateNotification error: ", err)
+			continue
+		}
+
+		assigned := isAssigned(task, user.UUID)
+		events := taskEvents(task, user.UUID, lastChecked(prev, t), prev)
+
+		err = s.repo.StoreTaskSnapshot(p, "task:"+uid.String(), task.Status.Code, assigned)
+		if err != nil {
+			logrus.Error("StoreTaskSnapshot error: ", err)
+		}
+
+		if s.onTaskEvents != nil {
+			err = s.onTaskEvents(p, task, events)
+			if err != nil {
+				logrus.Error("onTaskEvents error: ", err)
+			}
+		}
+
+		if settingsErr == nil && !allowed(settings, p, task.Project.UUID, domain.ChannelApp, events...) {
+			continue
+		}
+
 		diffState := aggregates.CompareState(task, user.UUID, t)
 
 		err = s.repo.StoreTaskState(p, "task:"+uid.String(), diffState)
 		if err != nil {
 			logrus.Error("StoreTaskState error: ", err)
 		}
+
+		err = s.repo.UpsertNotification(p, "task", uid, &task.Project.UUID, events, diffState)
+		if err != nil {
+			logrus.Error("UpsertNotification error: ", err)
+		}
 	}
 
 	return nil
 }
 
+func isAssigned(task dto.TaskDTO, userUUID uuid.UUID) bool {
+	for _, u := range []*dto.UserDTO{task.ResponsibleBy, task.ImplementBy, task.ManagedBy} {
+		if u != nil && u.UUID == userUUID {
+			return true
+		}
+	}
+
+	return lo.ContainsBy(task.CoWorkersBy, func(u dto.UserDTO) bool {
+		return u.UUID == userUUID
+	})
+}
+
+// lastChecked - время предыдущей проверки задачи для пользователя, но не раньше последнего открытия.
+func lastChecked(prev map[string]string, lastOpen time.Time) time.Time {
+	checkedAt, err := strconv.ParseInt(prev["checked_at"], 10, 64)
+	if err != nil || time.UnixMicro(checkedAt).Before(lastOpen) {
+		return lastOpen
+	}
+
+	return time.UnixMicro(checkedAt)
+}
+
+// taskEvents - события в задаче для пользователя после since. prev - снимок предыдущей проверки.
+func taskEvents(task dto.TaskDTO, userUUID uuid.UUID, since time.Time, prev map[string]string) (events []string) {
+	if isAssigned(task, userUUID) && prev["assigned"] != "1" {
+		events = append(events, domain.NotifyAssigned)
+	}
+
+	if prev["status"] != "" && prev["status"] != strconv.Itoa(task.Status.Code) {
+		events = append(events, domain.NotifyStatus)
+	}
+
+	for _, c := range task.Comments {
+		if !c.UpdatedAt.After(since) {
+			continue
+		}
+
+		if _, ok := c.InPeople(userUUID); ok {
+			events = append(events, domain.NotifyMentioned)
+		}
+
+		if c.CreatedAt.After(since) && (c.CreatedBy == nil || c.CreatedBy.UUID != userUUID) {
+			events = append(events, domain.NotifyComment)
+		}
+	}
+
+	if lo.SomeBy(task.Files, func(f dto.FileDTOs) bool {
+		return f.CreatedAt.After(since) && f.CreatedBy.UUID != userUUID
+	}) {
+		events = append(events, domain.NotifyFile)
+	}
+
+	if lo.SomeBy(task.Reminders, func(r dto.ReminderDTO) bool {
+		return r.UpdatedAt.After(since)
+	}) {
+		events = append(events, domain.NotifyReminder)
+	}
+
+	if len(events) == 0 {
+		events = append(events, domain.NotifyUpdated)
+	}
+
+	return lo.Uniq(events)
+}
+
 func (s *Service) GetTaskState(email string, uid uuid.UUID) (state aggregates.StateDiff, star bool, err error) {
 	js, err := s.repo.GetTaskStateNotification(email, "task:"+uid.String())
 	if err != nil {
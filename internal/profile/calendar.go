@@ -0,0 +1,41 @@
+package profile
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+// CalendarToken - секрет ссылки на календарь пользователя, создается при первом запросе.
+func (s *Service) CalendarToken(uid uuid.UUID) (string, error) {
+	token, err := s.repo.GetCalendarToken(uid)
+	if err != nil {
+		return "", err
+	}
+
+	if token != nil {
+		return *token, nil
+	}
+
+	return s.ResetCalendarToken(uid)
+}
+
+// ResetCalendarToken - новый секрет, старые ссылки на календарь перестают работать.
+func (s *Service) ResetCalendarToken(uid uuid.UUID) (string, error) {
+	b := make([]byte, 24)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	token := hex.EncodeToString(b)
+
+	return token, s.repo.ChangeField(uid, "calendar_token", token)
+}
+
+func (s *Service) UserByCalendarToken(token string) (domain.User, error) {
+	return s.repo.GetUserByCalendarToken(token)
+}
@@ -450,6 +450,10 @@ func (s *Service) ChangePreferences(uid uuid.UUID, prefs domain.ProfilePreferenc
 		}
 	}
 
+	if prefs.Language != nil && !helpers.InArray(*prefs.Language, domain.Languages) {
+		return errors.New("неизвестный язык")
+	}
+
 	err = s.repo.gorm.DB.
 		Exec("UPDATE users SET updated_at = NOW(), preferences = preferences || ? WHERE uuid = ?", j, uid).
 		Error
@@ -0,0 +1,139 @@
+package profile
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
+
+var ErrInvalidUnsubscribeToken = errors.New("некорректная ссылка отписки")
+
+func (s *Service) NotificationSettings(uid uuid.UUID) (settings domain.NotificationSettings, err error) {
+	user, err := s.repo.GetUser(uid, "uuid", "preferences")
+	if err != nil {
+		return settings, err
+	}
+
+	if user.Preferences.Notifications != nil {
+		settings = *user.Preferences.Notifications
+	}
+
+	return settings, nil
+}
+
+func (s *Service) ChangeNotificationSettings(uid uuid.UUID, events domain.NotificationMatrix, digest *string, quiet *domain.QuietHours) error {
+	err := events.Validate()
+	if err != nil {
+		return err
+	}
+
+	if quiet != nil {
+		err = quiet.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if digest != nil {
+		err = domain.ValidateDigestPeriod(*digest)
+		if err != nil {
+			return err
+		}
+	}
+
+	settings, err := s.NotificationSettings(uid)
+	if err != nil {
+		return err
+	}
+
+	settings.Events = events
+	if digest != nil {
+		settings.Digest = *digest
+	}
+
+	if quiet != nil {
+		settings.QuietHours = quiet
+	}
+
+	return s.ChangePreferences(uid, domain.ProfilePreferences{
+		Notifications: &settings,
+	})
+}
+
+func (s *Service) ChangeProjectNotificationSettings(uid, projectUUID uuid.UUID, project domain.ProjectNotificationSettings) error {
+	err := project.Events.Validate()
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.NotificationSettings(uid)
+	if err != nil {
+		return err
+	}
+
+	if settings.Projects == nil {
+		settings.Projects = make(map[uuid.UUID]domain.ProjectNotificationSettings)
+	}
+
+	settings.Projects[projectUUID] = project
+
+	return s.ChangePreferences(uid, domain.ProfilePreferences{
+		Notifications: &settings,
+	})
+}
+
+func (s *Service) DeleteProjectNotificationSettings(uid, projectUUID uuid.UUID) error {
+	settings, err := s.NotificationSettings(uid)
+	if err != nil {
+		return err
+	}
+
+	delete(settings.Projects, projectUUID)
+
+	return s.ChangePreferences(uid, domain.ProfilePreferences{
+		Notifications: &settings,
+	})
+}
+
+// UsersPreferences - настройки пользователей одним запросом, для рассылок.
+func (s *Service) UsersPreferences(uids []uuid.UUID) (map[uuid.UUID]domain.ProfilePreferences, error) {
+	if len(uids) == 0 {
+		return map[uuid.UUID]domain.ProfilePreferences{}, nil
+	}
+
+	return s.repo.GetUsersPreferences(uids)
+}
+
+// DigestUnsubscribeToken - подпись email для ссылки отписки от дайджеста, не требует авторизации и хранения.
+func (s *Service) DigestUnsubscribeToken(email string) string {
+	mac := hmac.New(sha256.New, []byte(s.conf.SOLT))
+	mac.Write([]byte("digest:" + email))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) UnsubscribeDigest(email, token string) error {
+	if !hmac.Equal([]byte(token), []byte(s.DigestUnsubscribeToken(email))) {
+		return ErrInvalidUnsubscribeToken
+	}
+
+	user, ok := s.dict.FindUser(email)
+	if !ok {
+		return ErrInvalidUnsubscribeToken
+	}
+
+	settings, err := s.NotificationSettings(user.UUID)
+	if err != nil {
+		return err
+	}
+
+	settings.Digest = domain.DigestOff
+
+	return s.ChangePreferences(user.UUID, domain.ProfilePreferences{
+		Notifications: &settings,
+	})
+}
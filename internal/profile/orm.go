@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"gorm.io/datatypes"
 )
@@ -25,6 +26,8 @@ type User struct {
 	Color    string    `gorm:"type:varchar(7);default:'#000000';not null"`
 	HasPhoto bool      `gorm:"type:boolean;default:false;not null"`
 
+	CalendarToken *string `gorm:"type:varchar(64);default:NULL;unique"`
+
 	CreatedAt        time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt        time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt        *time.Time `gorm:"type:timestamptz;default:NULL;"`
@@ -37,7 +40,9 @@ type User struct {
 }
 
 type UserPreferences struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Timezone      *string                      `json:"timezone,omitempty"`
+	Language      *string                      `json:"language,omitempty"`
+	Notifications *domain.NotificationSettings `json:"notifications,omitempty"`
 }
 
 func (j *UserPreferences) Scan(value interface{}) error {
@@ -131,7 +131,9 @@ func (r *Repository) GetUser(uid uuid.UUID, fields ...string) (user domain.User,
 		Color:    orm.Color,
 
 		Preferences: domain.ProfilePreferences{
-			Timezone: orm.Preferences.Timezone,
+			Timezone:      orm.Preferences.Timezone,
+			Language:      orm.Preferences.Language,
+			Notifications: orm.Preferences.Notifications,
 		},
 
 		CreatedAt: orm.CreatedAt,
@@ -141,6 +143,32 @@ func (r *Repository) GetUser(uid uuid.UUID, fields ...string) (user domain.User,
 	return user, err
 }
 
+func (r *Repository) GetUsersPreferences(uids []uuid.UUID) (prefs map[uuid.UUID]domain.ProfilePreferences, err error) {
+	orms := []User{}
+
+	err = r.gorm.DB.Model(User{}).
+		Where("uuid in ?", uids).
+		Where("deleted_at is null").
+		Select("uuid", "preferences").
+		Find(&orms).
+		Error
+
+	if err != nil {
+		return prefs, err
+	}
+
+	prefs = make(map[uuid.UUID]domain.ProfilePreferences, len(orms))
+	for _, orm := range orms {
+		prefs[orm.UUID] = domain.ProfilePreferences{
+			Timezone:      orm.Preferences.Timezone,
+			Language:      orm.Preferences.Language,
+			Notifications: orm.Preferences.Notifications,
+		}
+	}
+
+	return prefs, nil
+}
+
 func (r *Repository) GetUserByEmail(email string, fields ...string) (user domain.User, err error) {
 	if len(fields) == 0 {
 		fields = []string{"uuid"}
@@ -685,3 +713,30 @@ func (r *Repository) DeleteSurvey(uid uuid.UUID) (err error) {
 
 	return res.Error
 }
+
+func (r *Repository) GetCalendarToken(uid uuid.UUID) (token *string, err error) {
+	orm := User{}
+
+	err = r.gorm.DB.Model(User{}).
+		Where("uuid = ?", uid).
+		Select("uuid", "calendar_token").
+		Take(&orm).
+		Error
+
+	return orm.CalendarToken, err
+}
+
+func (r *Repository) GetUserByCalendarToken(token string) (user domain.User, err error) {
+	err = r.gorm.DB.Model(User{}).
+		Where("calendar_token = ?", token).
+		Where("deleted_at is null").
+		Select("uuid", "email", "name").
+		Take(&user).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, dto.NotFoundErr("календарь не найден")
+	}
+
+	return user, err
+}
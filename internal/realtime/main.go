@@ -0,0 +1,259 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/pkg/redis"
+	"github.com/sirupsen/logrus"
+)
+
+// channel - redis pub/sub канал, через который события расходятся по всем инстансам.
+const channel = "realtime"
+
+const (
+	clientBuffer    = 64
+	clientMaxTopics = 100
+)
+
+// Типы событий.
+const (
+	EventTaskUpdated     = "task.updated"
+	EventTaskStatus      = "task.status"
+	EventCommentCreated  = "comment.created"
+	EventReminderUpdated = "reminder.updated"
+	EventNotifications   = "notifications.count"
+)
+
+var (
+	ErrInvalidTopic   = errors.New("некорректная подписка")
+	ErrTooManyTopics  = errors.New("слишком много подписок")
+	ErrClientNotFound = errors.New("клиент не подключен")
+)
+
+type Event struct {
+	Type   string          `json:"type"`
+	Topics []string        `json:"topics"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	At     time.Time       `json:"at"`
+}
+
+func NewEvent(kind string, data interface{}, topics ...string) (Event, error) {
+	js, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:   kind,
+		Topics: topics,
+		Data:   js,
+		At:     time.Now(),
+	}, nil
+}
+
+func TaskTopic(uid uuid.UUID) string {
+	return "task:" + uid.String()
+}
+
+func ProjectTopic(uid uuid.UUID) string {
+	return "project:" + uid.String()
+}
+
+func UserTopic(email string) string {
+	return "user:" + email
+}
+
+// ParseTopic - тип подписки (task, project, user) и ее идентификатор.
+func ParseTopic(topic string) (kind, id string, err error) {
+	kind, id, ok := strings.Cut(topic, ":")
+	if !ok || id == "" {
+		return kind, id, ErrInvalidTopic
+	}
+
+	switch kind {
+	case "task", "project":
+		if _, err := uuid.Parse(id); err != nil {
+			return kind, id, ErrInvalidTopic
+		}
+	case "user":
+	default:
+		return kind, id, ErrInvalidTopic
+	}
+
+	return kind, id, nil
+}
+
+// Client - подключение пользователя (websocket или sse). Всегда подписан на свой inbox.
+type Client struct {
+	Email  string
+	Events chan Event
+
+	topics map[string]bool
+}
+
+type Service struct {
+	rds *redis.RDS
+
+	lock    sync.RWMutex
+	clients map[*Client]bool
+}
+
+func New(rds *redis.RDS) *Service {
+	return &Service{
+		rds:     rds,
+		clients: make(map[*Client]bool),
+	}
+}
+
+func (s *Service) Connect(email string) *Client {
+	c := &Client{
+		Email:  email,
+		Events: make(chan Event, clientBuffer),
+		topics: map[string]bool{UserTopic(email): true},
+	}
+
+	s.lock.Lock()
+	s.clients[c] = true
+	s.lock.Unlock()
+
+	return c
+}
+
+func (s *Service) Disconnect(c *Client) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.clients[c] {
+		delete(s.clients, c)
+		close(c.Events)
+	}
+}
+
+func (s *Service) Subscribe(c *Client, topic string) error {
+	if _, _, err := ParseTopic(topic); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.clients[c] {
+		return ErrClientNotFound
+	}
+
+	if len(c.topics) >= clientMaxTopics && !c.topics[topic] {
+		return ErrTooManyTopics
+	}
+
+	c.topics[topic] = true
+
+	return nil
+}
+
+func (s *Service) Unsubscribe(c *Client, topic string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// от своего inbox отписаться нельзя
+	if topic != UserTopic(c.Email) {
+		delete(c.topics, topic)
+	}
+}
+
+// Send - событие только этому клиенту, без redis.
+func (s *Service) Send(c *Client, e Event) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.clients[c] {
+		s.send(c, e)
+	}
+}
+
+// Publish - событие всем подписчикам на всех инстансах.
+func (s *Service) Publish(ctx context.Context, e Event) error {
+	if len(e.Topics) == 0 {
+		return nil
+	}
+
+	js, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return s.rds.Publish(ctx, channel, string(js))
+}
+
+func (s *Service) Clients() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.clients)
+}
+
+// Listen - получает события из redis и раздает локальным подключениям.
+func (s *Service) Listen(ctx context.Context) {
+	pubsub := s.rds.Subscribe(ctx, channel)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logrus.Errorf("exception: %s", string(debug.Stack()))
+				time.Sleep(time.Second * 5)
+				s.Listen(ctx)
+			}
+		}()
+
+		for {
+			msg, err := pubsub.ReceiveMessage(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				logrus.Error(err)
+				time.Sleep(time.Second)
+
+				continue
+			}
+
+			e := Event{}
+			err = json.Unmarshal([]byte(msg.Payload), &e)
+			if err != nil {
+				logrus.Error("realtime: ", err)
+				continue
+			}
+
+			s.dispatch(e)
+		}
+	}()
+}
+
+func (s *Service) dispatch(e Event) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for c := range s.clients {
+		for _, topic := range e.Topics {
+			if c.topics[topic] {
+				s.send(c, e)
+				break
+			}
+		}
+	}
+}
+
+// send - медленный клиент не должен блокировать остальных, событие для него теряется.
+func (s *Service) send(c *Client, e Event) {
+	select {
+	case c.Events <- e:
+	default:
+		logrus.WithField("email", c.Email).Warn("realtime: client buffer is full, event dropped")
+	}
+}
@@ -0,0 +1,79 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseTopic(t *testing.T) {
+	uid := uuid.New()
+
+	tests := []struct {
+		topic   string
+		kind    string
+		wantErr bool
+	}{
+		{topic: TaskTopic(uid), kind: "task"},
+		{topic: ProjectTopic(uid), kind: "project"},
+		{topic: UserTopic("a@mail.ru"), kind: "user"},
+		{topic: "task:123", wantErr: true},
+		{topic: "company:" + uid.String(), wantErr: true},
+		{topic: "user:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		kind, _, err := ParseTopic(tt.topic)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTopic(%q) error = %v, wantErr %v", tt.topic, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr && kind != tt.kind {
+			t.Errorf("ParseTopic(%q) = %q, want %q", tt.topic, kind, tt.kind)
+		}
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	s := New(nil)
+	taskUUID := uuid.New()
+
+	a := s.Connect("a@mail.ru")
+	b := s.Connect("b@mail.ru")
+
+	if err := s.Subscribe(b, TaskTopic(taskUUID)); err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := NewEvent(EventCommentCreated, map[string]string{"task_uuid": taskUUID.String()}, TaskTopic(taskUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.dispatch(e)
+
+	if len(a.Events) != 0 {
+		t.Error("client without subscription should not receive event")
+	}
+
+	if len(b.Events) != 1 {
+		t.Error("subscribed client should receive event")
+	}
+
+	s.Unsubscribe(a, UserTopic("a@mail.ru"))
+
+	e, _ = NewEvent(EventNotifications, map[string]int{"count": 1}, UserTopic("a@mail.ru"))
+	s.dispatch(e)
+
+	if len(a.Events) != 1 {
+		t.Error("client should always receive own inbox events")
+	}
+
+	s.Disconnect(a)
+	s.Disconnect(b)
+
+	if s.Clients() != 0 {
+		t.Errorf("Clients() = %d, want 0", s.Clients())
+	}
+}
@@ -0,0 +1,258 @@
+package reminders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	// Напоминания, пропущенные дольше этого (например, сервис был остановлен), не отправляются.
+	dispatchMaxDelay = 24 * time.Hour
+
+	// Взятое в отправку срабатывание, не завершенное за это время (упал инстанс), отправляется повторно.
+	dispatchSendingTimeout = 10 * time.Minute
+)
+
+// CreateDueDispatches - создает срабатывания для наступивших напоминаний. Идемпотентно, можно вызывать с нескольких инстансов.
+func (r *Repository) CreateDueDispatches(now time.Time) error {
+	return r.gorm.DB.Exec(`
+		INSERT INTO reminder_dispatches (reminder_uuid, fire_at, next_attempt_at)
+		SELECT uuid, coalesce(snoozed_until, date_from, date_to), coalesce(snoozed_until, date_from, date_to)
+		FROM reminders
+		WHERE deleted_at IS NULL
+			AND status <> ?
+			AND coalesce(snoozed_until, date_from, date_to) <= ?
+			AND coalesce(snoozed_until, date_from, date_to) > ?
+		ON CONFLICT (reminder_uuid, fire_at) DO NOTHING`,
+		domain.ReminderStatusSent, now, now.Add(-dispatchMaxDelay),
+	).Error
+}
+
+// ClaimDispatches - зависшее в отправке срабатывание берется повторно, пока не исчерпаны попытки,
+// затем попадает в список недоставленных.
+func (r *Repository) ClaimDispatches(now time.Time, limit int) (orms []ReminderDispatch, err error) {
+	err = r.gorm.DB.Exec(`
+		UPDATE reminder_dispatches
+		SET status = ?, error = ?, updated_at = now()
+		WHERE status = ? AND updated_at < ? AND attempts >= ?`,
+		domain.DispatchDead, "отправка прервана, попытки исчерпаны",
+		domain.DispatchSending, now.Add(-dispatchSendingTimeout), domain.DispatchMaxAttempts,
+	).Error
+	if err != nil {
+		return orms, err
+	}
+
+	err = r.gorm.DB.Raw(`
+		UPDATE reminder_dispatches
+		SET status = ?, attempts = attempts + 1, updated_at = now()
+		WHERE uuid IN (
+			SELECT uuid FROM reminder_dispatches
+			WHERE (status = ? AND next_attempt_at <= ?) OR (status = ? AND updated_at < ? AND attempts < ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.DispatchSending,
+		domain.DispatchPending, now,
+		domain.DispatchSending, now.Add(-dispatchSendingTimeout), domain.DispatchMaxAttempts,
+		limit,
+	).Scan(&orms).Error
+
+	return orms, err
+}
+
+// MarkDispatchSent - в одной транзакции обновляет дело, ставит в очередь доставки enqueue и помечает срабатывание отправленным.
+// При ошибке срабатывание повторяется целиком, без дублей в очереди.
+func (r *Repository) MarkDispatchSent(reminderUUID uuid.UUID, fields map[string]interface{}, dispatchUUID uuid.UUID, enqueue func(tx *gorm.DB) error) error {
+	fields["updated_at"] = gorm.Expr("now()")
+
+	return r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.
+			Model(&Reminder{}).
+			Where("uuid = ?", reminderUUID).
+			Where("deleted_at is null").
+			Updates(fields)
+
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return dto.NotFoundErr("нельзя обновлять удаленное дело")
+		}
+
+		err := enqueue(tx)
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&ReminderDispatch{}).
+			Where("uuid = ?", dispatchUUID).
+			Updates(map[string]interface{}{
+				"status":     domain.DispatchSent,
+				"error":      "",
+				"sent_at":    gorm.Expr("now()"),
+				"updated_at": gorm.Expr("now()"),
+			}).
+			Error
+	})
+}
+
+func (r *Repository) MarkDispatchFailed(uid uuid.UUID, status, reason string, nextAttemptAt time.Time) error {
+	return r.gorm.DB.
+		Model(&ReminderDispatch{}).
+		Where("uuid = ?", uid).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"error":           reason,
+			"next_attempt_at": nextAttemptAt,
+			"updated_at":      gorm.Expr("now()"),
+		}).
+		Error
+}
+
+// GetDeadDispatches - срабатывания с исчерпанными попытками по делам, где пользователь автор или исполнитель.
+func (r *Repository) GetDeadDispatches(userUUID uuid.UUID) (orms []ReminderDispatch, err error) {
+	err = r.gorm.DB.
+		Model(&ReminderDispatch{}).
+		Joins("join reminders on reminders.uuid = reminder_dispatches.reminder_uuid").
+		Where("reminder_dispatches.status = ?", domain.DispatchDead).
+		Where("(reminders.created_by_uuid = ? or reminders.user_uuid = ?)", userUUID.String(), userUUID).
+		Order("reminder_dispatches.updated_at desc").
+		Find(&orms).
+		Error
+
+	return orms, err
+}
+
+func (r *Repository) GetDispatch(uid uuid.UUID) (orm ReminderDispatch, err error) {
+	err = r.gorm.DB.
+		Where("uuid = ?", uid).
+		Take(&orm).
+		Error
+
+	return orm, err
+}
+
+// RetryDispatch - возвращает срабатывание из списка недоставленных в очередь.
+func (r *Repository) RetryDispatch(uid uuid.UUID) error {
+	res := r.gorm.DB.
+		Model(&ReminderDispatch{}).
+		Where("uuid = ? and status = ?", uid, domain.DispatchDead).
+		Updates(map[string]interface{}{
+			"status":          domain.DispatchPending,
+			"attempts":        0,
+			"next_attempt_at": gorm.Expr("now()"),
+			"updated_at":      gorm.Expr("now()"),
+		})
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("недоставленное напоминание не найдено")
+	}
+
+	return nil
+}
+
+func dispatchToDomain(orm ReminderDispatch) domain.ReminderDispatch {
+	return domain.ReminderDispatch{
+		UUID:          orm.UUID,
+		ReminderUUID:  orm.ReminderUUID,
+		FireAt:        orm.FireAt,
+		Status:        orm.Status,
+		Attempts:      orm.Attempts,
+		Error:         orm.Error,
+		NextAttemptAt: orm.NextAttemptAt,
+		SentAt:        orm.SentAt,
+		CreatedAt:     orm.CreatedAt,
+		UpdatedAt:     orm.UpdatedAt,
+	}
+}
+
+// ClaimDueDispatches - берет в отправку наступившие напоминания. Каждое срабатывание достается одному инстансу.
+func (s *Service) ClaimDueDispatches(now time.Time, limit int) ([]domain.ReminderDispatch, error) {
+	err := s.repo.CreateDueDispatches(now)
+	if err != nil {
+		return nil, err
+	}
+
+	orms, err := s.repo.ClaimDispatches(now, limit)
+
+	return lo.Map(orms, func(orm ReminderDispatch, _ int) domain.ReminderDispatch {
+		return dispatchToDomain(orm)
+	}), err
+}
+
+// MarkSent - помечает напоминание отправленным, повторяющееся переносит на следующее повторение
+// в часовом поясе получателя. enqueue ставит доставки по почте и sms в той же транзакции.
+// Изменение дела показывает напоминание в уведомлениях участников.
+func (s *Service) MarkSent(d domain.ReminderDispatch, r domain.Reminder, loc *time.Location, now time.Time, enqueue func(tx *gorm.DB) error) error {
+	fields := map[string]interface{}{
+		"snoozed_until": nil,
+		"status":        domain.ReminderStatusSent,
+	}
+
+	// день месяца запоминается до первого переноса даты, иначе после короткого месяца он потеряется
+	r.AnchorMonthDay(loc)
+
+	if from, to, ok := r.NextOccurrence(now, loc); ok {
+		fields["date_from"] = from
+		fields["date_to"] = to
+		fields["recurrence"] = (*Recurrence)(r.Recurrence)
+		fields["status"] = 0
+	}
+
+	err := s.repo.MarkDispatchSent(r.UUID, fields, d.UUID, enqueue)
+	if err != nil {
+		return err
+	}
+
+	people, err := s.GetPeople(r)
+	if err != nil {
+		return err
+	}
+
+	return s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, people)
+}
+
+// MarkDispatchFailed - повтор с паузой, после DispatchMaxAttempts попыток срабатывание попадает в список недоставленных.
+func (s *Service) MarkDispatchFailed(d domain.ReminderDispatch, reason error, now time.Time) error {
+	if d.Attempts >= domain.DispatchMaxAttempts {
+		return s.repo.MarkDispatchFailed(d.UUID, domain.DispatchDead, reason.Error(), d.NextAttemptAt)
+	}
+
+	return s.repo.MarkDispatchFailed(d.UUID, domain.DispatchPending, reason.Error(), d.RetryAt(now))
+}
+
+// CancelDispatch - дело удалено или перенесено, отправлять и повторять нечего.
+func (s *Service) CancelDispatch(d domain.ReminderDispatch, reason string) error {
+	return s.repo.MarkDispatchFailed(d.UUID, domain.DispatchCancelled, reason, d.NextAttemptAt)
+}
+
+func (s *Service) DeadDispatches(userUUID uuid.UUID) ([]domain.ReminderDispatch, error) {
+	orms, err := s.repo.GetDeadDispatches(userUUID)
+
+	return lo.Map(orms, func(orm ReminderDispatch, _ int) domain.ReminderDispatch {
+		return dispatchToDomain(orm)
+	}), err
+}
+
+func (s *Service) GetDispatch(uid uuid.UUID) (domain.ReminderDispatch, error) {
+	orm, err := s.repo.GetDispatch(uid)
+
+	return dispatchToDomain(orm), err
+}
+
+func (s *Service) RetryDispatch(uid uuid.UUID) error {
+	return s.repo.RetryDispatch(uid)
+}
@@ -3,6 +3,7 @@ package reminders
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -42,7 +43,15 @@ func (s *Service) Create(r domain.Reminder) (err error) {
 		return fmt.Errorf("даты должны быть в один день")
 	}
 
+	err = r.Validate()
+	if err != nil {
+		return err
+	}
+
 	err = s.repo.Create(r)
+	if err == nil && r.DeadlineOffset != nil {
+		_, err = s.repo.SyncDeadline(r.TaskUUID)
+	}
 
 	if r.UserUUID != nil {
 		cuser, cu := s.dict.FindUserByUUID(*r.UserUUID)
@@ -64,7 +73,15 @@ func (s *Service) Put(userEmail string, r domain.Reminder) (err error) {
 		return fmt.Errorf("даты должны быть в один день")
 	}
 
+	err = r.Validate()
+	if err != nil {
+		return err
+	}
+
 	err = s.repo.Put(r)
+	if err == nil && r.DeadlineOffset != nil {
+		_, err = s.repo.SyncDeadline(r.TaskUUID)
+	}
 	if err == nil {
 		people, err := s.GetPeople(r)
 		people = lo.Filter(people, func(email string, _ int) bool {
@@ -86,6 +103,10 @@ func (s *Service) Put(userEmail string, r domain.Reminder) (err error) {
 }
 
 func (s *Service) PatchStatus(userEmail string, r domain.Reminder, status int) (err error) {
+	if status < 0 || status > domain.ReminderStatusMax {
+		return domain.ErrReminderStatus
+	}
+
 	err = s.repo.ChangeField(r.UUID, "status", status)
 
 	if err == nil {
@@ -108,6 +129,44 @@ func (s *Service) PatchStatus(userEmail string, r domain.Reminder, status int) (
 	return err
 }
 
+// Snooze - откладывает напоминание, в том числе уже отправленное.
+func (s *Service) Snooze(userEmail string, r domain.Reminder, until time.Time) (err error) {
+	err = s.repo.ChangeFields(r.UUID, map[string]interface{}{
+		"snoozed_until": until,
+		"status":        0,
+	})
+	if err != nil {
+		return err
+	}
+
+	people, err := s.GetPeople(r)
+	if err != nil {
+		logrus.WithError(err).Error("GetPeople error")
+		return err
+	}
+
+	err = s.ReminderWasUpdatedOrCreated(r.UUID, r.TaskUUID, lo.Without(people, userEmail))
+	if err != nil {
+		logrus.WithError(err).Error("ReminderWasUpdatedOrCreated error")
+	}
+
+	return nil
+}
+
+// SyncDeadline - вызывается при изменении задачи, переносит напоминания относительно ее срока.
+func (s *Service) SyncDeadline(taskUUID uuid.UUID) error {
+	uids, err := s.repo.SyncDeadline(taskUUID)
+	if err != nil {
+		return err
+	}
+
+	if len(uids) > 0 {
+		logrus.WithField("task", taskUUID).Debugf("reminders moved with deadline: %d", len(uids))
+	}
+
+	return nil
+}
+
 func (s *Service) DeleteByUUID(uid uuid.UUID) (err error) {
 	r, err := s.Get(uid)
 	if err != nil {
@@ -138,6 +197,10 @@ func (s *Service) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error) {
 	return dms, err
 }
 
+func (s *Service) GetByProject(projectUUID uuid.UUID, from, to time.Time) ([]domain.Reminder, error) {
+	return s.repo.GetByProject(projectUUID, from, to)
+}
+
 func (s *Service) GetByTask(uid uuid.UUID) (dms []domain.Reminder, err error) {
 	dms, err = s.repo.GetByTask(uid)
 
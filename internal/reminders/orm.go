@@ -1,9 +1,14 @@
 package reminders
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 )
 
 type Reminder struct {
@@ -19,7 +24,44 @@ type Reminder struct {
 	Type          string     `gorm:"type:varchar(50)"`
 	Status        int        `gorm:"type:integer"`
 
+	Recurrence     *Recurrence `gorm:"type:jsonb"`
+	SnoozedUntil   *time.Time  `gorm:"type:timestamptz"`
+	DeadlineOffset *int        `gorm:"type:integer"`
+
 	CreatedAt time.Time `gorm:"->;type:timestamp"`
 	UpdatedAt time.Time
 	DeletedAt *time.Time
 }
+
+type ReminderDispatch struct {
+	UUID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();not null:false;primary_key:true"`
+	ReminderUUID uuid.UUID `gorm:"type:uuid;not null"`
+	FireAt       time.Time `gorm:"type:timestamptz;not null"`
+
+	Status        string     `gorm:"type:varchar(20);default:'pending';not null"`
+	Attempts      int        `gorm:"type:int;default:0;not null"`
+	Error         string     `gorm:"type:text;default:'';not null"`
+	NextAttemptAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
+	SentAt        *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
+	CreatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+	UpdatedAt time.Time `gorm:"type:timestamptz;default:now();not null"`
+}
+
+type Recurrence domain.ReminderRecurrence
+
+func (j *Recurrence) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+	}
+
+	result := Recurrence{}
+	err := json.Unmarshal(bytes, &result)
+	*j = result
+	return err
+}
+
+func (j Recurrence) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
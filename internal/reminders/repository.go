@@ -2,12 +2,14 @@ package reminders
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/pkg/postgres"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -32,6 +34,9 @@ func (r *Repository) Create(dm domain.Reminder) (err error) {
 		Description:   dm.Description,
 		Type:          dm.Type,
 		UserUUID:      dm.UserUUID,
+
+		Recurrence:     (*Recurrence)(dm.Recurrence),
+		DeadlineOffset: dm.DeadlineOffset,
 	}
 
 	err = r.gorm.DB.Create(&orm).Error
@@ -48,6 +53,9 @@ func (r *Repository) Put(dm domain.Reminder) (err error) {
 		Comment:     dm.Comment,
 		Type:        dm.Type,
 		UserUUID:    dm.UserUUID,
+
+		Recurrence:     (*Recurrence)(dm.Recurrence),
+		DeadlineOffset: dm.DeadlineOffset,
 	}
 
 	res := r.gorm.DB.Save(&orm)
@@ -109,6 +117,10 @@ func (r *Repository) GetByUser(uid uuid.UUID) (dms []domain.Reminder, err error)
 			Type:          item.Type,
 			UserUUID:      item.UserUUID,
 			Status:        item.Status,
+
+			Recurrence:     (*domain.ReminderRecurrence)(item.Recurrence),
+			SnoozedUntil:   item.SnoozedUntil,
+			DeadlineOffset: item.DeadlineOffset,
 		}
 	})
 
@@ -161,6 +173,53 @@ func (r *Repository) GetByTask(taskUUID uuid.UUID) (dms []domain.Reminder, err e
 			Description:   item.Description,
 			Comment:       item.Comment,
 			Type:          item.Type,
+			Status:        item.Status,
+
+			Recurrence:     (*domain.ReminderRecurrence)(item.Recurrence),
+			SnoozedUntil:   item.SnoozedUntil,
+			DeadlineOffset: item.DeadlineOffset,
+		}
+	})
+
+	return dms, nil
+}
+
+// GetByProject - дела по задачам проекта, срабатывающие в интервале [from, to), и все повторяющиеся.
+func (r *Repository) GetByProject(projectUUID uuid.UUID, from, to time.Time) (dms []domain.Reminder, err error) {
+	orm := []Reminder{}
+
+	err = r.gorm.DB.
+		Select("reminders.*").
+		Joins("JOIN tasks ON tasks.uuid = reminders.task_uuid AND tasks.deleted_at IS NULL").
+		Where("tasks.project_uuid = ?", projectUUID).
+		Where("reminders.deleted_at IS NULL").
+		Where("(reminders.recurrence IS NOT NULL OR (coalesce(reminders.snoozed_until, reminders.date_from, reminders.date_to) >= ? AND coalesce(reminders.snoozed_until, reminders.date_from, reminders.date_to) < ?))", from, to).
+		Find(&orm).
+		Error
+
+	if err != nil {
+		return dms, err
+	}
+
+	dms = lo.Map(orm, func(item Reminder, i int) domain.Reminder {
+		return domain.Reminder{
+			UUID:          item.UUID,
+			CreatedBy:     item.CreatedBy,
+			CreatedByUUID: item.CreatedByUUID,
+			TaskUUID:      item.TaskUUID,
+			UserUUID:      item.UserUUID,
+			DateFrom:      item.DateFrom,
+			DateTo:        item.DateTo,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Description:   item.Description,
+			Comment:       item.Comment,
+			Type:          item.Type,
+			Status:        item.Status,
+
+			Recurrence:     (*domain.ReminderRecurrence)(item.Recurrence),
+			SnoozedUntil:   item.SnoozedUntil,
+			DeadlineOffset: item.DeadlineOffset,
 		}
 	})
 
@@ -191,6 +250,13 @@ func (r *Repository) Get(uid uuid.UUID) (dms domain.Reminder, err error) {
 		CreatedAt:     orm.CreatedAt,
 		UpdatedAt:     orm.UpdatedAt,
 		Description:   orm.Description,
+		Comment:       orm.Comment,
+		Type:          orm.Type,
+		Status:        orm.Status,
+
+		Recurrence:     (*domain.ReminderRecurrence)(orm.Recurrence),
+		SnoozedUntil:   orm.SnoozedUntil,
+		DeadlineOffset: orm.DeadlineOffset,
 	}, nil
 }
 
@@ -209,3 +275,43 @@ func (r *Repository) GetRemindersNames(_ context.Context, uids []uuid.UUID) (wit
 
 	return withName, nil
 }
+
+func (r *Repository) ChangeFields(uid uuid.UUID, fields map[string]interface{}) error {
+	fields["updated_at"] = gorm.Expr("now()")
+
+	res := r.gorm.DB.
+		Model(&Reminder{}).
+		Where("uuid = ?", uid).
+		Where("deleted_at is null").
+		Updates(fields)
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return dto.NotFoundErr("нельзя обновлять удаленное дело")
+	}
+
+	return nil
+}
+
+// SyncDeadline - переносит напоминания относительно срока задачи вслед за сроком.
+// Перенесенное напоминание срабатывает снова, без срока - не срабатывает.
+func (r *Repository) SyncDeadline(taskUUID uuid.UUID) (uids []uuid.UUID, err error) {
+	err = r.gorm.DB.Raw(`
+		UPDATE reminders r
+		SET date_from = t.finish_to + r.deadline_offset * interval '1 minute',
+			date_to = NULL, snoozed_until = NULL, status = 0, updated_at = now()
+		FROM tasks t
+		WHERE t.uuid = r.task_uuid
+			AND r.task_uuid = ?
+			AND r.deadline_offset IS NOT NULL
+			AND r.deleted_at IS NULL
+			AND r.date_from IS DISTINCT FROM t.finish_to + r.deadline_offset * interval '1 minute'
+		RETURNING r.uuid`,
+		taskUUID,
+	).Scan(&uids).Error
+
+	return uids, err
+}
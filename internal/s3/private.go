@@ -124,6 +124,43 @@ func (s3 *ServicePrivate) UploadTaskCommentFile(federatonUUID, taskUUID, comment
 	return s3.uploadFile(file, filePath)
 }
 
+// CopyCommentFilesToTask - прикрепляет файлы комментария к задаче без повторной загрузки: новые записи ссылаются на те же объекты.
+// uuid копии выводится из задачи и исходного файла, уже прикрепленные файлы пропускаются.
+func (s3 *ServicePrivate) CopyCommentFilesToTask(commentUUID, taskUUID, userUUID uuid.UUID) (files []File, err error) {
+	orms, err := s3.repo.GetCommentFiles(commentUUID)
+	if err != nil {
+		return files, err
+	}
+
+	attached, err := s3.repo.GetTaskFiles(taskUUID)
+	if err != nil {
+		return files, err
+	}
+
+	for _, orm := range orms {
+		file := orm
+		file.UUID = uuid.NewSHA1(taskUUID, orm.UUID[:])
+
+		if lo.ContainsBy(attached, func(item File) bool { return item.UUID == file.UUID }) {
+			continue
+		}
+
+		file.Type = "task"
+		file.TypeUUID = taskUUID
+		file.CreatedBy = userUUID
+		file.CreatedAt = time.Now()
+
+		err = s3.repo.Create(file)
+		if err != nil {
+			return files, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
 func (s3 *ServicePrivate) uploadFile(file File, filePath string) (File, error) {
 	err := s3.repo.Create(file)
 	if err != nil {
@@ -194,6 +231,16 @@ func (s3 *ServicePrivate) Delete(fileUUID uuid.UUID) error {
 		return err
 	}
 
+	// объект может быть общим с копиями файла (например, после создания задачи из комментария)
+	refs, err := s3.repo.CountObjectRefs(file.ObjectName, fileUUID)
+	if err != nil {
+		return err
+	}
+
+	if refs > 0 {
+		return s3.repo.Delete(fileUUID)
+	}
+
 	minioClient, err := minio.New(s3.endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(s3.accessKeyID, s3.secretAccessKey, ""),
 		Secure: s3.useSSL,
@@ -72,6 +72,19 @@ func (r *Repository) GetCommentsFiles(commnetsUUID []uuid.UUID) (files []File, e
 	return files, res.Error
 }
 
+// CountObjectRefs - сколько других неудаленных файлов ссылаются на тот же объект в хранилище.
+func (r *Repository) CountObjectRefs(objectName string, exceptUUID uuid.UUID) (count int64, err error) {
+	err = r.gorm.DB.
+		Model(&File{}).
+		Where("object_name = ?", objectName).
+		Where("uuid != ?", exceptUUID).
+		Where("deleted_at IS NULL").
+		Count(&count).
+		Error
+
+	return count, err
+}
+
 func (r *Repository) MarkForDelete(fileUUID uuid.UUID) error {
 	res := r.gorm.DB.
 		Model(&File{}).
@@ -411,6 +411,10 @@ func (c *Service) CallbackDel(id, cbURL string) (Response, error) {
 	return res, nil
 }
 
+func (c *Service) GetScheduled(companyUUID uuid.UUID, email string, from, to time.Time) ([]domain.Sms, error) {
+	return c.repo.GetScheduled(companyUUID, email, from, to)
+}
+
 func (c *Service) GetSms(ctx context.Context, filter dto.SmsFilterDTO) ([]domain.Sms, int64, error) {
 	return c.repo.GetSms(ctx, filter)
 }
@@ -23,6 +23,8 @@ type sms struct {
 	Status string  `gorm:"type:varchar(100);default:'';not null;"`
 	Cost   float64 `gorm:"type:float;default:0;not null;"`
 
+	SentAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
+
 	CreatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	UpdatedAt time.Time  `gorm:"type:timestamptz;default:now();not null"`
 	DeletedAt *time.Time `gorm:"type:timestamptz;default:NULL;"`
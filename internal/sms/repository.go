@@ -2,11 +2,14 @@ package sms
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/pkg/postgres"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -31,6 +34,7 @@ func (r *Repository) Create(s *domain.Sms) error {
 		To:             s.To,
 		Text:           s.Text,
 		From:           s.From,
+		SentAt:         helpers.Ptr(helpers.If(s.Time.After(time.Now()), s.Time, time.Now())),
 	}).Error
 }
 
@@ -93,6 +97,7 @@ func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []d
 			To:   item.To,
 			From: item.From,
 			Text: item.Text,
+			Time: lo.FromPtr(item.SentAt),
 
 			CreatedAt: item.CreatedAt,
 			UpdatedAt: item.UpdatedAt,
@@ -101,3 +106,46 @@ func (r *Repository) GetSms(_ context.Context, filter dto.SmsFilterDTO) (dms []d
 
 	return dms, total, nil
 }
+
+// GetScheduled - sms компании или пользователя, отправка которых назначена на интервал [from, to).
+func (r *Repository) GetScheduled(companyUUID uuid.UUID, email string, from, to time.Time) (dms []domain.Sms, err error) {
+	orms := []sms{}
+
+	query := r.gorm.DB.
+		Where("deleted_at is null").
+		Where("sent_at >= ? and sent_at < ?", from, to)
+
+	if companyUUID != uuid.Nil {
+		query = query.Where("company_uuid = ?", companyUUID)
+	}
+
+	if email != "" {
+		query = query.Where("created_by = ?", email)
+	}
+
+	err = query.Find(&orms).Error
+	if err != nil {
+		return dms, err
+	}
+
+	dms = helpers.Map(orms, func(item sms, i int) domain.Sms {
+		return domain.Sms{
+			UUID:           item.UUID,
+			FederationUUID: item.FederationUUID,
+			CompanyUUID:    item.CompanyUUID,
+
+			CreatedBy:     item.CreatedBy,
+			CreatedByUUID: item.CreatedByUUID,
+
+			To:   item.To,
+			From: item.From,
+			Text: item.Text,
+			Time: lo.FromPtr(item.SentAt),
+
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+	})
+
+	return dms, nil
+}
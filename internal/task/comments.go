@@ -2,56 +2,67 @@ package task
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
-func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) (err error) {
+// CreateComment - участники задачи и упомянутые в комментарии получают уведомление.
+func (s *Service) CreateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) (domain.Comment, error) {
 	task, err := s.GetTask(ctx, uid, []string{})
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	err = s.commentService.CreateComment(ctx, cm)
+	cm, err = s.commentService.CreateComment(ctx, cm)
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	notify := lo.Filter(task.People, func(email string, _ int) bool {
-		return email != cm.CreatedBy
-	})
-
-	err = s.TaskWasUpdatedOrCreated(uid, notify)
+	err = s.TaskWasUpdatedOrCreated(uid, commentNotify(task, cm))
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	return nil
+	if s.onCommentCreated != nil {
+		err = s.onCommentCreated(task, cm)
+		if err != nil {
+			logrus.Error("onCommentCreated error: ", err)
+		}
+	}
+
+	return cm, nil
 }
 
-func (s *Service) UpdateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) (err error) {
+func (s *Service) UpdateComment(ctx context.Context, uid uuid.UUID, cm domain.Comment) (domain.Comment, error) {
 	task, err := s.GetTask(ctx, uid, []string{})
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	err = s.commentService.UpdateComment(ctx, cm)
+	cm, err = s.commentService.UpdateComment(ctx, cm)
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	notify := lo.Filter(task.People, func(email string, _ int) bool {
-		return email != cm.CreatedBy
-	})
-
-	err = s.TaskWasUpdatedOrCreated(uid, notify)
+	err = s.TaskWasUpdatedOrCreated(uid, commentNotify(task, cm))
 	if err != nil {
-		return err
+		return cm, err
 	}
 
-	return nil
+	return cm, nil
+}
+
+func commentNotify(task domain.Task, cm domain.Comment) []string {
+	return lo.Filter(lo.Uniq(append(lo.Keys(cm.People), task.People...)), func(email string, _ int) bool {
+		return email != cm.CreatedBy
+	})
 }
 
 func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUID, deletedBy string) (err error) {
@@ -76,3 +87,120 @@ func (s *Service) DeleteComment(ctx context.Context, taskUID, comentUID uuid.UUI
 
 	return nil
 }
+
+// createCommentTask - задача из комментария с заданным uuid.
+func (s *Service) createCommentTask(parent domain.Task, comment domain.Comment, createdBy string, uid uuid.UUID, path []string, name *string) (task domain.Task, id int, err error) {
+	taskName := domain.CommentTaskName(comment.Comment)
+	if name != nil && *name != "" {
+		taskName = *name
+	}
+
+	mentioned := lo.Keys(comment.People)
+	sort.Strings(mentioned)
+
+	task, err = domain.NewTask(
+		taskName,
+		parent.FederationUUID,
+		parent.CompanyUUID,
+		parent.ProjectUUID,
+		createdBy,
+		nil,
+		[]string{},
+
+		comment.Comment,
+		append([]string{}, path...),
+		mentioned,
+		"",
+		"",
+
+		parent.Priority,
+
+		nil,
+		"",
+		"",
+
+		make(map[uuid.UUID][]string),
+	)
+	if err != nil {
+		return task, id, err
+	}
+
+	task.UUID = uid
+	task.Path = append(append([]string{}, path...), uid.String())
+
+	id, err = s.CreateTask(task)
+
+	return task, id, err
+}
+
+// ConvertCommentToTask - создает из комментария подзадачу или задачу на том же уровне:
+// текст становится описанием, файлы прикрепляются без повторной загрузки, упомянутые становятся соисполнителями.
+// В исходный тред добавляется ответ со ссылкой на новую задачу.
+func (s *Service) ConvertCommentToTask(ctx context.Context, crtr domain.Creator, taskUUID, commentUUID uuid.UUID, mode string, name *string) (task domain.Task, id int, err error) {
+	parent, err := s.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return task, id, err
+	}
+
+	comment, err := s.commentService.GetComment(ctx, commentUUID)
+	if err != nil {
+		return task, id, err
+	}
+
+	if comment.TaskUUID != parent.UUID {
+		return task, id, dto.NotFoundErr("комментарий не найден")
+	}
+
+	path, err := domain.CommentTaskPath(parent.Path, mode)
+	if err != nil {
+		return task, id, err
+	}
+
+	// uuid задачи и ссылки выводятся из комментария: повтор запроса после сбоя
+	// находит уже созданную задачу и доделывает недостающие шаги, а не создает дубль
+	uid := domain.CommentTaskUUID(comment.UUID)
+
+	task, err = s.repo.GetTask(ctx, uid)
+	if err != nil && !errors.As(err, &dto.NotFoundError{}) {
+		return task, id, err
+	}
+
+	if err == nil {
+		id = task.ID
+	} else {
+		task, id, err = s.createCommentTask(parent, comment, crtr.Email, uid, path, name)
+		if err != nil {
+			return task, id, err
+		}
+	}
+
+	// ссылка добавляется последней: если она есть, задача уже создана полностью
+	linkUUID := domain.CommentTaskLinkUUID(comment.UUID)
+	if _, err = s.commentService.GetComment(ctx, linkUUID); err == nil {
+		return task, id, nil
+	}
+
+	_, err = s.storage.CopyCommentFilesToTask(comment.UUID, task.UUID, crtr.UUID)
+	if err != nil {
+		return task, id, err
+	}
+
+	// если тред уже максимальной глубины, ссылка остается на том же уровне
+	replyUUID := comment.UUID
+	if comment.Depth >= domain.CommentMaxDepth && comment.ReplyUUID != nil {
+		replyUUID = *comment.ReplyUUID
+	}
+
+	project, _ := s.dict.FindProject(task.ProjectUUID)
+	ref := domain.NewTaskRef(project.Name, id)
+
+	link := domain.NewComment(crtr.Email, parent.UUID, replyUUID, []string{}, fmt.Sprintf("Создана задача %s «%s»", ref, task.Name))
+	link.UUID = linkUUID
+
+	_, err = s.CreateComment(ctx, parent.UUID, *link)
+	if err != nil {
+		return task, id, err
+	}
+
+	return task, id, nil
+}
@@ -1,6 +1,9 @@
 package task
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+)
 
 func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 	s.onTaskUpdatedOrCreated = fn
@@ -9,3 +12,11 @@ func (s *Service) OnTaskUpdatedOrCreated(fn func(uuid.UUID, []string) error) {
 func (s *Service) OnOpenTask(fn func(uuid.UUID, string) error) {
 	s.onOpenTask = fn
 }
+
+func (s *Service) OnCommentCreated(fn func(domain.Task, domain.Comment) error) {
+	s.onCommentCreated = fn
+}
+
+func (s *Service) OnStatusChanged(fn func(domain.Task) error) {
+	s.onStatusChanged = fn
+}
@@ -0,0 +1,74 @@
+package task
+
+import (
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+)
+
+// CheckFieldRules - проверка значений кастомных полей по правилам компании и проекта.
+// При создании required проверяется для всех полей, при обновлении - только для переданных.
+func (s *Service) CheckFieldRules(task domain.Task, filteredFields map[string]interface{}, isNew bool) error {
+	if !isNew && len(task.RawFields) == 0 {
+		return nil
+	}
+
+	projectFields, err := s.repo.GetProjectFields(task.ProjectUUID)
+	if err != nil {
+		return err
+	}
+
+	violations := []dto.FieldViolation{}
+	for _, pfield := range projectFields {
+		rules := pfield.Rules.Merge(pfield.ProjectRules)
+
+		_, sent := task.RawFields[pfield.Hash]
+		if !sent && !isNew {
+			continue
+		}
+
+		value := filteredFields[pfield.Hash]
+		if domain.IsEmptyFieldValue(value) {
+			if rules.IsRequired() {
+				violations = append(violations, dto.FieldViolation{
+					Hash:    pfield.Hash,
+					Name:    pfield.Name,
+					Rule:    domain.RuleRequired,
+					Message: "обязательное поле",
+				})
+			}
+
+			continue
+		}
+
+		for _, v := range rules.Check(value) {
+			violations = append(violations, dto.FieldViolation{
+				Hash:    pfield.Hash,
+				Name:    pfield.Name,
+				Rule:    v.Rule,
+				Message: v.Message,
+			})
+		}
+
+		if rules.IsUnique() {
+			exists, err := s.repo.FieldValueExists(task.ProjectUUID, task.UUID, pfield.Hash, value)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				violations = append(violations, dto.FieldViolation{
+					Hash:    pfield.Hash,
+					Name:    pfield.Name,
+					Rule:    domain.RuleUnique,
+					Message: "значение уже используется в другой задаче проекта",
+				})
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return dto.FieldValidationError{Fields: violations}
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ type Service struct {
 
 	onTaskUpdatedOrCreated func(uuid.UUID, []string) error
 	onOpenTask             func(uuid.UUID, string) error
+	onCommentCreated       func(domain.Task, domain.Comment) error
+	onStatusChanged        func(domain.Task) error
 }
 
 func New(repo *Repository, dict *dictionary.Service, as *activities.Service, ps *profile.Service, cs *comments.Service, storage *s3.ServicePrivate) *Service {
@@ -79,11 +81,18 @@ func (s *Service) TaskWasOpen(uid uuid.UUID, email string) error {
 }
 
 func (s *Service) CreateTask(task domain.Task) (id int, err error) {
+	task.Description = domain.SanitizeMarkdown(task.Description)
+
 	filteredFields, err := s.FilterTaskFields(task)
 	if err != nil {
 		return id, err
 	}
 
+	err = s.CheckFieldRules(task, filteredFields, true)
+	if err != nil {
+		return id, err
+	}
+
 	// @todo: filter task_entities fields by project
 
 	task.Fields = filteredFields
@@ -116,11 +125,18 @@ func (s *Service) CreateTask(task domain.Task) (id int, err error) {
 }
 
 func (s *Service) UpdateTask(crtr domain.Creator, task domain.Task, shouldUpdate []string) (err error) {
+	task.Description = domain.SanitizeMarkdown(task.Description)
+
 	filteredFields, err := s.FilterTaskFields(task)
 	if err != nil {
 		return err
 	}
 
+	err = s.CheckFieldRules(task, filteredFields, false)
+	if err != nil {
+		return err
+	}
+
 	for k, v := range filteredFields {
 		task.Fields[k] = v
 	}
@@ -332,6 +348,53 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 						msg := fmt.Sprintf("field %s (%s) should be string", pfield.Name, pfield.Hash)
 						return filteredFields, errors.New(msg)
 					}
+				case domain.Select:
+					v, ok := value.(string)
+					if !ok {
+						msg := fmt.Sprintf("field %s (%s) should be string (option uuid)", pfield.Name, pfield.Hash)
+						return filteredFields, errors.New(msg)
+					}
+
+					if _, found := pfield.Options.Find(v); !found {
+						msg := fmt.Sprintf("field %s (%s) - unknown option %s", pfield.Name, pfield.Hash, v)
+						return filteredFields, errors.New(msg)
+					}
+
+					filteredFields[pfield.Hash] = v
+				case domain.MultiSelect:
+					rt := reflect.TypeOf(value)
+					if rt.Kind() != reflect.Slice && rt.Kind() != reflect.Array {
+						msg := fmt.Sprintf("field %s (%s) should be array (option uuids)", pfield.Name, pfield.Hash)
+						return filteredFields, errors.New(msg)
+					}
+
+					arrWithStrings := []string{}
+					for _, i := range value.([]interface{}) {
+						v := fmt.Sprintf("%v", i)
+						if _, found := pfield.Options.Find(v); !found {
+							msg := fmt.Sprintf("field %s (%s) - unknown option %s", pfield.Name, pfield.Hash, v)
+							return filteredFields, errors.New(msg)
+						}
+
+						arrWithStrings = append(arrWithStrings, v)
+					}
+
+					filteredFields[pfield.Hash] = lo.Uniq(arrWithStrings)
+				case domain.MoneyType:
+					v, ok := value.(map[string]interface{})
+					if !ok {
+						msg := fmt.Sprintf("field %s (%s) should be money {amount, currency}", pfield.Name, pfield.Hash)
+						return filteredFields, errors.New(msg)
+					}
+
+					currency, _ := v["currency"].(string)
+					m, err := domain.NewMoney(v["amount"], currency)
+					if err != nil {
+						msg := fmt.Sprintf("field %s (%s) - %s", pfield.Name, pfield.Hash, err.Error())
+						return filteredFields, errors.New(msg)
+					}
+
+					filteredFields[pfield.Hash] = m
 				case domain.People:
 					rt := reflect.TypeOf(value)
 					if rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array {
@@ -358,6 +421,38 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 						msg := fmt.Sprintf("field %s (%s) should be array", pfield.Name, pfield.Hash)
 						return filteredFields, errors.New(msg)
 					}
+				case domain.Relation:
+					if pfield.Relation == nil {
+						msg := fmt.Sprintf("field %s (%s) - relation is not configured", pfield.Name, pfield.Hash)
+						return filteredFields, errors.New(msg)
+					}
+
+					uids, err := pfield.Relation.ParseValue(value)
+					if err != nil {
+						msg := fmt.Sprintf("field %s (%s) %s", pfield.Name, pfield.Hash, err.Error())
+						return filteredFields, errors.New(msg)
+					}
+
+					names, err := s.repo.FindRelations(*pfield.Relation, task.CompanyUUID, uids)
+					if err != nil {
+						return filteredFields, err
+					}
+
+					strs := []string{}
+					for _, uid := range uids {
+						if _, ok := names[uid]; !ok {
+							msg := fmt.Sprintf("field %s (%s) - %s not found", pfield.Name, pfield.Hash, uid)
+							return filteredFields, errors.New(msg)
+						}
+
+						strs = append(strs, uid.String())
+					}
+
+					if pfield.Relation.Multiple {
+						filteredFields[pfield.Hash] = strs
+					} else {
+						filteredFields[pfield.Hash] = strs[0]
+					}
 				}
 			}
 		}
@@ -382,6 +477,10 @@ func (s *Service) FilterTaskFields(task domain.Task) (filteredFields map[string]
 }
 
 func (s *Service) CreateTaskBatch(updaterEmail string, tasks []domain.Task) (err error) {
+	for i := range tasks {
+		tasks[i].Description = domain.SanitizeMarkdown(tasks[i].Description)
+	}
+
 	err = s.repo.CreateInBatches(tasks)
 	if err != nil {
 		return err
@@ -507,9 +606,22 @@ func (s *Service) GetTasksNames(ctx context.Context, uid []uuid.UUID) (taskWithN
 	return s.repo.GetTaskNames(ctx, uid)
 }
 
+func (s *Service) GetDeadlineTasks(ctx context.Context, email string, projectUUID uuid.UUID) ([]domain.Task, error) {
+	return s.repo.GetDeadlineTasks(ctx, email, projectUUID)
+}
+
+func (s *Service) GetCalendarTasks(ctx context.Context, email string, projectUUID uuid.UUID, from, to time.Time) ([]domain.Task, error) {
+	return s.repo.GetCalendarTasks(ctx, email, projectUUID, from, to)
+}
+
 func (s *Service) GetTasks(ctx context.Context, filter dto.TaskSearchDTO) (dm []domain.Task, total int64, err error) {
 	allowSort := s.GetSortFields(filter.ProjectUUID)
 
+	filter.Fields, err = s.prepareFieldFilters(filter.ProjectUUID, filter.Fields)
+	if err != nil {
+		return dm, -1, err
+	}
+
 	dm, total, err = s.repo.GetTasks(ctx, filter, allowSort)
 	if err != nil {
 		return dm, -1, err
@@ -758,6 +870,13 @@ func (s *Service) PatchStatus(crtr domain.Creator, project dto.ProjectDTO, task
 		if err != nil {
 			return stopUUID, path, err
 		}
+
+		if s.onStatusChanged != nil {
+			err = s.onStatusChanged(task)
+			if err != nil {
+				logrus.Error("onStatusChanged error: ", err)
+			}
+		}
 	}
 
 	//
@@ -1059,16 +1178,60 @@ func (s *Service) ResetCache(uid uuid.UUID) {
 }
 
 func (s *Service) GetSortFields(projectUUID uuid.UUID) []string {
-	fields, _ := s.dict.FindCompanyFields(projectUUID)
+	fields, _ := s.dict.FindProjectFields(projectUUID)
 
-	allowOrder := s.repo.GetSortFields()
+	return sortFields(s.repo.GetSortFields(), fields)
+}
 
+// sortFields - поля задачи и полей проекта, по которым разрешена сортировка.
+func sortFields(allowOrder []string, fields []dto.ProjectFieldDTO) []string {
 	for _, field := range fields {
 		if domain.FieldDataType(field.DataType) == domain.Integer || domain.FieldDataType(field.DataType) == domain.Float || domain.FieldDataType(field.DataType) == domain.String {
 			// fileds.a || fields.b
 			allowOrder = append(allowOrder, "fields."+field.Hash+"")
 		}
+
+		if domain.FieldDataType(field.DataType) == domain.MoneyType {
+			// fields.a.amount
+			allowOrder = append(allowOrder, "fields."+field.Hash+".amount")
+		}
 	}
 
 	return allowOrder
 }
+
+// prepareFieldFilters - фильтры по полям типа money: "RUB", ">=100", "<=100", "100..500", "100..500 RUB".
+func (s *Service) prepareFieldFilters(projectUUID uuid.UUID, filters []dto.FilterDTO) ([]dto.FilterDTO, error) {
+	if len(filters) == 0 {
+		return filters, nil
+	}
+
+	fields, _ := s.dict.FindProjectFields(projectUUID)
+
+	for i, f := range filters {
+		field, found := lo.Find(fields, func(item dto.ProjectFieldDTO) bool {
+			return item.Hash == f.Name
+		})
+		if !found || domain.FieldDataType(field.DataType) != domain.MoneyType {
+			continue
+		}
+
+		mf, err := dto.NewMoneyFilterDTO(fmt.Sprintf("%v", f.Value))
+		if err != nil {
+			return filters, fmt.Errorf("field %s (%s) - %w", field.Name, field.Hash, err)
+		}
+
+		filters[i].Operator = dto.MoneyFilterOperator
+		filters[i].Value = mf
+	}
+
+	return filters, nil
+}
+
+func (s *Service) GetFieldValues(companyUUID uuid.UUID, hash string, after uuid.UUID, since *time.Time, limit int) ([]domain.FieldValue, error) {
+	return s.repo.GetFieldValues(companyUUID, hash, after, since, limit)
+}
+
+func (s *Service) SetFieldValues(hash string, values []domain.FieldValue) error {
+	return s.repo.SetFieldValues(hash, values)
+}
@@ -0,0 +1,39 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+func TestSortFields(t *testing.T) {
+	fields := []dto.ProjectFieldDTO{
+		{Hash: "a", DataType: int(domain.Integer)},
+		{Hash: "b", DataType: int(domain.MoneyType)},
+		{Hash: "c", DataType: int(domain.Select)},
+	}
+
+	got := sortFields([]string{"created_at"}, fields)
+
+	tests := []struct {
+		name  string
+		field string
+		want  bool
+	}{
+		{name: "Task field", field: "created_at", want: true},
+		{name: "Integer field", field: "fields.a", want: true},
+		{name: "Money field", field: "fields.b.amount", want: true},
+		{name: "Money field without amount", field: "fields.b", want: false},
+		{name: "Select field", field: "fields.c", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if lo.Contains(got, tt.field) != tt.want {
+				t.Errorf("sortFields() = %v, contains %s want %v", got, tt.field, tt.want)
+			}
+		})
+	}
+}
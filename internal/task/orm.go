@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/lib/pq"
 	"gorm.io/datatypes"
 )
@@ -138,4 +139,11 @@ type CompanyFields struct {
 	Name        string `gorm:"type:varchar(100);not null;"`
 	DataType    int    `gorm:"type:int;not null;default:0"`
 	CompanyUUID string `gorm:"type:uuid;not null"`
+
+	Options domain.CompanyFieldOptions `gorm:"type:jsonb;default:'[]';not null;"`
+	Rules   domain.FieldRules          `gorm:"type:jsonb;default:'{}';not null;"`
+
+	Relation *domain.FieldRelation `gorm:"type:jsonb;"`
+
+	ProjectRules domain.FieldRules `gorm:"->;type:jsonb;"`
 }
@@ -0,0 +1,102 @@
+package task
+
+import (
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/samber/lo"
+)
+
+const referencingTasksLimit = 200
+
+// RelationFieldsData - сущности, на которые ссылаются поля relation задачи, для LinkedFieldsData.
+// Удаленные сущности не попадают в результат.
+func (s *Service) RelationFieldsData(dm domain.Task) (map[uuid.UUID]interface{}, error) {
+	data := make(map[uuid.UUID]interface{})
+
+	if len(dm.Fields) == 0 {
+		return data, nil
+	}
+
+	projectFields, err := s.repo.GetProjectFields(dm.ProjectUUID)
+	if err != nil {
+		return data, err
+	}
+
+	for _, pfield := range projectFields {
+		if domain.FieldDataType(pfield.DataType) != domain.Relation || pfield.Relation == nil {
+			continue
+		}
+
+		uids := pfield.Relation.ReferencedUUIDs(dm.Fields[pfield.Hash])
+		if len(uids) == 0 {
+			continue
+		}
+
+		names, err := s.repo.FindRelations(*pfield.Relation, dm.CompanyUUID, uids)
+		if err != nil {
+			return data, err
+		}
+
+		for uid, name := range names {
+			if item, ok := data[uid].(dto.RelationDTO); ok {
+				item.Hash = append(item.Hash, pfield.Hash)
+				data[uid] = item
+				continue
+			}
+
+			data[uid] = dto.RelationDTO{
+				UUID:   uid,
+				Target: pfield.Relation.Target,
+				Name:   name,
+				Hash:   []string{pfield.Hash},
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// GetReferencingTasks - обратный поиск: задачи компании, которые ссылаются на uid через поля relation.
+func (s *Service) GetReferencingTasks(companyUUID, uid uuid.UUID) ([]dto.RelationTaskDTO, error) {
+	items := []dto.RelationTaskDTO{}
+
+	fields, err := s.repo.GetCompanyRelationFields(companyUUID)
+	if err != nil {
+		return items, err
+	}
+
+	hashes := lo.Map(fields, func(item CompanyFields, index int) string {
+		return item.Hash
+	})
+
+	orms, err := s.repo.GetReferencingTasks(companyUUID, hashes, uid)
+	if err != nil {
+		return items, err
+	}
+
+	for _, orm := range orms {
+		item := dto.RelationTaskDTO{
+			UUID:        orm.UUID,
+			ID:          orm.ID,
+			Name:        orm.Name,
+			ProjectUUID: orm.ProjectUUID,
+			Status:      orm.Status,
+			Hash:        []string{},
+		}
+
+		for _, field := range fields {
+			if field.Relation == nil {
+				continue
+			}
+
+			if lo.Contains(field.Relation.ReferencedUUIDs(orm.Fields[field.Hash]), uid) {
+				item.Hash = append(item.Hash, field.Hash)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
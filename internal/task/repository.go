@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -160,6 +162,7 @@ func (r *Repository) GetProjectFields(projectUUID uuid.UUID) (orm []CompanyField
 	orm = []CompanyFields{}
 
 	err = r.gorm.DB.Model(&orm).
+		Select("company_fields.*, pf.rules as project_rules").
 		Joins("left join project_fields pf on pf.company_field_uuid = company_fields.uuid").
 		Where("pf.project_uuid = ?", projectUUID).
 		Where("company_fields.deleted_at is null").
@@ -426,7 +429,7 @@ func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWith
 
 	err = r.gorm.DB.
 		Model(&Task{}).
-		Select("uuid, name").
+		Select("uuid, id, name, project_uuid, status").
 		Where("uuid in ?", uids).
 		Where("deleted_at is null").
 		Find(&taskWithName).
@@ -439,6 +442,54 @@ func (r *Repository) GetTaskNames(_ context.Context, uids []uuid.UUID) (taskWith
 	return taskWithName, nil
 }
 
+// GetDeadlineTasks - незавершенные задачи со сроком: пользователя (исполнитель или ответственный) и/или проекта.
+func (r *Repository) GetDeadlineTasks(_ context.Context, email string, projectUUID uuid.UUID) (dms []domain.Task, err error) {
+	defer r.storeTime("GetDeadlineTasks", tm())
+
+	query := r.gorm.DB.
+		Model(&Task{}).
+		Select("uuid, id, name, project_uuid, status, finish_to, updated_at").
+		Where("deleted_at is null").
+		Where("finish_to is not null").
+		Where("status not in ?", []int{domain.StatusDone, domain.StatusCancel})
+
+	if email != "" {
+		query = query.Where("(implement_by = ? or responsible_by = ?)", email, email)
+	}
+
+	if projectUUID != uuid.Nil {
+		query = query.Where("project_uuid = ?", projectUUID)
+	}
+
+	err = query.Order("finish_to").Find(&dms).Error
+
+	return dms, err
+}
+
+// GetCalendarTasks - задачи со сроком в интервале [from, to) (кроме завершенных и отмененных) или завершенные в нем.
+func (r *Repository) GetCalendarTasks(_ context.Context, email string, projectUUID uuid.UUID, from, to time.Time) (dms []domain.Task, err error) {
+	defer r.storeTime("GetCalendarTasks", tm())
+
+	query := r.gorm.DB.
+		Model(&Task{}).
+		Select("uuid, id, name, project_uuid, status, finish_to, finished_at, finished_by, implement_by, responsible_by, updated_at").
+		Where("deleted_at is null").
+		Where("((finish_to >= ? and finish_to < ? and status not in ?) or (finished_at >= ? and finished_at < ?))",
+			from, to, []int{domain.StatusDone, domain.StatusCancel}, from, to)
+
+	if email != "" {
+		query = query.Where("(implement_by = ? or responsible_by = ?)", email, email)
+	}
+
+	if projectUUID != uuid.Nil {
+		query = query.Where("project_uuid = ?", projectUUID)
+	}
+
+	err = query.Find(&dms).Error
+
+	return dms, err
+}
+
 func (r *Repository) GetSortFields() []string {
 	st := reflect.TypeOf(Task{})
 
@@ -470,7 +521,10 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		}
 
 		if helpers.InArray(*filter.Order, allowSort) {
-			if strings.HasPrefix(*filter.Order, "fields.") {
+			if strings.HasPrefix(*filter.Order, "fields.") && strings.HasSuffix(*filter.Order, ".amount") {
+				hash := strings.TrimSuffix(strings.TrimPrefix(*filter.Order, "fields."), ".amount")
+				*filter.Order = "(fields->'" + hash + "'->>'amount')::numeric"
+			} else if strings.HasPrefix(*filter.Order, "fields.") {
 				*filter.Order = "fields->>'" + strings.Replace(*filter.Order, "fields.", "", 1) + "'"
 			}
 
@@ -519,6 +573,19 @@ func (r *Repository) GetTasks(_ context.Context, filter dto.TaskSearchDTO, allow
 		for _, item := range filter.Fields {
 			logrus.Warn(item.Value)
 			logrus.Warn(item.Value)
+			if mf, ok := item.Value.(dto.MoneyFilterDTO); ok && item.Operator == dto.MoneyFilterOperator {
+				if mf.Currency != nil {
+					query = query.Where("fields->?->>'currency' = ?", item.Name, *mf.Currency)
+				}
+				if mf.From != nil {
+					query = query.Where("(fields->?->>'amount')::numeric >= ?::numeric", item.Name, *mf.From)
+				}
+				if mf.To != nil {
+					query = query.Where("(fields->?->>'amount')::numeric <= ?::numeric", item.Name, *mf.To)
+				}
+				continue
+			}
+
 			// @todo: add regular to check array
 			if strings.HasPrefix(fmt.Sprintf("%v", item.Value), "@> [") && strings.HasSuffix(fmt.Sprintf("%v", item.Value), "]") {
 				v := strings.TrimPrefix(item.Value.(string), "@> ")
@@ -622,6 +689,29 @@ func (r *Repository) ChangeField(uid uuid.UUID, fieldName string, value interfac
 	return res.Error
 }
 
+// FieldValueExists - есть ли в проекте другая задача с таким же значением поля (правило unique).
+func (r *Repository) FieldValueExists(projectUUID, exceptUUID uuid.UUID, hash string, value interface{}) (bool, error) {
+	defer r.storeTime("FieldValueExists", tm())
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("project_uuid = ?", projectUUID).
+		Where("uuid <> ?", exceptUUID).
+		Where("deleted_at is null").
+		Where("fields -> ? = ?::jsonb", hash, string(b)).
+		Limit(1).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
 func (r *Repository) storeTime(name string, t *helpers.Time) {
 	func() { r.histogram.WithLabelValues(name).Observe(t.Secondsf()) }()
 }
@@ -790,3 +880,189 @@ func (r *Repository) DeleteTask(uid uuid.UUID) (err error) {
 func (r *Repository) ResetCache(uid uuid.UUID) {
 	r.cache.ClearTask(context.TODO(), uid)
 }
+
+type fieldValueRow struct {
+	UUID  uuid.UUID
+	Value []byte
+}
+
+// GetFieldValues - значения поля hash в задачах компании, постранично по uuid (after).
+func (r *Repository) GetFieldValues(companyUUID uuid.UUID, hash string, after uuid.UUID, since *time.Time, limit int) (items []domain.FieldValue, err error) {
+	defer r.storeTime("GetFieldValues", tm())
+
+	rows := []fieldValueRow{}
+	q := r.gorm.DB.
+		Model(&Task{}).
+		Select("uuid, fields -> ? as value", hash).
+		Where("company_uuid = ?", companyUUID).
+		Where("fields -> ? is not null", hash).
+		Where("uuid > ?", after)
+
+	if since != nil {
+		q = q.Where("updated_at >= ?", *since)
+	}
+
+	err = q.Order("uuid").Limit(limit).Scan(&rows).Error
+	if err != nil {
+		return items, err
+	}
+
+	for _, row := range rows {
+		var value interface{}
+		if err := json.Unmarshal(row.Value, &value); err != nil {
+			return items, err
+		}
+
+		items = append(items, domain.FieldValue{UUID: row.UUID, Value: value})
+	}
+
+	return items, nil
+}
+
+// SetFieldValues - запись сконвертированных значений поля, nil удаляет значение.
+// updated_at не меняется: это миграция данных, а не правка задачи.
+func (r *Repository) SetFieldValues(hash string, values []domain.FieldValue) error {
+	defer r.storeTime("SetFieldValues", tm())
+
+	err := r.gorm.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range values {
+			if v.Value == nil {
+				if err := tx.Exec("update tasks set fields = fields - ? where uuid = ?", hash, v.UUID).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			b, err := json.Marshal(v.Value)
+			if err != nil {
+				return err
+			}
+
+			err = tx.Exec("update tasks set fields = jsonb_set(fields, ARRAY[?]::text[], ?::jsonb) where uuid = ?", hash, string(b), v.UUID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		go func() {
+			for _, v := range values {
+				r.ResetCache(v.UUID)
+			}
+		}()
+	}
+
+	return err
+}
+
+type relationRow struct {
+	UUID   uuid.UUID
+	Name   string
+	Fields []byte
+}
+
+// FindRelations - существующие сущности компании для поля relation: uuid -> название.
+// Для записи справочника название - первое непустое строковое поле.
+func (r *Repository) FindRelations(rel domain.FieldRelation, companyUUID uuid.UUID, uids []uuid.UUID) (names map[uuid.UUID]string, err error) {
+	defer r.storeTime("FindRelations", tm())
+
+	names = make(map[uuid.UUID]string, len(uids))
+	if len(uids) == 0 {
+		return names, nil
+	}
+
+	rows := []relationRow{}
+
+	switch rel.Target {
+	case domain.RelationTargetTask:
+		err = r.gorm.DB.Raw("select uuid, name from tasks where uuid in ? and company_uuid = ? and deleted_at is null", uids, companyUUID).Scan(&rows).Error
+	case domain.RelationTargetAgent:
+		err = r.gorm.DB.Raw("select uuid, name from agents where uuid in ? and company_uuid = ? and deleted_at is null", uids, companyUUID).Scan(&rows).Error
+	case domain.RelationTargetCatalog:
+		err = r.gorm.DB.Raw("select uuid, fields from catalog_data where uuid in ? and company_uuid = ? and catalog_uuid = ? and deleted_at is null", uids, companyUUID, rel.CatalogUUID).Scan(&rows).Error
+	default:
+		return names, errors.New("unknown relation target")
+	}
+
+	if err != nil {
+		return names, err
+	}
+
+	for _, row := range rows {
+		if rel.Target == domain.RelationTargetCatalog {
+			row.Name = catalogRowName(row.Fields)
+		}
+
+		names[row.UUID] = row.Name
+	}
+
+	return names, nil
+}
+
+func catalogRowName(fields []byte) string {
+	mp := map[string]interface{}{}
+	if err := json.Unmarshal(fields, &mp); err != nil {
+		return ""
+	}
+
+	keys := helpers.GetMapKeys(mp)
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, k := range keys {
+		if s, ok := mp[k].(string); ok && s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+func (r *Repository) GetCompanyRelationFields(companyUUID uuid.UUID) (orm []CompanyFields, err error) {
+	err = r.gorm.DB.Model(&orm).
+		Where("company_uuid = ?", companyUUID).
+		Where("data_type = ?", int(domain.Relation)).
+		Where("deleted_at is null").
+		Find(&orm).
+		Error
+
+	return orm, err
+}
+
+// GetReferencingTasks - задачи компании, у которых одно из полей hashes ссылается на uid.
+func (r *Repository) GetReferencingTasks(companyUUID uuid.UUID, hashes []string, uid uuid.UUID) (orms []Task, err error) {
+	defer r.storeTime("GetReferencingTasks", tm())
+
+	if len(hashes) == 0 {
+		return orms, nil
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	for _, hash := range hashes {
+		single, _ := json.Marshal(map[string]interface{}{hash: uid.String()})
+		multiple, _ := json.Marshal(map[string]interface{}{hash: []string{uid.String()}})
+
+		conditions = append(conditions, "fields @> ?::jsonb", "fields @> ?::jsonb")
+		args = append(args, string(single), string(multiple))
+	}
+
+	err = r.gorm.DB.
+		Model(&Task{}).
+		Where("company_uuid = ?", companyUUID).
+		Where("deleted_at is null").
+		Where("("+strings.Join(conditions, " or ")+")", args...).
+		Order("created_at desc").
+		Limit(referencingTasksLimit).
+		Find(&orms).
+		Error
+
+	return orms, err
+}
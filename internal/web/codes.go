@@ -1,5 +1,7 @@
 package web
 
+import "github.com/krisch/crm-backend/dto"
+
 const (
 	CodeInvalidEmail = 1
 )
@@ -7,6 +9,7 @@ const (
 type ValidationError struct {
 	StatusCode int
 	Errors     []string
+	Fields     []dto.FieldViolation `json:",omitempty"`
 }
 
 type RequestError struct {
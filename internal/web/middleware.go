@@ -100,8 +100,8 @@ func ValidateStructMiddeware(f omain.StrictHandlerFunc, operationID string) omai
 		if !ok {
 			logrus.Debugf("[operation:%v] validation error", operationID)
 			return nil, &ValidationError{
-				http.StatusBadRequest,
-				errs,
+				StatusCode: http.StatusBadRequest,
+				Errors:     errs,
 			}
 		}
 
@@ -60,6 +60,17 @@ type CompanyDTO = dto.CompanyDTO
 // CompanyFieldDTO defines model for CompanyFieldDTO.
 type CompanyFieldDTO = dto.CompanyFieldDTO
 
+// CompanyFieldOptionDTO defines model for CompanyFieldOptionDTO.
+type CompanyFieldOptionDTO = dto.CompanyFieldOptionDTO
+
+// CompanyFieldOptionRequest defines model for CompanyFieldOptionRequest.
+type CompanyFieldOptionRequest struct {
+	Color *string             `json:"color,omitempty" validate:"omitempty,lte=20"`
+	Label string              `json:"label" validate:"trim,min=1,max=100"`
+	Order *int                `json:"order,omitempty" validate:"omitempty,gte=0"`
+	Uuid  *openapi_types.UUID `json:"uuid,omitempty" validate:"omitempty,uuid"`
+}
+
 // CompanyPriorityCreateRequest defines model for CompanyPriorityCreateRequest.
 type CompanyPriorityCreateRequest struct {
 	Color  string `json:"color" validate:"color"`
@@ -70,6 +81,28 @@ type CompanyPriorityCreateRequest struct {
 // CompanyPriorityDTO defines model for CompanyPriorityDTO.
 type CompanyPriorityDTO = dto.CompanyPriorityDTO
 
+// CurrencyRateDTO defines model for CurrencyRateDTO.
+type CurrencyRateDTO = dto.CurrencyRateDTO
+
+// CurrencyRateRequest defines model for CurrencyRateRequest.
+type CurrencyRateRequest struct {
+	Date openapi_types.Date `json:"date"`
+	From string             `json:"from" validate:"len=3"`
+	Rate string             `json:"rate" validate:"min=1,max=40"`
+	To   string             `json:"to" validate:"len=3"`
+}
+
+// CurrencyRatesLoadRequest defines model for CurrencyRatesLoadRequest.
+type CurrencyRatesLoadRequest struct {
+	Items []CurrencyRateRequest `json:"items" validate:"min=1,max=5000,dive"`
+}
+
+// EmailBrandingDTO defines model for EmailBrandingDTO.
+type EmailBrandingDTO = dto.EmailBrandingDTO
+
+// EmailTemplateDTO defines model for EmailTemplateDTO.
+type EmailTemplateDTO = dto.EmailTemplateDTO
+
 // FederationAddUserRequest defines model for FederationAddUserRequest.
 type FederationAddUserRequest struct {
 	UserUuid openapi_types.UUID `json:"user_uuid" validate:"uuid"`
@@ -89,9 +122,41 @@ type FederationCreateRequest struct {
 // FederationDTO defines model for FederationDTO.
 type FederationDTO = dto.FederationDTO
 
+// FieldMigrationDTO defines model for FieldMigrationDTO.
+type FieldMigrationDTO = dto.FieldMigrationDTO
+
+// FieldMigrationReportDTO defines model for FieldMigrationReportDTO.
+type FieldMigrationReportDTO = dto.FieldMigrationReportDTO
+
+// FieldMigrationRequest defines model for FieldMigrationRequest.
+type FieldMigrationRequest struct {
+	DataType  domain.FieldDataType         `json:"data_type" validate:"min=0,max=17"`
+	Default   *interface{}                 `json:"default,omitempty"`
+	FieldUuid openapi_types.UUID           `json:"field_uuid" validate:"required"`
+	Options   *[]CompanyFieldOptionRequest `json:"options,omitempty" validate:"omitempty,lte=200,dive"`
+	Strategy  *string                      `json:"strategy,omitempty" validate:"omitempty,oneof=fail default clear"`
+	Target    string                       `json:"target" validate:"oneof=company_field catalog_field"`
+}
+
+// FieldRelationDTO defines model for FieldRelationDTO.
+type FieldRelationDTO = domain.FieldRelation
+
+// FieldRelationRequest defines model for FieldRelationRequest.
+type FieldRelationRequest struct {
+	CatalogUuid *openapi_types.UUID `json:"catalog_uuid,omitempty" validate:"omitempty,uuid"`
+	Multiple    *bool               `json:"multiple,omitempty"`
+	Target      string              `json:"target" validate:"oneof=catalog agent task"`
+}
+
+// FieldRules defines model for FieldRules.
+type FieldRules = domain.FieldRules
+
 // GroupDTO defines model for GroupDTO.
 type GroupDTO = dto.GroupDTO
 
+// IntakeAddressDTO defines model for IntakeAddressDTO.
+type IntakeAddressDTO = dto.IntakeAddressDTO
+
 // InviteCreateRequest defines model for InviteCreateRequest.
 type InviteCreateRequest struct {
 	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty" validate:"omitempty,uuid"`
@@ -126,6 +191,9 @@ type NameRequiredRequest struct {
 	Name string `json:"name" validate:"trim,name,min=1,max=100"`
 }
 
+// NotificationDeliveryDTO defines model for NotificationDeliveryDTO.
+type NotificationDeliveryDTO = dto.NotificationDeliveryDTO
+
 // PatchGroupRequest defines model for PatchGroupRequest.
 type PatchGroupRequest struct {
 	Name *string `json:"name,omitempty" validate:"trim,name,min=1,max=100"`
@@ -168,25 +236,31 @@ type ProjectDTOs = dto.ProjectDTOs
 
 // ProjectFieldCreateRequest defines model for ProjectFieldCreateRequest.
 type ProjectFieldCreateRequest struct {
-	DataType           domain.FieldDataType `json:"data_type" validate:"min=0,max=14"`
-	DataUuid           *openapi_types.UUID  `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
-	Description        string               `json:"description" validate:"trim,max=5000"`
-	Icon               string               `json:"icon" validate:"trim,omitempty,lte=50"`
-	Name               string               `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int                `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	DataType           domain.FieldDataType         `json:"data_type" validate:"min=0,max=18"`
+	DataUuid           *openapi_types.UUID          `json:"data_uuid,omitempty" validate:"omitempty,uuid"`
+	Description        string                       `json:"description" validate:"trim,max=5000"`
+	Icon               string                       `json:"icon" validate:"trim,omitempty,lte=50"`
+	Name               string                       `json:"name" validate:"trim,name,min=1,max=50"`
+	Options            *[]CompanyFieldOptionRequest `json:"options,omitempty" validate:"omitempty,lte=200,dive"`
+	Relation           *FieldRelationRequest        `json:"relation,omitempty"`
+	RequiredOnStatuses []int                        `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	Rules              *FieldRules                  `json:"rules,omitempty"`
 }
 
 // ProjectFieldPutRequest defines model for ProjectFieldPutRequest.
 type ProjectFieldPutRequest struct {
-	Description        string `json:"description" validate:"trim,max=5000"`
-	Icon               string `json:"icon" validate:"trim,max=50"`
-	Name               string `json:"name" validate:"trim,name,min=1,max=50"`
-	RequiredOnStatuses []int  `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	Description        string                       `json:"description" validate:"trim,max=5000"`
+	Icon               string                       `json:"icon" validate:"trim,max=50"`
+	Name               string                       `json:"name" validate:"trim,name,min=1,max=50"`
+	Options            *[]CompanyFieldOptionRequest `json:"options,omitempty" validate:"omitempty,lte=200,dive"`
+	RequiredOnStatuses []int                        `json:"required_on_statuses" validate:"omitempty,dive,gte=0,lte=20"`
+	Rules              *FieldRules                  `json:"rules,omitempty"`
 }
 
 // ProjectRequestOptions defines model for ProjectRequestOptions.
 type ProjectRequestOptions struct {
 	Color                     *string `json:"color,omitempty" validate:"omitempty,color"`
+	CommentEditMinutes        *int    `json:"comment_edit_minutes,omitempty" validate:"omitempty,min=0,max=10080"`
 	RequireCancelationComment *bool   `json:"require_cancelation_comment,omitempty"`
 	RequireDoneComment        *bool   `json:"require_done_comment,omitempty"`
 	StatusEnable              *bool   `json:"status_enable,omitempty"`
@@ -212,6 +286,9 @@ type ProjectStatusCreateRequest struct {
 // ProjectStatusDTO defines model for ProjectStatusDTO.
 type ProjectStatusDTO = dto.ProjectStatusDTO
 
+// RelationTaskDTO defines model for RelationTaskDTO.
+type RelationTaskDTO = dto.RelationTaskDTO
+
 // SearchUserRequest defines model for SearchUserRequest.
 type SearchUserRequest struct {
 	CompanyUuid    *openapi_types.UUID `json:"company_uuid" validate:"omitempty,uuid"`
@@ -249,6 +326,9 @@ type UUIDResponse struct {
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// WorkingHoursDTO defines model for WorkingHoursDTO.
+type WorkingHoursDTO = dto.WorkingHoursDTO
+
 // EntityName defines model for entityName.
 type EntityName = string
 
@@ -261,6 +341,14 @@ type UserUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// GetCompanyUUIDNotificationsDeliveriesParams defines parameters for GetCompanyUUIDNotificationsDeliveries.
+type GetCompanyUUIDNotificationsDeliveriesParams struct {
+	Offset  *int    `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit   *int    `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,oneof=pending sending sent failed"`
+	Channel *string `form:"channel,omitempty" json:"channel,omitempty" validate:"omitempty,oneof=email sms"`
+}
+
 // PatchCompanyUUIDPrioritiesEntityUUIDJSONBody defines parameters for PatchCompanyUUIDPrioritiesEntityUUID.
 type PatchCompanyUUIDPrioritiesEntityUUIDJSONBody struct {
 	Color string `json:"color" validate:"color"`
@@ -290,6 +378,9 @@ type PostCompanyUUIDSmsOptionsJSONBody struct {
 type PostCompanyUUIDSmsSendJSONBody struct {
 	Phone int    `json:"phone" validate:"trim,min=1000000000,max=9999999999999"`
 	Text  string `json:"text" validate:"trim,min=1,max=100"`
+
+	// Time Scheduled send time, sent immediately if empty or in the past
+	Time *time.Time `json:"time,omitempty"`
 }
 
 // PostCompanyUUIDSmsSendParams defines parameters for PostCompanyUUIDSmsSend.
@@ -303,6 +394,32 @@ type GetFederationUUIDAgentParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// DeleteFederationUUIDEmailTemplatesParams defines parameters for DeleteFederationUUIDEmailTemplates.
+type DeleteFederationUUIDEmailTemplatesParams struct {
+	Type        string              `form:"type" json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang        string              `form:"lang" json:"lang" validate:"oneof=ru en"`
+	CompanyUuid *openapi_types.UUID `form:"company_uuid,omitempty" json:"company_uuid,omitempty"`
+}
+
+// PutFederationUUIDEmailTemplatesJSONBody defines parameters for PutFederationUUIDEmailTemplates.
+type PutFederationUUIDEmailTemplatesJSONBody struct {
+	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty"`
+	Type        string              `json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang        string              `json:"lang" validate:"oneof=ru en"`
+	Subject     string              `json:"subject" validate:"required,max=200"`
+	Body        string              `json:"body" validate:"required,max=100000"`
+}
+
+// PostFederationUUIDEmailTemplatesPreviewJSONBody defines parameters for PostFederationUUIDEmailTemplatesPreview.
+type PostFederationUUIDEmailTemplatesPreviewJSONBody struct {
+	CompanyUuid *openapi_types.UUID `json:"company_uuid,omitempty"`
+	Type        string              `json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang        string              `json:"lang" validate:"oneof=ru en"`
+	// Subject Empty subject and body - preview of the effective template
+	Subject *string `json:"subject,omitempty" validate:"omitempty,max=200"`
+	Body    *string `json:"body,omitempty" validate:"omitempty,max=100000"`
+}
+
 // GetFederationUUIDProjectParams defines parameters for GetFederationUUIDProject.
 type GetFederationUUIDProjectParams struct {
 	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
@@ -333,8 +450,9 @@ type PatchProjectUUIDDescriptionJSONBody struct {
 
 // PostProjectUUIDFieldEntityUUIDJSONBody defines parameters for PostProjectUUIDFieldEntityUUID.
 type PostProjectUUIDFieldEntityUUIDJSONBody struct {
-	RequiredOnStatuses []int  `json:"required_on_statuses"`
-	Style              string `json:"style"`
+	RequiredOnStatuses []int       `json:"required_on_statuses"`
+	Rules              *FieldRules `json:"rules,omitempty"`
+	Style              string      `json:"style"`
 }
 
 // PatchProjectUUIDGraphJSONBody defines parameters for PatchProjectUUIDGraph.
@@ -342,6 +460,12 @@ type PatchProjectUUIDGraphJSONBody struct {
 	Graph map[string]interface{} `json:"graph"`
 }
 
+// PutProjectUUIDIntakeJSONBody defines parameters for PutProjectUUIDIntake.
+type PutProjectUUIDIntakeJSONBody struct {
+	Email         string  `json:"email" validate:"required,email,max=100"`
+	ResponsibleBy *string `json:"responsible_by,omitempty" validate:"omitempty,email,max=100"`
+}
+
 // PatchProjectUUIDStatusEntityUUIDJSONBody defines parameters for PatchProjectUUIDStatusEntityUUID.
 type PatchProjectUUIDStatusEntityUUIDJSONBody struct {
 	Color       string `json:"color" validate:"color"`
@@ -363,6 +487,15 @@ type PatchTagUUIDJSONBody struct {
 // PostCompanyJSONRequestBody defines body for PostCompany for application/json ContentType.
 type PostCompanyJSONRequestBody = FederationCreateCompanyRequest
 
+// PostCompanyUUIDCurrencyRatesJSONRequestBody defines body for PostCompanyUUIDCurrencyRates for application/json ContentType.
+type PostCompanyUUIDCurrencyRatesJSONRequestBody = CurrencyRatesLoadRequest
+
+// PostCompanyUUIDFieldMigrationsJSONRequestBody defines body for PostCompanyUUIDFieldMigrations for application/json ContentType.
+type PostCompanyUUIDFieldMigrationsJSONRequestBody = FieldMigrationRequest
+
+// PostCompanyUUIDFieldMigrationsDryRunJSONRequestBody defines body for PostCompanyUUIDFieldMigrationsDryRun for application/json ContentType.
+type PostCompanyUUIDFieldMigrationsDryRunJSONRequestBody = FieldMigrationRequest
+
 // PostCompanyUUIDFieldsJSONRequestBody defines body for PostCompanyUUIDFields for application/json ContentType.
 type PostCompanyUUIDFieldsJSONRequestBody = ProjectFieldCreateRequest
 
@@ -396,6 +529,9 @@ type PostCompanyUUIDSmsSendJSONRequestBody PostCompanyUUIDSmsSendJSONBody
 // PostCompanyUUIDUserJSONRequestBody defines body for PostCompanyUUIDUser for application/json ContentType.
 type PostCompanyUUIDUserJSONRequestBody = CompanyAddUserRequest
 
+// PutCompanyUUIDWorkingHoursJSONRequestBody defines body for PutCompanyUUIDWorkingHours for application/json ContentType.
+type PutCompanyUUIDWorkingHoursJSONRequestBody = WorkingHoursDTO
+
 // PostFederationJSONRequestBody defines body for PostFederation for application/json ContentType.
 type PostFederationJSONRequestBody = FederationCreateRequest
 
@@ -411,6 +547,15 @@ type PostFederationUUIDAgentJSONRequestBody = AgentCreateRequest
 // PatchFederationUUIDAgentEntityUUIDJSONRequestBody defines body for PatchFederationUUIDAgentEntityUUID for application/json ContentType.
 type PatchFederationUUIDAgentEntityUUIDJSONRequestBody = AgentPatchRequest
 
+// PutFederationUUIDEmailBrandingJSONRequestBody defines body for PutFederationUUIDEmailBranding for application/json ContentType.
+type PutFederationUUIDEmailBrandingJSONRequestBody = EmailBrandingDTO
+
+// PutFederationUUIDEmailTemplatesJSONRequestBody defines body for PutFederationUUIDEmailTemplates for application/json ContentType.
+type PutFederationUUIDEmailTemplatesJSONRequestBody = PutFederationUUIDEmailTemplatesJSONBody
+
+// PostFederationUUIDEmailTemplatesPreviewJSONRequestBody defines body for PostFederationUUIDEmailTemplatesPreview for application/json ContentType.
+type PostFederationUUIDEmailTemplatesPreviewJSONRequestBody = PostFederationUUIDEmailTemplatesPreviewJSONBody
+
 // PostFederationUUIDInviteJSONRequestBody defines body for PostFederationUUIDInvite for application/json ContentType.
 type PostFederationUUIDInviteJSONRequestBody = InviteCreateRequest
 
@@ -450,6 +595,9 @@ type PostProjectUUIDFieldEntityUUIDJSONRequestBody PostProjectUUIDFieldEntityUUI
 // PatchProjectUUIDGraphJSONRequestBody defines body for PatchProjectUUIDGraph for application/json ContentType.
 type PatchProjectUUIDGraphJSONRequestBody PatchProjectUUIDGraphJSONBody
 
+// PutProjectUUIDIntakeJSONRequestBody defines body for PutProjectUUIDIntake for application/json ContentType.
+type PutProjectUUIDIntakeJSONRequestBody = PutProjectUUIDIntakeJSONBody
+
 // PatchProjectUUIDNameJSONRequestBody defines body for PatchProjectUUIDName for application/json ContentType.
 type PatchProjectUUIDNameJSONRequestBody = NameRequest
 
@@ -486,6 +634,21 @@ type ServerInterface interface {
 	// (GET /company/{UUID})
 	GetCompanyUUID(ctx echo.Context, uUID Uuid) error
 
+	// (GET /company/{UUID}/currency-rates)
+	GetCompanyUUIDCurrencyRates(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/currency-rates)
+	PostCompanyUUIDCurrencyRates(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/field-migrations)
+	PostCompanyUUIDFieldMigrations(ctx echo.Context, uUID Uuid) error
+
+	// (POST /company/{UUID}/field-migrations/dry-run)
+	PostCompanyUUIDFieldMigrationsDryRun(ctx echo.Context, uUID Uuid) error
+
+	// (GET /company/{UUID}/field-migrations/{entityUUID})
+	GetCompanyUUIDFieldMigrationsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (GET /company/{UUID}/fields)
 	GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error
 
@@ -513,6 +676,9 @@ type ServerInterface interface {
 	// (PATCH /company/{UUID}/name)
 	PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error
 
+	// (GET /company/{UUID}/notifications/deliveries)
+	GetCompanyUUIDNotificationsDeliveries(ctx echo.Context, uUID Uuid, params GetCompanyUUIDNotificationsDeliveriesParams) error
+
 	// (GET /company/{UUID}/priorities)
 	GetCompanyUUIDPriorities(ctx echo.Context, uUID Uuid) error
 
@@ -528,6 +694,9 @@ type ServerInterface interface {
 	// (GET /company/{UUID}/project/catalog/{entityName})
 	GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context, uUID Uuid, entityName EntityName) error
 
+	// (GET /company/{UUID}/relations/{entityUUID})
+	GetCompanyUUIDRelationsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (GET /company/{UUID}/sms)
 	GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error
 
@@ -546,6 +715,12 @@ type ServerInterface interface {
 	// (DELETE /company/{UUID}/user/{userUUID})
 	DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uuid, userUUID UserUUID) error
 
+	// (GET /company/{UUID}/working-hours)
+	GetCompanyUUIDWorkingHours(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /company/{UUID}/working-hours)
+	PutCompanyUUIDWorkingHours(ctx echo.Context, uUID Uuid) error
+
 	// (POST /federation)
 	PostFederation(ctx echo.Context) error
 	// Get all legal entities
@@ -579,6 +754,24 @@ type ServerInterface interface {
 	// (PATCH /federation/{UUID}/agent/{entityUUID})
 	PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /federation/{UUID}/email/branding)
+	GetFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /federation/{UUID}/email/branding)
+	PutFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error
+
+	// (DELETE /federation/{UUID}/email/templates)
+	DeleteFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid, params DeleteFederationUUIDEmailTemplatesParams) error
+
+	// (GET /federation/{UUID}/email/templates)
+	GetFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /federation/{UUID}/email/templates)
+	PutFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error
+
+	// (POST /federation/{UUID}/email/templates/preview)
+	PostFederationUUIDEmailTemplatesPreview(ctx echo.Context, uUID Uuid) error
+
 	// (GET /federation/{UUID}/invite)
 	GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error
 
@@ -663,6 +856,15 @@ type ServerInterface interface {
 	// (PATCH /project/{UUID}/graph)
 	PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) error
 
+	// (DELETE /project/{UUID}/intake)
+	DeleteProjectUUIDIntake(ctx echo.Context, uUID Uuid) error
+
+	// (GET /project/{UUID}/intake)
+	GetProjectUUIDIntake(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /project/{UUID}/intake)
+	PutProjectUUIDIntake(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /project/{UUID}/name)
 	PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error
 
@@ -755,6 +957,104 @@ func (w *ServerInterfaceWrapper) GetCompanyUUID(ctx echo.Context) error {
 	return err
 }
 
+// GetCompanyUUIDCurrencyRates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDCurrencyRates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDCurrencyRates(ctx, uUID)
+	return err
+}
+
+// PostCompanyUUIDCurrencyRates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDCurrencyRates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCompanyUUIDCurrencyRates(ctx, uUID)
+	return err
+}
+
+// PostCompanyUUIDFieldMigrations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDFieldMigrations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCompanyUUIDFieldMigrations(ctx, uUID)
+	return err
+}
+
+// PostCompanyUUIDFieldMigrationsDryRun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCompanyUUIDFieldMigrationsDryRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCompanyUUIDFieldMigrationsDryRun(ctx, uUID)
+	return err
+}
+
+// GetCompanyUUIDFieldMigrationsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDFieldMigrationsEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDFieldMigrationsEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
 // GetCompanyUUIDFields converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCompanyUUIDFields(ctx echo.Context) error {
 	var err error
@@ -949,6 +1249,54 @@ func (w *ServerInterfaceWrapper) PatchCompanyUUIDName(ctx echo.Context) error {
 	return err
 }
 
+// GetCompanyUUIDNotificationsDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDNotificationsDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyUUIDNotificationsDeliveriesParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "channel" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "channel", ctx.QueryParams(), &params.Channel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channel: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDNotificationsDeliveries(ctx, uUID, params)
+	return err
+}
+
 // GetCompanyUUIDPriorities converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCompanyUUIDPriorities(ctx echo.Context) error {
 	var err error
@@ -1063,6 +1411,32 @@ func (w *ServerInterfaceWrapper) GetCompanyUUIDProjectCatalogEntityName(ctx echo
 	return err
 }
 
+// GetCompanyUUIDRelationsEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDRelationsEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDRelationsEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
+
 // GetCompanyUUIDSms converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCompanyUUIDSms(ctx echo.Context) error {
 	var err error
@@ -1222,6 +1596,42 @@ func (w *ServerInterfaceWrapper) DeleteCompanyUUIDUserUserUUID(ctx echo.Context)
 	return err
 }
 
+// GetCompanyUUIDWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompanyUUIDWorkingHours(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCompanyUUIDWorkingHours(ctx, uUID)
+	return err
+}
+
+// PutCompanyUUIDWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCompanyUUIDWorkingHours(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCompanyUUIDWorkingHours(ctx, uUID)
+	return err
+}
+
 // PostFederation converts echo context to params.
 func (w *ServerInterfaceWrapper) PostFederation(ctx echo.Context) error {
 	var err error
@@ -1431,8 +1841,8 @@ func (w *ServerInterfaceWrapper) PatchFederationUUIDAgentEntityUUID(ctx echo.Con
 	return err
 }
 
-// GetFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
+// GetFederationUUIDEmailBranding converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDEmailBranding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1445,12 +1855,12 @@ func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
+	err = w.Handler.GetFederationUUIDEmailBranding(ctx, uUID)
 	return err
 }
 
-// PostFederationUUIDInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
+// PutFederationUUIDEmailBranding converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDEmailBranding(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1463,12 +1873,12 @@ func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) erro
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
+	err = w.Handler.PutFederationUUIDEmailBranding(ctx, uUID)
 	return err
 }
 
-// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
+// DeleteFederationUUIDEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDEmailTemplates(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -1478,20 +1888,151 @@ func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.C
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	// ------------- Path parameter "entityUUID" -------------
-	var entityUUID EntityUUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFederationUUIDEmailTemplatesParams
+	// ------------- Required query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "type", ctx.QueryParams(), &params.Type)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "lang" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, true, "lang", ctx.QueryParams(), &params.Lang)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lang: %s", err))
+	}
+
+	// ------------- Optional query parameter "company_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company_uuid", ctx.QueryParams(), &params.CompanyUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter company_uuid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDEmailTemplates(ctx, uUID, params)
+	return err
+}
+
+// GetFederationUUIDEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDEmailTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDEmailTemplates(ctx, uUID)
+	return err
+}
+
+// PutFederationUUIDEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFederationUUIDEmailTemplates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutFederationUUIDEmailTemplates(ctx, uUID)
+	return err
+}
+
+// PostFederationUUIDEmailTemplatesPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDEmailTemplatesPreview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDEmailTemplatesPreview(ctx, uUID)
+	return err
+}
+
+// GetFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederationUUIDInvite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederationUUIDInvite(ctx, uUID)
+	return err
+}
+
+// PostFederationUUIDInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFederationUUIDInvite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFederationUUIDInvite(ctx, uUID)
+	return err
+}
+
+// DeleteFederationUUIDInviteEntityUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFederationUUIDInviteEntityUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFederationUUIDInviteEntityUUID(ctx, uUID, entityUUID)
+	return err
+}
 
 // PatchFederationUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchFederationUUIDName(ctx echo.Context) error {
@@ -1985,6 +2526,60 @@ func (w *ServerInterfaceWrapper) PatchProjectUUIDGraph(ctx echo.Context) error {
 	return err
 }
 
+// DeleteProjectUUIDIntake converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectUUIDIntake(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectUUIDIntake(ctx, uUID)
+	return err
+}
+
+// GetProjectUUIDIntake converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectUUIDIntake(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectUUIDIntake(ctx, uUID)
+	return err
+}
+
+// PutProjectUUIDIntake converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProjectUUIDIntake(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProjectUUIDIntake(ctx, uUID)
+	return err
+}
+
 // PatchProjectUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchProjectUUIDName(ctx echo.Context) error {
 	var err error
@@ -2262,6 +2857,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/company", wrapper.PostCompany)
 	router.DELETE(baseURL+"/company/:UUID", wrapper.DeleteCompanyUUID)
 	router.GET(baseURL+"/company/:UUID", wrapper.GetCompanyUUID)
+	router.GET(baseURL+"/company/:UUID/currency-rates", wrapper.GetCompanyUUIDCurrencyRates)
+	router.POST(baseURL+"/company/:UUID/currency-rates", wrapper.PostCompanyUUIDCurrencyRates)
+	router.POST(baseURL+"/company/:UUID/field-migrations", wrapper.PostCompanyUUIDFieldMigrations)
+	router.POST(baseURL+"/company/:UUID/field-migrations/dry-run", wrapper.PostCompanyUUIDFieldMigrationsDryRun)
+	router.GET(baseURL+"/company/:UUID/field-migrations/:entityUUID", wrapper.GetCompanyUUIDFieldMigrationsEntityUUID)
 	router.GET(baseURL+"/company/:UUID/fields", wrapper.GetCompanyUUIDFields)
 	router.POST(baseURL+"/company/:UUID/fields", wrapper.PostCompanyUUIDFields)
 	router.DELETE(baseURL+"/company/:UUID/fields/:entityUUID", wrapper.DeleteCompanyUUIDFieldsEntityUUID)
@@ -2271,17 +2871,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/company/:UUID/group/:entityUUID", wrapper.DeleteCompanyUUIDGroupEntityUUID)
 	router.PATCH(baseURL+"/company/:UUID/group/:entityUUID", wrapper.PatchCompanyUUIDGroupEntityUUID)
 	router.PATCH(baseURL+"/company/:UUID/name", wrapper.PatchCompanyUUIDName)
+	router.GET(baseURL+"/company/:UUID/notifications/deliveries", wrapper.GetCompanyUUIDNotificationsDeliveries)
 	router.GET(baseURL+"/company/:UUID/priorities", wrapper.GetCompanyUUIDPriorities)
 	router.POST(baseURL+"/company/:UUID/priorities", wrapper.PostCompanyUUIDPriorities)
 	router.DELETE(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.DeleteCompanyUUIDPrioritiesEntityUUID)
 	router.PATCH(baseURL+"/company/:UUID/priorities/:entityUUID", wrapper.PatchCompanyUUIDPrioritiesEntityUUID)
 	router.GET(baseURL+"/company/:UUID/project/catalog/:entityName", wrapper.GetCompanyUUIDProjectCatalogEntityName)
+	router.GET(baseURL+"/company/:UUID/relations/:entityUUID", wrapper.GetCompanyUUIDRelationsEntityUUID)
 	router.GET(baseURL+"/company/:UUID/sms", wrapper.GetCompanyUUIDSms)
 	router.POST(baseURL+"/company/:UUID/sms/cost", wrapper.PostCompanyUUIDSmsCost)
 	router.POST(baseURL+"/company/:UUID/sms/options", wrapper.PostCompanyUUIDSmsOptions)
 	router.POST(baseURL+"/company/:UUID/sms/send", wrapper.PostCompanyUUIDSmsSend)
 	router.POST(baseURL+"/company/:UUID/user", wrapper.PostCompanyUUIDUser)
 	router.DELETE(baseURL+"/company/:UUID/user/:userUUID", wrapper.DeleteCompanyUUIDUserUserUUID)
+	router.GET(baseURL+"/company/:UUID/working-hours", wrapper.GetCompanyUUIDWorkingHours)
+	router.PUT(baseURL+"/company/:UUID/working-hours", wrapper.PutCompanyUUIDWorkingHours)
 	router.POST(baseURL+"/federation", wrapper.PostFederation)
 	router.GET(baseURL+"/federation/legal-entities", wrapper.GetAllLegalEntities)
 	router.POST(baseURL+"/federation/legal-entities", wrapper.СreateLegalEntity)
@@ -2293,6 +2897,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/federation/:UUID/agent", wrapper.PostFederationUUIDAgent)
 	router.DELETE(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.DeleteFederationUUIDAgentEntityUUID)
 	router.PATCH(baseURL+"/federation/:UUID/agent/:entityUUID", wrapper.PatchFederationUUIDAgentEntityUUID)
+	router.GET(baseURL+"/federation/:UUID/email/branding", wrapper.GetFederationUUIDEmailBranding)
+	router.PUT(baseURL+"/federation/:UUID/email/branding", wrapper.PutFederationUUIDEmailBranding)
+	router.DELETE(baseURL+"/federation/:UUID/email/templates", wrapper.DeleteFederationUUIDEmailTemplates)
+	router.GET(baseURL+"/federation/:UUID/email/templates", wrapper.GetFederationUUIDEmailTemplates)
+	router.PUT(baseURL+"/federation/:UUID/email/templates", wrapper.PutFederationUUIDEmailTemplates)
+	router.POST(baseURL+"/federation/:UUID/email/templates/preview", wrapper.PostFederationUUIDEmailTemplatesPreview)
 	router.GET(baseURL+"/federation/:UUID/invite", wrapper.GetFederationUUIDInvite)
 	router.POST(baseURL+"/federation/:UUID/invite", wrapper.PostFederationUUIDInvite)
 	router.DELETE(baseURL+"/federation/:UUID/invite/:entityUUID", wrapper.DeleteFederationUUIDInviteEntityUUID)
@@ -2321,6 +2931,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/project/:UUID/field/:entityUUID", wrapper.DeleteProjectUUIDFieldEntityUUID)
 	router.POST(baseURL+"/project/:UUID/field/:entityUUID", wrapper.PostProjectUUIDFieldEntityUUID)
 	router.PATCH(baseURL+"/project/:UUID/graph", wrapper.PatchProjectUUIDGraph)
+	router.DELETE(baseURL+"/project/:UUID/intake", wrapper.DeleteProjectUUIDIntake)
+	router.GET(baseURL+"/project/:UUID/intake", wrapper.GetProjectUUIDIntake)
+	router.PUT(baseURL+"/project/:UUID/intake", wrapper.PutProjectUUIDIntake)
 	router.PATCH(baseURL+"/project/:UUID/name", wrapper.PatchProjectUUIDName)
 	router.PATCH(baseURL+"/project/:UUID/options", wrapper.PatchProjectUUIDOptions)
 	router.GET(baseURL+"/project/:UUID/status", wrapper.GetProjectUUIDStatus)
@@ -2387,6 +3000,100 @@ func (response GetCompanyUUID200JSONResponse) VisitGetCompanyUUIDResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCompanyUUIDCurrencyRatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDCurrencyRatesResponseObject interface {
+	VisitGetCompanyUUIDCurrencyRatesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDCurrencyRates200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []CurrencyRateDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDCurrencyRates200JSONResponse) VisitGetCompanyUUIDCurrencyRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDCurrencyRatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDCurrencyRatesJSONRequestBody
+}
+
+type PostCompanyUUIDCurrencyRatesResponseObject interface {
+	VisitPostCompanyUUIDCurrencyRatesResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDCurrencyRates200JSONResponse struct {
+	Count int `json:"count"`
+}
+
+func (response PostCompanyUUIDCurrencyRates200JSONResponse) VisitPostCompanyUUIDCurrencyRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDFieldMigrationsRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDFieldMigrationsJSONRequestBody
+}
+
+type PostCompanyUUIDFieldMigrationsResponseObject interface {
+	VisitPostCompanyUUIDFieldMigrationsResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDFieldMigrations200JSONResponse FieldMigrationDTO
+
+func (response PostCompanyUUIDFieldMigrations200JSONResponse) VisitPostCompanyUUIDFieldMigrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCompanyUUIDFieldMigrationsDryRunRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostCompanyUUIDFieldMigrationsDryRunJSONRequestBody
+}
+
+type PostCompanyUUIDFieldMigrationsDryRunResponseObject interface {
+	VisitPostCompanyUUIDFieldMigrationsDryRunResponse(w http.ResponseWriter) error
+}
+
+type PostCompanyUUIDFieldMigrationsDryRun200JSONResponse FieldMigrationReportDTO
+
+func (response PostCompanyUUIDFieldMigrationsDryRun200JSONResponse) VisitPostCompanyUUIDFieldMigrationsDryRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyUUIDFieldMigrationsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCompanyUUIDFieldMigrationsEntityUUIDResponseObject interface {
+	VisitGetCompanyUUIDFieldMigrationsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDFieldMigrationsEntityUUID200JSONResponse FieldMigrationDTO
+
+func (response GetCompanyUUIDFieldMigrationsEntityUUID200JSONResponse) VisitGetCompanyUUIDFieldMigrationsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCompanyUUIDFieldsRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -2417,12 +3124,15 @@ type PostCompanyUUIDFieldsResponseObject interface {
 }
 
 type PostCompanyUUIDFields200JSONResponse struct {
-	Hash            string               `json:"hash"`
-	Icon            string               `json:"icon"`
-	Type            domain.FieldDataType `json:"type"`
-	TypeDescription string               `json:"type_description"`
-	TypeUuid        *openapi_types.UUID  `json:"type_uuid,omitempty"`
-	Uuid            string               `json:"uuid"`
+	Hash            string                   `json:"hash"`
+	Icon            string                   `json:"icon"`
+	Options         *[]CompanyFieldOptionDTO `json:"options,omitempty"`
+	Relation        *FieldRelationDTO        `json:"relation,omitempty"`
+	Rules           *FieldRules              `json:"rules,omitempty"`
+	Type            domain.FieldDataType     `json:"type"`
+	TypeDescription string                   `json:"type_description"`
+	TypeUuid        *openapi_types.UUID      `json:"type_uuid,omitempty"`
+	Uuid            string                   `json:"uuid"`
 }
 
 func (response PostCompanyUUIDFields200JSONResponse) VisitPostCompanyUUIDFieldsResponse(w http.ResponseWriter) error {
@@ -2557,6 +3267,28 @@ func (response PatchCompanyUUIDName200Response) VisitPatchCompanyUUIDNameRespons
 	return nil
 }
 
+type GetCompanyUUIDNotificationsDeliveriesRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetCompanyUUIDNotificationsDeliveriesParams
+}
+
+type GetCompanyUUIDNotificationsDeliveriesResponseObject interface {
+	VisitGetCompanyUUIDNotificationsDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDNotificationsDeliveries200JSONResponse struct {
+	Count int                       `json:"count"`
+	Items []NotificationDeliveryDTO `json:"items"`
+	Total int64                     `json:"total"`
+}
+
+func (response GetCompanyUUIDNotificationsDeliveries200JSONResponse) VisitGetCompanyUUIDNotificationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCompanyUUIDPrioritiesRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -2651,6 +3383,27 @@ func (response GetCompanyUUIDProjectCatalogEntityName200JSONResponse) VisitGetCo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCompanyUUIDRelationsEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetCompanyUUIDRelationsEntityUUIDResponseObject interface {
+	VisitGetCompanyUUIDRelationsEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDRelationsEntityUUID200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []RelationTaskDTO `json:"items"`
+}
+
+func (response GetCompanyUUIDRelationsEntityUUID200JSONResponse) VisitGetCompanyUUIDRelationsEntityUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCompanyUUIDSmsRequestObject struct {
 	UUID   Uuid `json:"UUID"`
 	Params GetCompanyUUIDSmsParams
@@ -2762,6 +3515,40 @@ func (response DeleteCompanyUUIDUserUserUUID200Response) VisitDeleteCompanyUUIDU
 	return nil
 }
 
+type GetCompanyUUIDWorkingHoursRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetCompanyUUIDWorkingHoursResponseObject interface {
+	VisitGetCompanyUUIDWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyUUIDWorkingHours200JSONResponse WorkingHoursDTO
+
+func (response GetCompanyUUIDWorkingHours200JSONResponse) VisitGetCompanyUUIDWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCompanyUUIDWorkingHoursRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutCompanyUUIDWorkingHoursJSONRequestBody
+}
+
+type PutCompanyUUIDWorkingHoursResponseObject interface {
+	VisitPutCompanyUUIDWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type PutCompanyUUIDWorkingHours200Response struct {
+}
+
+func (response PutCompanyUUIDWorkingHours200Response) VisitPutCompanyUUIDWorkingHoursResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type PostFederationRequestObject struct {
 	Body *PostFederationJSONRequestBody
 }
@@ -2837,129 +3624,242 @@ type UpdateLegalEntityResponseObject interface {
 	VisitUpdateLegalEntityResponse(w http.ResponseWriter) error
 }
 
-type UpdateLegalEntity200JSONResponse LegalEntityDTO
+type UpdateLegalEntity200JSONResponse LegalEntityDTO
+
+func (response UpdateLegalEntity200JSONResponse) VisitUpdateLegalEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteFederationUUIDResponseObject interface {
+	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUID200Response struct {
+}
+
+func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteFederationUUID401Response struct {
+}
+
+func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetFederationUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDResponseObject interface {
+	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUID200JSONResponse FederationDTO
+
+func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFederationUUIDAgentRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetFederationUUIDAgentParams
+}
+
+type GetFederationUUIDAgentResponseObject interface {
+	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDAgent200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []AgentDTO `json:"items"`
+	Total int64      `json:"total"`
+}
+
+func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFederationUUIDAgentRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDAgentJSONRequestBody
+}
+
+type PostFederationUUIDAgentResponseObject interface {
+	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+}
+
+type PostFederationUUIDAgent200JSONResponse UUIDResponse
+
+func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
+}
+
+type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
+	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchFederationUUIDAgentEntityUUID200Response struct {
+}
+
+func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetFederationUUIDEmailBrandingRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetFederationUUIDEmailBrandingResponseObject interface {
+	VisitGetFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error
+}
+
+type GetFederationUUIDEmailBranding200JSONResponse EmailBrandingDTO
 
-func (response UpdateLegalEntity200JSONResponse) VisitUpdateLegalEntityResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDEmailBranding200JSONResponse) VisitGetFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDRequestObject struct {
+type PutFederationUUIDEmailBrandingRequestObject struct {
 	UUID Uuid `json:"UUID"`
+	Body *PutFederationUUIDEmailBrandingJSONRequestBody
 }
 
-type DeleteFederationUUIDResponseObject interface {
-	VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error
+type PutFederationUUIDEmailBrandingResponseObject interface {
+	VisitPutFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUID200Response struct {
+type PutFederationUUIDEmailBranding200Response struct {
 }
 
-func (response DeleteFederationUUID200Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
+func (response PutFederationUUIDEmailBranding200Response) VisitPutFederationUUIDEmailBrandingResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type DeleteFederationUUID401Response struct {
-}
-
-func (response DeleteFederationUUID401Response) VisitDeleteFederationUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type DeleteFederationUUIDEmailTemplatesRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params DeleteFederationUUIDEmailTemplatesParams
 }
 
-type GetFederationUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+type DeleteFederationUUIDEmailTemplatesResponseObject interface {
+	VisitDeleteFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDResponseObject interface {
-	VisitGetFederationUUIDResponse(w http.ResponseWriter) error
+type DeleteFederationUUIDEmailTemplates200Response struct {
 }
 
-type GetFederationUUID200JSONResponse FederationDTO
-
-func (response GetFederationUUID200JSONResponse) VisitGetFederationUUIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteFederationUUIDEmailTemplates200Response) VisitDeleteFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetFederationUUIDAgentRequestObject struct {
-	UUID   Uuid `json:"UUID"`
-	Params GetFederationUUIDAgentParams
+type GetFederationUUIDEmailTemplatesRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type GetFederationUUIDAgentResponseObject interface {
-	VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error
+type GetFederationUUIDEmailTemplatesResponseObject interface {
+	VisitGetFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error
 }
 
-type GetFederationUUIDAgent200JSONResponse struct {
-	Count int        `json:"count"`
-	Items []AgentDTO `json:"items"`
-	Total int64      `json:"total"`
+type GetFederationUUIDEmailTemplates200JSONResponse struct {
+	Count     int                `json:"count"`
+	Items     []EmailTemplateDTO `json:"items"`
+	Languages []string           `json:"languages"`
+	Types     []string           `json:"types"`
 }
 
-func (response GetFederationUUIDAgent200JSONResponse) VisitGetFederationUUIDAgentResponse(w http.ResponseWriter) error {
+func (response GetFederationUUIDEmailTemplates200JSONResponse) VisitGetFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostFederationUUIDAgentRequestObject struct {
+type PutFederationUUIDEmailTemplatesRequestObject struct {
 	UUID Uuid `json:"UUID"`
-	Body *PostFederationUUIDAgentJSONRequestBody
+	Body *PutFederationUUIDEmailTemplatesJSONRequestBody
 }
 
-type PostFederationUUIDAgentResponseObject interface {
-	VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error
+type PutFederationUUIDEmailTemplatesResponseObject interface {
+	VisitPutFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error
 }
 
-type PostFederationUUIDAgent200JSONResponse UUIDResponse
+type PutFederationUUIDEmailTemplates200JSONResponse EmailTemplateDTO
 
-func (response PostFederationUUIDAgent200JSONResponse) VisitPostFederationUUIDAgentResponse(w http.ResponseWriter) error {
+func (response PutFederationUUIDEmailTemplates200JSONResponse) VisitPutFederationUUIDEmailTemplatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
+type PostFederationUUIDEmailTemplatesPreviewRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostFederationUUIDEmailTemplatesPreviewJSONRequestBody
 }
 
-type DeleteFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
+type PostFederationUUIDEmailTemplatesPreviewResponseObject interface {
+	VisitPostFederationUUIDEmailTemplatesPreviewResponse(w http.ResponseWriter) error
 }
 
-type DeleteFederationUUIDAgentEntityUUID200Response struct {
+type PostFederationUUIDEmailTemplatesPreview200JSONResponse struct {
+	Html       string `json:"html"`
+	SenderName string `json:"sender_name"`
+	Subject    string `json:"subject"`
 }
 
-func (response DeleteFederationUUIDAgentEntityUUID200Response) VisitDeleteFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
+func (response PostFederationUUIDEmailTemplatesPreview200JSONResponse) VisitPostFederationUUIDEmailTemplatesPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
-
-type PatchFederationUUIDAgentEntityUUIDRequestObject struct {
-	UUID       Uuid       `json:"UUID"`
-	EntityUUID EntityUUID `json:"entityUUID"`
-	Body       *PatchFederationUUIDAgentEntityUUIDJSONRequestBody
-}
-
-type PatchFederationUUIDAgentEntityUUIDResponseObject interface {
-	VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error
-}
 
-type PatchFederationUUIDAgentEntityUUID200Response struct {
-}
-
-func (response PatchFederationUUIDAgentEntityUUID200Response) VisitPatchFederationUUIDAgentEntityUUIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
 type GetFederationUUIDInviteRequestObject struct {
@@ -3468,6 +4368,57 @@ func (response PatchProjectUUIDGraph200JSONResponse) VisitPatchProjectUUIDGraphR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type DeleteProjectUUIDIntakeRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProjectUUIDIntakeResponseObject interface {
+	VisitDeleteProjectUUIDIntakeResponse(w http.ResponseWriter) error
+}
+
+type DeleteProjectUUIDIntake200Response struct {
+}
+
+func (response DeleteProjectUUIDIntake200Response) VisitDeleteProjectUUIDIntakeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProjectUUIDIntakeRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type GetProjectUUIDIntakeResponseObject interface {
+	VisitGetProjectUUIDIntakeResponse(w http.ResponseWriter) error
+}
+
+type GetProjectUUIDIntake200JSONResponse IntakeAddressDTO
+
+func (response GetProjectUUIDIntake200JSONResponse) VisitGetProjectUUIDIntakeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProjectUUIDIntakeRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutProjectUUIDIntakeJSONRequestBody
+}
+
+type PutProjectUUIDIntakeResponseObject interface {
+	VisitPutProjectUUIDIntakeResponse(w http.ResponseWriter) error
+}
+
+type PutProjectUUIDIntake200JSONResponse IntakeAddressDTO
+
+func (response PutProjectUUIDIntake200JSONResponse) VisitPutProjectUUIDIntakeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchProjectUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchProjectUUIDNameJSONRequestBody
@@ -3712,6 +4663,21 @@ type StrictServerInterface interface {
 	// (GET /company/{UUID})
 	GetCompanyUUID(ctx context.Context, request GetCompanyUUIDRequestObject) (GetCompanyUUIDResponseObject, error)
 
+	// (GET /company/{UUID}/currency-rates)
+	GetCompanyUUIDCurrencyRates(ctx context.Context, request GetCompanyUUIDCurrencyRatesRequestObject) (GetCompanyUUIDCurrencyRatesResponseObject, error)
+
+	// (POST /company/{UUID}/currency-rates)
+	PostCompanyUUIDCurrencyRates(ctx context.Context, request PostCompanyUUIDCurrencyRatesRequestObject) (PostCompanyUUIDCurrencyRatesResponseObject, error)
+
+	// (POST /company/{UUID}/field-migrations)
+	PostCompanyUUIDFieldMigrations(ctx context.Context, request PostCompanyUUIDFieldMigrationsRequestObject) (PostCompanyUUIDFieldMigrationsResponseObject, error)
+
+	// (POST /company/{UUID}/field-migrations/dry-run)
+	PostCompanyUUIDFieldMigrationsDryRun(ctx context.Context, request PostCompanyUUIDFieldMigrationsDryRunRequestObject) (PostCompanyUUIDFieldMigrationsDryRunResponseObject, error)
+
+	// (GET /company/{UUID}/field-migrations/{entityUUID})
+	GetCompanyUUIDFieldMigrationsEntityUUID(ctx context.Context, request GetCompanyUUIDFieldMigrationsEntityUUIDRequestObject) (GetCompanyUUIDFieldMigrationsEntityUUIDResponseObject, error)
+
 	// (GET /company/{UUID}/fields)
 	GetCompanyUUIDFields(ctx context.Context, request GetCompanyUUIDFieldsRequestObject) (GetCompanyUUIDFieldsResponseObject, error)
 
@@ -3739,6 +4705,9 @@ type StrictServerInterface interface {
 	// (PATCH /company/{UUID}/name)
 	PatchCompanyUUIDName(ctx context.Context, request PatchCompanyUUIDNameRequestObject) (PatchCompanyUUIDNameResponseObject, error)
 
+	// (GET /company/{UUID}/notifications/deliveries)
+	GetCompanyUUIDNotificationsDeliveries(ctx context.Context, request GetCompanyUUIDNotificationsDeliveriesRequestObject) (GetCompanyUUIDNotificationsDeliveriesResponseObject, error)
+
 	// (GET /company/{UUID}/priorities)
 	GetCompanyUUIDPriorities(ctx context.Context, request GetCompanyUUIDPrioritiesRequestObject) (GetCompanyUUIDPrioritiesResponseObject, error)
 
@@ -3754,6 +4723,9 @@ type StrictServerInterface interface {
 	// (GET /company/{UUID}/project/catalog/{entityName})
 	GetCompanyUUIDProjectCatalogEntityName(ctx context.Context, request GetCompanyUUIDProjectCatalogEntityNameRequestObject) (GetCompanyUUIDProjectCatalogEntityNameResponseObject, error)
 
+	// (GET /company/{UUID}/relations/{entityUUID})
+	GetCompanyUUIDRelationsEntityUUID(ctx context.Context, request GetCompanyUUIDRelationsEntityUUIDRequestObject) (GetCompanyUUIDRelationsEntityUUIDResponseObject, error)
+
 	// (GET /company/{UUID}/sms)
 	GetCompanyUUIDSms(ctx context.Context, request GetCompanyUUIDSmsRequestObject) (GetCompanyUUIDSmsResponseObject, error)
 
@@ -3772,6 +4744,12 @@ type StrictServerInterface interface {
 	// (DELETE /company/{UUID}/user/{userUUID})
 	DeleteCompanyUUIDUserUserUUID(ctx context.Context, request DeleteCompanyUUIDUserUserUUIDRequestObject) (DeleteCompanyUUIDUserUserUUIDResponseObject, error)
 
+	// (GET /company/{UUID}/working-hours)
+	GetCompanyUUIDWorkingHours(ctx context.Context, request GetCompanyUUIDWorkingHoursRequestObject) (GetCompanyUUIDWorkingHoursResponseObject, error)
+
+	// (PUT /company/{UUID}/working-hours)
+	PutCompanyUUIDWorkingHours(ctx context.Context, request PutCompanyUUIDWorkingHoursRequestObject) (PutCompanyUUIDWorkingHoursResponseObject, error)
+
 	// (POST /federation)
 	PostFederation(ctx context.Context, request PostFederationRequestObject) (PostFederationResponseObject, error)
 	// Get all legal entities
@@ -3805,6 +4783,24 @@ type StrictServerInterface interface {
 	// (PATCH /federation/{UUID}/agent/{entityUUID})
 	PatchFederationUUIDAgentEntityUUID(ctx context.Context, request PatchFederationUUIDAgentEntityUUIDRequestObject) (PatchFederationUUIDAgentEntityUUIDResponseObject, error)
 
+	// (GET /federation/{UUID}/email/branding)
+	GetFederationUUIDEmailBranding(ctx context.Context, request GetFederationUUIDEmailBrandingRequestObject) (GetFederationUUIDEmailBrandingResponseObject, error)
+
+	// (PUT /federation/{UUID}/email/branding)
+	PutFederationUUIDEmailBranding(ctx context.Context, request PutFederationUUIDEmailBrandingRequestObject) (PutFederationUUIDEmailBrandingResponseObject, error)
+
+	// (DELETE /federation/{UUID}/email/templates)
+	DeleteFederationUUIDEmailTemplates(ctx context.Context, request DeleteFederationUUIDEmailTemplatesRequestObject) (DeleteFederationUUIDEmailTemplatesResponseObject, error)
+
+	// (GET /federation/{UUID}/email/templates)
+	GetFederationUUIDEmailTemplates(ctx context.Context, request GetFederationUUIDEmailTemplatesRequestObject) (GetFederationUUIDEmailTemplatesResponseObject, error)
+
+	// (PUT /federation/{UUID}/email/templates)
+	PutFederationUUIDEmailTemplates(ctx context.Context, request PutFederationUUIDEmailTemplatesRequestObject) (PutFederationUUIDEmailTemplatesResponseObject, error)
+
+	// (POST /federation/{UUID}/email/templates/preview)
+	PostFederationUUIDEmailTemplatesPreview(ctx context.Context, request PostFederationUUIDEmailTemplatesPreviewRequestObject) (PostFederationUUIDEmailTemplatesPreviewResponseObject, error)
+
 	// (GET /federation/{UUID}/invite)
 	GetFederationUUIDInvite(ctx context.Context, request GetFederationUUIDInviteRequestObject) (GetFederationUUIDInviteResponseObject, error)
 
@@ -3889,6 +4885,15 @@ type StrictServerInterface interface {
 	// (PATCH /project/{UUID}/graph)
 	PatchProjectUUIDGraph(ctx context.Context, request PatchProjectUUIDGraphRequestObject) (PatchProjectUUIDGraphResponseObject, error)
 
+	// (DELETE /project/{UUID}/intake)
+	DeleteProjectUUIDIntake(ctx context.Context, request DeleteProjectUUIDIntakeRequestObject) (DeleteProjectUUIDIntakeResponseObject, error)
+
+	// (GET /project/{UUID}/intake)
+	GetProjectUUIDIntake(ctx context.Context, request GetProjectUUIDIntakeRequestObject) (GetProjectUUIDIntakeResponseObject, error)
+
+	// (PUT /project/{UUID}/intake)
+	PutProjectUUIDIntake(ctx context.Context, request PutProjectUUIDIntakeRequestObject) (PutProjectUUIDIntakeResponseObject, error)
+
 	// (PATCH /project/{UUID}/name)
 	PatchProjectUUIDName(ctx context.Context, request PatchProjectUUIDNameRequestObject) (PatchProjectUUIDNameResponseObject, error)
 
@@ -4020,6 +5025,150 @@ func (sh *strictHandler) GetCompanyUUID(ctx echo.Context, uUID Uuid) error {
 	return nil
 }
 
+// GetCompanyUUIDCurrencyRates operation middleware
+func (sh *strictHandler) GetCompanyUUIDCurrencyRates(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDCurrencyRatesRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDCurrencyRates(ctx.Request().Context(), request.(GetCompanyUUIDCurrencyRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDCurrencyRates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDCurrencyRatesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDCurrencyRatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDCurrencyRates operation middleware
+func (sh *strictHandler) PostCompanyUUIDCurrencyRates(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDCurrencyRatesRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDCurrencyRatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDCurrencyRates(ctx.Request().Context(), request.(PostCompanyUUIDCurrencyRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDCurrencyRates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDCurrencyRatesResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDCurrencyRatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDFieldMigrations operation middleware
+func (sh *strictHandler) PostCompanyUUIDFieldMigrations(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDFieldMigrationsRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDFieldMigrationsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDFieldMigrations(ctx.Request().Context(), request.(PostCompanyUUIDFieldMigrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDFieldMigrations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDFieldMigrationsResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDFieldMigrationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCompanyUUIDFieldMigrationsDryRun operation middleware
+func (sh *strictHandler) PostCompanyUUIDFieldMigrationsDryRun(ctx echo.Context, uUID Uuid) error {
+	var request PostCompanyUUIDFieldMigrationsDryRunRequestObject
+
+	request.UUID = uUID
+
+	var body PostCompanyUUIDFieldMigrationsDryRunJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCompanyUUIDFieldMigrationsDryRun(ctx.Request().Context(), request.(PostCompanyUUIDFieldMigrationsDryRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCompanyUUIDFieldMigrationsDryRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCompanyUUIDFieldMigrationsDryRunResponseObject); ok {
+		return validResponse.VisitPostCompanyUUIDFieldMigrationsDryRunResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDFieldMigrationsEntityUUID operation middleware
+func (sh *strictHandler) GetCompanyUUIDFieldMigrationsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCompanyUUIDFieldMigrationsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDFieldMigrationsEntityUUID(ctx.Request().Context(), request.(GetCompanyUUIDFieldMigrationsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDFieldMigrationsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDFieldMigrationsEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDFieldMigrationsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetCompanyUUIDFields operation middleware
 func (sh *strictHandler) GetCompanyUUIDFields(ctx echo.Context, uUID Uuid) error {
 	var request GetCompanyUUIDFieldsRequestObject
@@ -4252,27 +5401,53 @@ func (sh *strictHandler) PatchCompanyUUIDGroupEntityUUID(ctx echo.Context, uUID
 func (sh *strictHandler) PatchCompanyUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchCompanyUUIDNameRequestObject
 
-	request.UUID = uUID
+	request.UUID = uUID
+
+	var body PatchCompanyUUIDNameJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCompanyUUIDName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
+		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyUUIDNotificationsDeliveries operation middleware
+func (sh *strictHandler) GetCompanyUUIDNotificationsDeliveries(ctx echo.Context, uUID Uuid, params GetCompanyUUIDNotificationsDeliveriesParams) error {
+	var request GetCompanyUUIDNotificationsDeliveriesRequestObject
 
-	var body PatchCompanyUUIDNameJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCompanyUUIDName(ctx.Request().Context(), request.(PatchCompanyUUIDNameRequestObject))
+		return sh.ssi.GetCompanyUUIDNotificationsDeliveries(ctx.Request().Context(), request.(GetCompanyUUIDNotificationsDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCompanyUUIDName")
+		handler = middleware(handler, "GetCompanyUUIDNotificationsDeliveries")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCompanyUUIDNameResponseObject); ok {
-		return validResponse.VisitPatchCompanyUUIDNameResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetCompanyUUIDNotificationsDeliveriesResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDNotificationsDeliveriesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -4419,6 +5594,32 @@ func (sh *strictHandler) GetCompanyUUIDProjectCatalogEntityName(ctx echo.Context
 	return nil
 }
 
+// GetCompanyUUIDRelationsEntityUUID operation middleware
+func (sh *strictHandler) GetCompanyUUIDRelationsEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetCompanyUUIDRelationsEntityUUIDRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDRelationsEntityUUID(ctx.Request().Context(), request.(GetCompanyUUIDRelationsEntityUUIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDRelationsEntityUUID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDRelationsEntityUUIDResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDRelationsEntityUUIDResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetCompanyUUIDSms operation middleware
 func (sh *strictHandler) GetCompanyUUIDSms(ctx echo.Context, uUID Uuid, params GetCompanyUUIDSmsParams) error {
 	var request GetCompanyUUIDSmsRequestObject
@@ -4596,6 +5797,62 @@ func (sh *strictHandler) DeleteCompanyUUIDUserUserUUID(ctx echo.Context, uUID Uu
 	return nil
 }
 
+// GetCompanyUUIDWorkingHours operation middleware
+func (sh *strictHandler) GetCompanyUUIDWorkingHours(ctx echo.Context, uUID Uuid) error {
+	var request GetCompanyUUIDWorkingHoursRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyUUIDWorkingHours(ctx.Request().Context(), request.(GetCompanyUUIDWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyUUIDWorkingHours")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCompanyUUIDWorkingHoursResponseObject); ok {
+		return validResponse.VisitGetCompanyUUIDWorkingHoursResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutCompanyUUIDWorkingHours operation middleware
+func (sh *strictHandler) PutCompanyUUIDWorkingHours(ctx echo.Context, uUID Uuid) error {
+	var request PutCompanyUUIDWorkingHoursRequestObject
+
+	request.UUID = uUID
+
+	var body PutCompanyUUIDWorkingHoursJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCompanyUUIDWorkingHours(ctx.Request().Context(), request.(PutCompanyUUIDWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCompanyUUIDWorkingHours")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCompanyUUIDWorkingHoursResponseObject); ok {
+		return validResponse.VisitPutCompanyUUIDWorkingHoursResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostFederation operation middleware
 func (sh *strictHandler) PostFederation(ctx echo.Context) error {
 	var request PostFederationRequestObject
@@ -4898,6 +6155,175 @@ func (sh *strictHandler) PatchFederationUUIDAgentEntityUUID(ctx echo.Context, uU
 	return nil
 }
 
+// GetFederationUUIDEmailBranding operation middleware
+func (sh *strictHandler) GetFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDEmailBrandingRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDEmailBranding(ctx.Request().Context(), request.(GetFederationUUIDEmailBrandingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDEmailBranding")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDEmailBrandingResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDEmailBrandingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutFederationUUIDEmailBranding operation middleware
+func (sh *strictHandler) PutFederationUUIDEmailBranding(ctx echo.Context, uUID Uuid) error {
+	var request PutFederationUUIDEmailBrandingRequestObject
+
+	request.UUID = uUID
+
+	var body PutFederationUUIDEmailBrandingJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutFederationUUIDEmailBranding(ctx.Request().Context(), request.(PutFederationUUIDEmailBrandingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutFederationUUIDEmailBranding")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutFederationUUIDEmailBrandingResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDEmailBrandingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteFederationUUIDEmailTemplates operation middleware
+func (sh *strictHandler) DeleteFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid, params DeleteFederationUUIDEmailTemplatesParams) error {
+	var request DeleteFederationUUIDEmailTemplatesRequestObject
+
+	request.UUID = uUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFederationUUIDEmailTemplates(ctx.Request().Context(), request.(DeleteFederationUUIDEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFederationUUIDEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteFederationUUIDEmailTemplatesResponseObject); ok {
+		return validResponse.VisitDeleteFederationUUIDEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFederationUUIDEmailTemplates operation middleware
+func (sh *strictHandler) GetFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error {
+	var request GetFederationUUIDEmailTemplatesRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFederationUUIDEmailTemplates(ctx.Request().Context(), request.(GetFederationUUIDEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFederationUUIDEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFederationUUIDEmailTemplatesResponseObject); ok {
+		return validResponse.VisitGetFederationUUIDEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutFederationUUIDEmailTemplates operation middleware
+func (sh *strictHandler) PutFederationUUIDEmailTemplates(ctx echo.Context, uUID Uuid) error {
+	var request PutFederationUUIDEmailTemplatesRequestObject
+
+	request.UUID = uUID
+
+	var body PutFederationUUIDEmailTemplatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutFederationUUIDEmailTemplates(ctx.Request().Context(), request.(PutFederationUUIDEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutFederationUUIDEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutFederationUUIDEmailTemplatesResponseObject); ok {
+		return validResponse.VisitPutFederationUUIDEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostFederationUUIDEmailTemplatesPreview operation middleware
+func (sh *strictHandler) PostFederationUUIDEmailTemplatesPreview(ctx echo.Context, uUID Uuid) error {
+	var request PostFederationUUIDEmailTemplatesPreviewRequestObject
+
+	request.UUID = uUID
+
+	var body PostFederationUUIDEmailTemplatesPreviewJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFederationUUIDEmailTemplatesPreview(ctx.Request().Context(), request.(PostFederationUUIDEmailTemplatesPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFederationUUIDEmailTemplatesPreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFederationUUIDEmailTemplatesPreviewResponseObject); ok {
+		return validResponse.VisitPostFederationUUIDEmailTemplatesPreviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetFederationUUIDInvite operation middleware
 func (sh *strictHandler) GetFederationUUIDInvite(ctx echo.Context, uUID Uuid) error {
 	var request GetFederationUUIDInviteRequestObject
@@ -5677,6 +7103,87 @@ func (sh *strictHandler) PatchProjectUUIDGraph(ctx echo.Context, uUID Uuid) erro
 	return nil
 }
 
+// DeleteProjectUUIDIntake operation middleware
+func (sh *strictHandler) DeleteProjectUUIDIntake(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProjectUUIDIntakeRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProjectUUIDIntake(ctx.Request().Context(), request.(DeleteProjectUUIDIntakeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProjectUUIDIntake")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProjectUUIDIntakeResponseObject); ok {
+		return validResponse.VisitDeleteProjectUUIDIntakeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProjectUUIDIntake operation middleware
+func (sh *strictHandler) GetProjectUUIDIntake(ctx echo.Context, uUID Uuid) error {
+	var request GetProjectUUIDIntakeRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectUUIDIntake(ctx.Request().Context(), request.(GetProjectUUIDIntakeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectUUIDIntake")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProjectUUIDIntakeResponseObject); ok {
+		return validResponse.VisitGetProjectUUIDIntakeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutProjectUUIDIntake operation middleware
+func (sh *strictHandler) PutProjectUUIDIntake(ctx echo.Context, uUID Uuid) error {
+	var request PutProjectUUIDIntakeRequestObject
+
+	request.UUID = uUID
+
+	var body PutProjectUUIDIntakeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProjectUUIDIntake(ctx.Request().Context(), request.(PutProjectUUIDIntakeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProjectUUIDIntake")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutProjectUUIDIntakeResponseObject); ok {
+		return validResponse.VisitPutProjectUUIDIntakeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchProjectUUIDName operation middleware
 func (sh *strictHandler) PatchProjectUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchProjectUUIDNameRequestObject
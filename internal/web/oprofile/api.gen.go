@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"time"
@@ -38,12 +39,21 @@ const (
 	PostProfileLikeJSONBodyTypeTask       PostProfileLikeJSONBodyType = "task"
 )
 
+// CalendarEventDTO defines model for CalendarEventDTO.
+type CalendarEventDTO = dto.CalendarEventDTO
+
 // CompanyDTO defines model for CompanyDTO.
 type CompanyDTO = dto.CompanyDTO
 
 // CompanyDTOs defines model for CompanyDTOs.
 type CompanyDTOs = dto.CompanyDTOs
 
+// EmailDTO defines model for EmailDTO.
+type EmailDTO = dto.EmailDTO
+
+// EmailTemplateDTO defines model for EmailTemplateDTO.
+type EmailTemplateDTO = dto.EmailTemplateDTO
+
 // FederationDTO defines model for FederationDTO.
 type FederationDTO = dto.FederationDTO
 
@@ -53,9 +63,27 @@ type FederationDTOs = dto.FederationDTOs
 // InviteDTO defines model for InviteDTO.
 type InviteDTO = dto.InviteDTO
 
+// MailDTO defines model for MailDTO.
+type MailDTO = dto.MailDTO
+
+// MentionDTO defines model for MentionDTO.
+type MentionDTO = dto.MentionDTO
+
+// NotificationDeliveryDTO defines model for NotificationDeliveryDTO.
+type NotificationDeliveryDTO = dto.NotificationDeliveryDTO
+
+// NotificationHistoryDTO defines model for NotificationHistoryDTO.
+type NotificationHistoryDTO = dto.NotificationHistoryDTO
+
+// NotificationMatrixDTO defines model for NotificationMatrixDTO.
+type NotificationMatrixDTO = dto.NotificationMatrixDTO
+
 // NotificationReminderDTO defines model for NotificationReminderDTO.
 type NotificationReminderDTO = dto.NotificationReminderDTO
 
+// NotificationSettingsDTO defines model for NotificationSettingsDTO.
+type NotificationSettingsDTO = dto.NotificationSettingsDTO
+
 // NotificationTaskDTO defines model for NotificationTaskDTO.
 type NotificationTaskDTO = dto.NotificationTaskDTO
 
@@ -131,6 +159,12 @@ type ProfileValidateSimpleRequest struct {
 // ProjectDTOs defines model for ProjectDTOs.
 type ProjectDTOs = dto.ProjectDTOs
 
+// QuietHoursDTO defines model for QuietHoursDTO.
+type QuietHoursDTO = dto.QuietHoursDTO
+
+// SentEmailDTO defines model for SentEmailDTO.
+type SentEmailDTO = dto.SentEmailDTO
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Uuid openapi_types.UUID `json:"uuid"`
@@ -139,17 +173,78 @@ type UUIDResponse struct {
 // UserDTO defines model for UserDTO.
 type UserDTO = dto.UserDTO
 
+// UserEmailDTO defines model for UserEmailDTO.
+type UserEmailDTO = dto.UserEmailDTO
+
 // EntityUUID defines model for entityUUID.
 type EntityUUID = openapi_types.UUID
 
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// DeleteProfileAdminEmailTemplatesParams defines parameters for DeleteProfileAdminEmailTemplates.
+type DeleteProfileAdminEmailTemplatesParams struct {
+	Type string `form:"type" json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang string `form:"lang" json:"lang" validate:"oneof=ru en"`
+}
+
+// PutProfileAdminEmailTemplatesJSONBody defines parameters for PutProfileAdminEmailTemplates.
+type PutProfileAdminEmailTemplatesJSONBody struct {
+	Type    string `json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang    string `json:"lang" validate:"oneof=ru en"`
+	Subject string `json:"subject" validate:"required,max=200"`
+	Body    string `json:"body" validate:"required,max=100000"`
+}
+
+// PostProfileAdminEmailTemplatesPreviewJSONBody defines parameters for PostProfileAdminEmailTemplatesPreview.
+type PostProfileAdminEmailTemplatesPreviewJSONBody struct {
+	Type string `json:"type" validate:"oneof=email_confirmation email_reset email_digest email_notification"`
+	Lang string `json:"lang" validate:"oneof=ru en"`
+	// Subject Empty subject and body - preview of the effective template
+	Subject *string `json:"subject,omitempty" validate:"omitempty,max=200"`
+	Body    *string `json:"body,omitempty" validate:"omitempty,max=100000"`
+}
+
+// GetProfileAdminMailsParams defines parameters for GetProfileAdminMails.
+type GetProfileAdminMailsParams struct {
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+	Status *string `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,oneof=pending sending sent dead"`
+	To     *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetProfileCalendarEventsParams defines parameters for GetProfileCalendarEvents.
+type GetProfileCalendarEventsParams struct {
+	From        time.Time             `form:"from" json:"from"`
+	To          time.Time             `form:"to" json:"to"`
+	ProjectUuid *openapi_types.UUID   `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+	Type        *[]string             `form:"type,omitempty" json:"type,omitempty" validate:"omitempty,dive,oneof=reminder deadline finished sms"`
+	People      *[]openapi_types.UUID `form:"people,omitempty" json:"people,omitempty"`
+}
+
+// GetProfileCalendarFeedParams defines parameters for GetProfileCalendarFeed.
+type GetProfileCalendarFeedParams struct {
+	Token       string              `form:"token" json:"token"`
+	ProjectUuid *openapi_types.UUID `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+}
+
 // PatchProfileColorJSONBody defines parameters for PatchProfileColor.
 type PatchProfileColorJSONBody struct {
 	Color string `json:"color" validate:"color"`
 }
 
+// GetProfileDigestUnsubscribeParams defines parameters for GetProfileDigestUnsubscribe.
+type GetProfileDigestUnsubscribeParams struct {
+	Email string `form:"email" json:"email"`
+	Token string `form:"token" json:"token"`
+}
+
+// PostProfileDigestUnsubscribeParams defines parameters for PostProfileDigestUnsubscribe.
+type PostProfileDigestUnsubscribeParams struct {
+	Email string `form:"email" json:"email"`
+	Token string `form:"token" json:"token"`
+}
+
 // PostProfileDislikeJSONBody defines parameters for PostProfileDislike.
 type PostProfileDislikeJSONBody struct {
 	Type PostProfileDislikeJSONBodyType `json:"type"`
@@ -175,6 +270,84 @@ type PostProfileLikeJSONBody struct {
 // PostProfileLikeJSONBodyType defines parameters for PostProfileLike.
 type PostProfileLikeJSONBodyType string
 
+// PostProfileMailboxesJSONBody defines parameters for PostProfileMailboxes.
+type PostProfileMailboxesJSONBody struct {
+	// Domain Mail service domain, imap.<domain> and smtp.<domain> are used. Empty - domain of the email
+	Domain   *string `json:"domain,omitempty" validate:"omitempty,fqdn,max=100"`
+	Email    string  `json:"email" validate:"required,email,max=100"`
+	Password string  `json:"password" validate:"required,max=200"`
+}
+
+// GetProfileMailboxesEmailsParams defines parameters for GetProfileMailboxesEmails.
+type GetProfileMailboxesEmailsParams struct {
+	MailboxUuid *openapi_types.UUID `form:"mailbox_uuid,omitempty" json:"mailbox_uuid,omitempty"`
+	AgentUuid   *openapi_types.UUID `form:"agent_uuid,omitempty" json:"agent_uuid,omitempty"`
+	TaskUuid    *openapi_types.UUID `form:"task_uuid,omitempty" json:"task_uuid,omitempty"`
+	Offset      *int                `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchProfileMailboxesUUIDJSONBody defines parameters for PatchProfileMailboxesUUID.
+type PatchProfileMailboxesUUIDJSONBody struct {
+	Domain   *string `json:"domain,omitempty" validate:"omitempty,fqdn,max=100"`
+	Password *string `json:"password,omitempty" validate:"omitempty,max=200"`
+
+	// Status 1 - active, 0 - paused
+	Status *int `json:"status,omitempty" validate:"omitempty,oneof=0 1"`
+}
+
+// PostProfileMailboxesUUIDSendJSONBody defines parameters for PostProfileMailboxesUUIDSend.
+type PostProfileMailboxesUUIDSendJSONBody struct {
+	Body           string `json:"body" validate:"required,max=100000"`
+	RecipientEmail string `json:"recipient_email" validate:"required,email,max=100"`
+	Subject        string `json:"subject" validate:"required,max=200"`
+}
+
+// GetProfileMailboxesUUIDSentParams defines parameters for GetProfileMailboxesUUIDSent.
+type GetProfileMailboxesUUIDSentParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetProfileMentionsParams defines parameters for GetProfileMentions.
+type GetProfileMentionsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetProfileNotificationsDeliveriesParams defines parameters for GetProfileNotificationsDeliveries.
+type GetProfileNotificationsDeliveriesParams struct {
+	Offset  *int    `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit   *int    `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+	Status  *string `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,oneof=pending sending sent failed"`
+	Channel *string `form:"channel,omitempty" json:"channel,omitempty" validate:"omitempty,oneof=email sms"`
+}
+
+// GetProfileNotificationsHistoryParams defines parameters for GetProfileNotificationsHistory.
+type GetProfileNotificationsHistoryParams struct {
+	Offset      *int                `form:"offset,omitempty" json:"offset,omitempty" validate:"omitempty,min=0"`
+	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty" validate:"omitempty,min=1,max=200"`
+	Status      *string             `form:"status,omitempty" json:"status,omitempty" validate:"omitempty,oneof=unread read"`
+	Starred     *bool               `form:"starred,omitempty" json:"starred,omitempty"`
+	Hidden      *bool               `form:"hidden,omitempty" json:"hidden,omitempty"`
+	Type        *string             `form:"type,omitempty" json:"type,omitempty" validate:"omitempty,oneof=task reminder"`
+	ProjectUuid *openapi_types.UUID `form:"project_uuid,omitempty" json:"project_uuid,omitempty"`
+	Search      *string             `form:"search,omitempty" json:"search,omitempty" validate:"omitempty,max=100"`
+}
+
+// PutProfileNotificationsSettingsJSONBody defines parameters for PutProfileNotificationsSettings.
+type PutProfileNotificationsSettingsJSONBody struct {
+	Digest     *string               `json:"digest,omitempty" validate:"omitempty,oneof=off hourly daily weekly"`
+	Events     NotificationMatrixDTO `json:"events"`
+	QuietHours *QuietHoursDTO        `json:"quiet_hours,omitempty"`
+}
+
+// PutProfileNotificationsSettingsProjectUUIDJSONBody defines parameters for PutProfileNotificationsSettingsProjectUUID.
+type PutProfileNotificationsSettingsProjectUUIDJSONBody struct {
+	Events *NotificationMatrixDTO `json:"events,omitempty"`
+	Muted  bool                   `json:"muted"`
+}
+
 // PatchProfilePhoneJSONBody defines parameters for PatchProfilePhone.
 type PatchProfilePhoneJSONBody struct {
 	Phone int `json:"phone" validate:"trim,min=10000000000,max=9999999999999"`
@@ -187,12 +360,20 @@ type PatchProfilePhotoMultipartBody struct {
 
 // PatchProfilePreferencesJSONBody defines parameters for PatchProfilePreferences.
 type PatchProfilePreferencesJSONBody struct {
+	// Language Language of emails
+	Language *string `json:"language,omitempty" validate:"omitempty,oneof=ru en"`
 	Timezone *string `json:"timezone,omitempty"`
 }
 
 // PostProfileJSONRequestBody defines body for PostProfile for application/json ContentType.
 type PostProfileJSONRequestBody = ProfileRegisterRequest
 
+// PutProfileAdminEmailTemplatesJSONRequestBody defines body for PutProfileAdminEmailTemplates for application/json ContentType.
+type PutProfileAdminEmailTemplatesJSONRequestBody = PutProfileAdminEmailTemplatesJSONBody
+
+// PostProfileAdminEmailTemplatesPreviewJSONRequestBody defines body for PostProfileAdminEmailTemplatesPreview for application/json ContentType.
+type PostProfileAdminEmailTemplatesPreviewJSONRequestBody = PostProfileAdminEmailTemplatesPreviewJSONBody
+
 // PatchProfileColorJSONRequestBody defines body for PatchProfileColor for application/json ContentType.
 type PatchProfileColorJSONRequestBody PatchProfileColorJSONBody
 
@@ -211,6 +392,21 @@ type PostProfileLoginJSONRequestBody = ProfileLoginRequest
 // PostProfileLoginAsJSONRequestBody defines body for PostProfileLoginAs for application/json ContentType.
 type PostProfileLoginAsJSONRequestBody = ProfileLoginAsRequest
 
+// PostProfileMailboxesJSONRequestBody defines body for PostProfileMailboxes for application/json ContentType.
+type PostProfileMailboxesJSONRequestBody = PostProfileMailboxesJSONBody
+
+// PatchProfileMailboxesUUIDJSONRequestBody defines body for PatchProfileMailboxesUUID for application/json ContentType.
+type PatchProfileMailboxesUUIDJSONRequestBody = PatchProfileMailboxesUUIDJSONBody
+
+// PostProfileMailboxesUUIDSendJSONRequestBody defines body for PostProfileMailboxesUUIDSend for application/json ContentType.
+type PostProfileMailboxesUUIDSendJSONRequestBody = PostProfileMailboxesUUIDSendJSONBody
+
+// PutProfileNotificationsSettingsJSONRequestBody defines body for PutProfileNotificationsSettings for application/json ContentType.
+type PutProfileNotificationsSettingsJSONRequestBody = PutProfileNotificationsSettingsJSONBody
+
+// PutProfileNotificationsSettingsProjectUUIDJSONRequestBody defines body for PutProfileNotificationsSettingsProjectUUID for application/json ContentType.
+type PutProfileNotificationsSettingsProjectUUIDJSONRequestBody = PutProfileNotificationsSettingsProjectUUIDJSONBody
+
 // PatchProfilePasswordJSONRequestBody defines body for PatchProfilePassword for application/json ContentType.
 type PatchProfilePasswordJSONRequestBody = ProfileChangePasswordRequest
 
@@ -253,9 +449,45 @@ type ServerInterface interface {
 	// (POST /profile)
 	PostProfile(ctx echo.Context) error
 
+	// (DELETE /profile/admin/email/templates)
+	DeleteProfileAdminEmailTemplates(ctx echo.Context, params DeleteProfileAdminEmailTemplatesParams) error
+
+	// (GET /profile/admin/email/templates)
+	GetProfileAdminEmailTemplates(ctx echo.Context) error
+
+	// (PUT /profile/admin/email/templates)
+	PutProfileAdminEmailTemplates(ctx echo.Context) error
+
+	// (POST /profile/admin/email/templates/preview)
+	PostProfileAdminEmailTemplatesPreview(ctx echo.Context) error
+
+	// (GET /profile/admin/mails)
+	GetProfileAdminMails(ctx echo.Context, params GetProfileAdminMailsParams) error
+
+	// (POST /profile/admin/mails/{UUID}/resend)
+	PostProfileAdminMailsUUIDResend(ctx echo.Context, uUID Uuid) error
+
+	// (GET /profile/calendar)
+	GetProfileCalendar(ctx echo.Context) error
+
+	// (GET /profile/calendar/events)
+	GetProfileCalendarEvents(ctx echo.Context, params GetProfileCalendarEventsParams) error
+
+	// (GET /profile/calendar/feed)
+	GetProfileCalendarFeed(ctx echo.Context, params GetProfileCalendarFeedParams) error
+
+	// (POST /profile/calendar/reset)
+	PostProfileCalendarReset(ctx echo.Context) error
+
 	// (PATCH /profile/color)
 	PatchProfileColor(ctx echo.Context) error
 
+	// (GET /profile/digest/unsubscribe)
+	GetProfileDigestUnsubscribe(ctx echo.Context, params GetProfileDigestUnsubscribeParams) error
+
+	// (POST /profile/digest/unsubscribe)
+	PostProfileDigestUnsubscribe(ctx echo.Context, params PostProfileDigestUnsubscribeParams) error
+
 	// (POST /profile/dislike)
 	PostProfileDislike(ctx echo.Context) error
 
@@ -286,15 +518,69 @@ type ServerInterface interface {
 	// (GET /profile/logout)
 	GetProfileLogout(ctx echo.Context) error
 
+	// (GET /profile/mailboxes)
+	GetProfileMailboxes(ctx echo.Context) error
+
+	// (POST /profile/mailboxes)
+	PostProfileMailboxes(ctx echo.Context) error
+
+	// (GET /profile/mailboxes/emails)
+	GetProfileMailboxesEmails(ctx echo.Context, params GetProfileMailboxesEmailsParams) error
+
+	// (DELETE /profile/mailboxes/{UUID})
+	DeleteProfileMailboxesUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PATCH /profile/mailboxes/{UUID})
+	PatchProfileMailboxesUUID(ctx echo.Context, uUID Uuid) error
+
+	// (POST /profile/mailboxes/{UUID}/fetch)
+	PostProfileMailboxesUUIDFetch(ctx echo.Context, uUID Uuid) error
+
+	// (POST /profile/mailboxes/{UUID}/send)
+	PostProfileMailboxesUUIDSend(ctx echo.Context, uUID Uuid) error
+
+	// (GET /profile/mailboxes/{UUID}/sent)
+	GetProfileMailboxesUUIDSent(ctx echo.Context, uUID Uuid, params GetProfileMailboxesUUIDSentParams) error
+
+	// (GET /profile/mentions)
+	GetProfileMentions(ctx echo.Context, params GetProfileMentionsParams) error
+
 	// (DELETE /profile/notifications)
 	DeleteProfileNotifications(ctx echo.Context) error
 
 	// (GET /profile/notifications)
 	GetProfileNotifications(ctx echo.Context) error
 
+	// (GET /profile/notifications/deliveries)
+	GetProfileNotificationsDeliveries(ctx echo.Context, params GetProfileNotificationsDeliveriesParams) error
+
+	// (GET /profile/notifications/history)
+	GetProfileNotificationsHistory(ctx echo.Context, params GetProfileNotificationsHistoryParams) error
+
+	// (POST /profile/notifications/rebuild)
+	PostProfileNotificationsRebuild(ctx echo.Context) error
+
+	// (GET /profile/notifications/settings)
+	GetProfileNotificationsSettings(ctx echo.Context) error
+
+	// (PUT /profile/notifications/settings)
+	PutProfileNotificationsSettings(ctx echo.Context) error
+
+	// (DELETE /profile/notifications/settings/project/{UUID})
+	DeleteProfileNotificationsSettingsProjectUUID(ctx echo.Context, uUID Uuid) error
+
+	// (PUT /profile/notifications/settings/project/{UUID})
+	PutProfileNotificationsSettingsProjectUUID(ctx echo.Context, uUID Uuid) error
+
 	// (POST /profile/notifications/task/{UUID}/hide)
 	PostProfileNotificationsTaskUUIDHide(ctx echo.Context, uUID Uuid) error
 
+	// (DELETE /profile/notifications/task/{UUID}/read)
+	DeleteProfileNotificationsTaskUUIDRead(ctx echo.Context, uUID Uuid) error
+
+	// (POST /profile/notifications/task/{UUID}/read)
+	PostProfileNotificationsTaskUUIDRead(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /profile/notifications/task/{UUID}/star)
 	DeleteProfileNotificationsTaskUUIDStar(ctx echo.Context, uUID Uuid) error
 
@@ -373,70 +659,109 @@ func (w *ServerInterfaceWrapper) PostProfile(ctx echo.Context) error {
 	return err
 }
 
-// PatchProfileColor converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfileColor(ctx echo.Context) error {
+// DeleteProfileAdminEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileAdminEmailTemplates(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProfileAdminEmailTemplatesParams
+	// ------------- Required query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Required query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "lang", ctx.QueryParams(), &params.Lang)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lang: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfileColor(ctx)
+	err = w.Handler.DeleteProfileAdminEmailTemplates(ctx, params)
 	return err
 }
 
-// PostProfileDislike converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileDislike(ctx echo.Context) error {
+// GetProfileAdminEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileAdminEmailTemplates(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileDislike(ctx)
+	err = w.Handler.GetProfileAdminEmailTemplates(ctx)
 	return err
 }
 
-// PatchProfileFio converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfileFio(ctx echo.Context) error {
+// PutProfileAdminEmailTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProfileAdminEmailTemplates(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfileFio(ctx)
+	err = w.Handler.PutProfileAdminEmailTemplates(ctx)
 	return err
 }
 
-// GetProfileInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileInvite(ctx echo.Context) error {
+// PostProfileAdminEmailTemplatesPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileAdminEmailTemplatesPreview(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileInvite(ctx)
+	err = w.Handler.PostProfileAdminEmailTemplatesPreview(ctx)
 	return err
 }
 
-// PatchProfileInviteUUIDAccept converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfileInviteUUIDAccept(ctx echo.Context) error {
+// GetProfileAdminMails converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileAdminMails(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileAdminMailsParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfileInviteUUIDAccept(ctx, uUID)
+	err = w.Handler.GetProfileAdminMails(ctx, params)
 	return err
 }
 
-// PatchProfileInviteUUIDDecline converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfileInviteUUIDDecline(ctx echo.Context) error {
+// PostProfileAdminMailsUUIDResend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileAdminMailsUUIDResend(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -449,107 +774,207 @@ func (w *ServerInterfaceWrapper) PatchProfileInviteUUIDDecline(ctx echo.Context)
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfileInviteUUIDDecline(ctx, uUID)
+	err = w.Handler.PostProfileAdminMailsUUIDResend(ctx, uUID)
 	return err
 }
 
-// PostProfileLike converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileLike(ctx echo.Context) error {
+// GetProfileCalendar converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileCalendar(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileLike(ctx)
+	err = w.Handler.GetProfileCalendar(ctx)
 	return err
 }
 
-// GetProfileLikes converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileLikes(ctx echo.Context) error {
+// GetProfileCalendarEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileCalendarEvents(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileCalendarEventsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "people" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "people", ctx.QueryParams(), &params.People)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter people: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileLikes(ctx)
+	err = w.Handler.GetProfileCalendarEvents(ctx, params)
 	return err
 }
 
-// PostProfileLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileLogin(ctx echo.Context) error {
+// GetProfileCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileCalendarFeed(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileCalendarFeedParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileLogin(ctx)
+	err = w.Handler.GetProfileCalendarFeed(ctx, params)
 	return err
 }
 
-// PostProfileLoginAs converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileLoginAs(ctx echo.Context) error {
+// PostProfileCalendarReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileCalendarReset(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileLoginAs(ctx)
+	err = w.Handler.PostProfileCalendarReset(ctx)
 	return err
 }
 
-// GetProfileLogout converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileLogout(ctx echo.Context) error {
+// PatchProfileColor converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfileColor(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileLogout(ctx)
+	err = w.Handler.PatchProfileColor(ctx)
 	return err
 }
 
-// DeleteProfileNotifications converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfileNotifications(ctx echo.Context) error {
+// GetProfileDigestUnsubscribe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileDigestUnsubscribe(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileDigestUnsubscribeParams
+	// ------------- Required query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfileNotifications(ctx)
+	err = w.Handler.GetProfileDigestUnsubscribe(ctx, params)
 	return err
 }
 
-// GetProfileNotifications converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfileNotifications(ctx echo.Context) error {
+// PostProfileDigestUnsubscribe converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileDigestUnsubscribe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfileDigestUnsubscribeParams
+	// ------------- Required query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileDigestUnsubscribe(ctx, params)
+	return err
+}
+
+// PostProfileDislike converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileDislike(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfileNotifications(ctx)
+	err = w.Handler.PostProfileDislike(ctx)
 	return err
 }
 
-// PostProfileNotificationsTaskUUIDHide converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDHide(ctx echo.Context) error {
+// PatchProfileFio converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfileFio(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "UUID" -------------
-	var uUID Uuid
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfileFio(ctx)
+	return err
+}
+
+// GetProfileInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileInvite(ctx echo.Context) error {
+	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileNotificationsTaskUUIDHide(ctx, uUID)
+	err = w.Handler.GetProfileInvite(ctx)
 	return err
 }
 
-// DeleteProfileNotificationsTaskUUIDStar converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfileNotificationsTaskUUIDStar(ctx echo.Context) error {
+// PatchProfileInviteUUIDAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfileInviteUUIDAccept(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -562,12 +987,12 @@ func (w *ServerInterfaceWrapper) DeleteProfileNotificationsTaskUUIDStar(ctx echo
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfileNotificationsTaskUUIDStar(ctx, uUID)
+	err = w.Handler.PatchProfileInviteUUIDAccept(ctx, uUID)
 	return err
 }
 
-// PostProfileNotificationsTaskUUIDStar converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDStar(ctx echo.Context) error {
+// PatchProfileInviteUUIDDecline converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfileInviteUUIDDecline(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "UUID" -------------
 	var uUID Uuid
@@ -580,1267 +1005,3230 @@ func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDStar(ctx echo.C
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileNotificationsTaskUUIDStar(ctx, uUID)
+	err = w.Handler.PatchProfileInviteUUIDDecline(ctx, uUID)
 	return err
 }
 
-// PatchProfilePassword converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfilePassword(ctx echo.Context) error {
+// PostProfileLike converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileLike(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfilePassword(ctx)
+	err = w.Handler.PostProfileLike(ctx)
 	return err
 }
 
-// PatchProfilePhone converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfilePhone(ctx echo.Context) error {
+// GetProfileLikes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileLikes(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfilePhone(ctx)
+	err = w.Handler.GetProfileLikes(ctx)
 	return err
 }
 
-// DeleteProfilePhoto converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteProfilePhoto(ctx echo.Context) error {
+// PostProfileLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileLogin(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteProfilePhoto(ctx)
+	err = w.Handler.PostProfileLogin(ctx)
 	return err
 }
 
-// PatchProfilePhoto converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfilePhoto(ctx echo.Context) error {
+// PostProfileLoginAs converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileLoginAs(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfilePhoto(ctx)
+	err = w.Handler.PostProfileLoginAs(ctx)
 	return err
 }
 
-// PatchProfilePreferences converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchProfilePreferences(ctx echo.Context) error {
+// GetProfileLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileLogout(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchProfilePreferences(ctx)
+	err = w.Handler.GetProfileLogout(ctx)
 	return err
 }
 
-// PostProfileReset converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileReset(ctx echo.Context) error {
+// GetProfileMailboxes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileMailboxes(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileReset(ctx)
+	err = w.Handler.GetProfileMailboxes(ctx)
 	return err
 }
 
-// PostProfileResetSend converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileResetSend(ctx echo.Context) error {
+// PostProfileMailboxes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileMailboxes(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileResetSend(ctx)
+	err = w.Handler.PostProfileMailboxes(ctx)
 	return err
 }
 
-// PostProfileValidate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileValidate(ctx echo.Context) error {
+// GetProfileMailboxesEmails converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileMailboxesEmails(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileMailboxesEmailsParams
+	// ------------- Optional query parameter "mailbox_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mailbox_uuid", ctx.QueryParams(), &params.MailboxUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mailbox_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "agent_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "agent_uuid", ctx.QueryParams(), &params.AgentUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter agent_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "task_uuid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "task_uuid", ctx.QueryParams(), &params.TaskUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_uuid: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileValidate(ctx)
+	err = w.Handler.GetProfileMailboxesEmails(ctx, params)
 	return err
 }
 
-// PostProfileValidateSimple converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileValidateSimple(ctx echo.Context) error {
+// DeleteProfileMailboxesUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileMailboxesUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileValidateSimple(ctx)
+	err = w.Handler.DeleteProfileMailboxesUUID(ctx, uUID)
 	return err
 }
 
-// PostProfileValidateSimpleSend converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileValidateSimpleSend(ctx echo.Context) error {
+// PatchProfileMailboxesUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfileMailboxesUUID(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileValidateSimpleSend(ctx)
+	err = w.Handler.PatchProfileMailboxesUUID(ctx, uUID)
 	return err
 }
 
-// PostProfileValidateSend converts echo context to params.
-func (w *ServerInterfaceWrapper) PostProfileValidateSend(ctx echo.Context) error {
+// PostProfileMailboxesUUIDFetch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileMailboxesUUIDFetch(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostProfileValidateSend(ctx)
+	err = w.Handler.PostProfileMailboxesUUIDFetch(ctx, uUID)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostProfileMailboxesUUIDSend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileMailboxesUUIDSend(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileMailboxesUUIDSend(ctx, uUID)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// GetProfileMailboxesUUIDSent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileMailboxesUUIDSent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
 	}
 
-	router.DELETE(baseURL+"/profile", wrapper.DeleteProfile)
-	router.GET(baseURL+"/profile", wrapper.GetProfile)
-	router.POST(baseURL+"/profile", wrapper.PostProfile)
-	router.PATCH(baseURL+"/profile/color", wrapper.PatchProfileColor)
-	router.POST(baseURL+"/profile/dislike", wrapper.PostProfileDislike)
-	router.PATCH(baseURL+"/profile/fio", wrapper.PatchProfileFio)
-	router.GET(baseURL+"/profile/invite", wrapper.GetProfileInvite)
-	router.PATCH(baseURL+"/profile/invite/:UUID/accept", wrapper.PatchProfileInviteUUIDAccept)
-	router.PATCH(baseURL+"/profile/invite/:UUID/decline", wrapper.PatchProfileInviteUUIDDecline)
-	router.POST(baseURL+"/profile/like", wrapper.PostProfileLike)
-	router.GET(baseURL+"/profile/likes", wrapper.GetProfileLikes)
-	router.POST(baseURL+"/profile/login", wrapper.PostProfileLogin)
-	router.POST(baseURL+"/profile/login_as", wrapper.PostProfileLoginAs)
-	router.GET(baseURL+"/profile/logout", wrapper.GetProfileLogout)
-	router.DELETE(baseURL+"/profile/notifications", wrapper.DeleteProfileNotifications)
-	router.GET(baseURL+"/profile/notifications", wrapper.GetProfileNotifications)
-	router.POST(baseURL+"/profile/notifications/task/:UUID/hide", wrapper.PostProfileNotificationsTaskUUIDHide)
-	router.DELETE(baseURL+"/profile/notifications/task/:UUID/star", wrapper.DeleteProfileNotificationsTaskUUIDStar)
-	router.POST(baseURL+"/profile/notifications/task/:UUID/star", wrapper.PostProfileNotificationsTaskUUIDStar)
-	router.PATCH(baseURL+"/profile/password", wrapper.PatchProfilePassword)
-	router.PATCH(baseURL+"/profile/phone", wrapper.PatchProfilePhone)
-	router.DELETE(baseURL+"/profile/photo", wrapper.DeleteProfilePhoto)
-	router.PATCH(baseURL+"/profile/photo", wrapper.PatchProfilePhoto)
-	router.PATCH(baseURL+"/profile/preferences", wrapper.PatchProfilePreferences)
-	router.POST(baseURL+"/profile/reset", wrapper.PostProfileReset)
-	router.POST(baseURL+"/profile/reset/send", wrapper.PostProfileResetSend)
-	router.POST(baseURL+"/profile/validate", wrapper.PostProfileValidate)
-	router.POST(baseURL+"/profile/validate-simple", wrapper.PostProfileValidateSimple)
-	router.POST(baseURL+"/profile/validate-simple/send", wrapper.PostProfileValidateSimpleSend)
-	router.POST(baseURL+"/profile/validate/send", wrapper.PostProfileValidateSend)
+	ctx.Set(BearerAuthScopes, []string{})
 
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileMailboxesUUIDSentParams
+	// ------------- Optional query parameter "offset" -------------
 
-type DeleteProfileRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-type DeleteProfileResponseObject interface {
-	VisitDeleteProfileResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type DeleteProfile200ResponseHeaders struct {
-	SetCookie string
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type DeleteProfile200Response struct {
-	Headers DeleteProfile200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileMailboxesUUIDSent(ctx, uUID, params)
+	return err
 }
 
-func (response DeleteProfile200Response) VisitDeleteProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
-	return nil
-}
+// GetProfileMentions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileMentions(ctx echo.Context) error {
+	var err error
 
-type GetProfileRequestObject struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetProfileResponseObject interface {
-	VisitGetProfileResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileMentionsParams
+	// ------------- Optional query parameter "offset" -------------
 
-type GetProfile200JSONResponse ProfileDTO
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-func (response GetProfile200JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type PostProfileRequestObject struct {
-	Body *PostProfileJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileMentions(ctx, params)
+	return err
 }
 
-type PostProfileResponseObject interface {
-	VisitPostProfileResponse(w http.ResponseWriter) error
-}
+// DeleteProfileNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileNotifications(ctx echo.Context) error {
+	var err error
 
-type PostProfile200ResponseHeaders struct {
-	Hint string
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostProfile200JSONResponse struct {
-	Body    UUIDResponse
-	Headers PostProfile200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileNotifications(ctx)
+	return err
 }
 
-func (response PostProfile200JSONResponse) VisitPostProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
-	w.WriteHeader(200)
+// GetProfileNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileNotifications(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PatchProfileColorRequestObject struct {
-	Body *PatchProfileColorJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileNotifications(ctx)
+	return err
 }
 
-type PatchProfileColorResponseObject interface {
-	VisitPatchProfileColorResponse(w http.ResponseWriter) error
-}
+// GetProfileNotificationsDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileNotificationsDeliveries(ctx echo.Context) error {
+	var err error
 
-type PatchProfileColor200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PatchProfileColor200Response) VisitPatchProfileColorResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileNotificationsDeliveriesParams
+	// ------------- Optional query parameter "offset" -------------
 
-type PostProfileDislikeRequestObject struct {
-	Body *PostProfileDislikeJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-type PostProfileDislikeResponseObject interface {
-	VisitPostProfileDislikeResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostProfileDislike200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-func (response PostProfileDislike200Response) VisitPostProfileDislikeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "status" -------------
 
-type PatchProfileFioRequestObject struct {
-	Body *PatchProfileFioJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-type PatchProfileFioResponseObject interface {
-	VisitPatchProfileFioResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "channel" -------------
 
-type PatchProfileFio200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "channel", ctx.QueryParams(), &params.Channel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channel: %s", err))
+	}
 
-func (response PatchProfileFio200Response) VisitPatchProfileFioResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileNotificationsDeliveries(ctx, params)
+	return err
 }
 
-type GetProfileInviteRequestObject struct {
-}
+// GetProfileNotificationsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileNotificationsHistory(ctx echo.Context) error {
+	var err error
 
-type GetProfileInviteResponseObject interface {
-	VisitGetProfileInviteResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetProfileInvite200JSONResponse struct {
-	Count int         `json:"count"`
-	Items []InviteDTO `json:"items"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileNotificationsHistoryParams
+	// ------------- Optional query parameter "offset" -------------
 
-func (response GetProfileInvite200JSONResponse) VisitGetProfileInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PatchProfileInviteUUIDAcceptRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type PatchProfileInviteUUIDAcceptResponseObject interface {
-	VisitPatchProfileInviteUUIDAcceptResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "status" -------------
 
-type PatchProfileInviteUUIDAccept200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-func (response PatchProfileInviteUUIDAccept200Response) VisitPatchProfileInviteUUIDAcceptResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "starred" -------------
 
-type PatchProfileInviteUUIDDeclineRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "starred", ctx.QueryParams(), &params.Starred)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter starred: %s", err))
+	}
 
-type PatchProfileInviteUUIDDeclineResponseObject interface {
-	VisitPatchProfileInviteUUIDDeclineResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "hidden" -------------
 
-type PatchProfileInviteUUIDDecline200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "hidden", ctx.QueryParams(), &params.Hidden)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hidden: %s", err))
+	}
 
-func (response PatchProfileInviteUUIDDecline200Response) VisitPatchProfileInviteUUIDDeclineResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "type" -------------
 
-type PostProfileLikeRequestObject struct {
-	Body *PostProfileLikeJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-type PostProfileLikeResponseObject interface {
-	VisitPostProfileLikeResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "project_uuid" -------------
 
-type PostProfileLike200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "project_uuid", ctx.QueryParams(), &params.ProjectUuid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_uuid: %s", err))
+	}
 
-func (response PostProfileLike200Response) VisitPostProfileLikeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "search" -------------
 
-type GetProfileLikesRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
 
-type GetProfileLikesResponseObject interface {
-	VisitGetProfileLikesResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileNotificationsHistory(ctx, params)
+	return err
 }
 
-type GetProfileLikes200JSONResponse struct {
-	Companies   []openapi_types.UUID `json:"companies"`
-	Federations []openapi_types.UUID `json:"federations"`
-	Projects    []openapi_types.UUID `json:"projects"`
-	Tasks       []openapi_types.UUID `json:"tasks"`
-}
+// PostProfileNotificationsRebuild converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileNotificationsRebuild(ctx echo.Context) error {
+	var err error
 
-func (response GetProfileLikes200JSONResponse) VisitGetProfileLikesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileNotificationsRebuild(ctx)
+	return err
 }
 
-type PostProfileLoginRequestObject struct {
-	Body *PostProfileLoginJSONRequestBody
-}
+// GetProfileNotificationsSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileNotificationsSettings(ctx echo.Context) error {
+	var err error
 
-type PostProfileLoginResponseObject interface {
-	VisitPostProfileLoginResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostProfileLogin200ResponseHeaders struct {
-	SetCookie string
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileNotificationsSettings(ctx)
+	return err
 }
 
-type PostProfileLogin200JSONResponse struct {
-	Body    ProfileLoginResponse
-	Headers PostProfileLogin200ResponseHeaders
-}
+// PutProfileNotificationsSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProfileNotificationsSettings(ctx echo.Context) error {
+	var err error
 
-func (response PostProfileLogin200JSONResponse) VisitPostProfileLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProfileNotificationsSettings(ctx)
+	return err
 }
 
-type PostProfileLoginAsRequestObject struct {
-	Body *PostProfileLoginAsJSONRequestBody
-}
+// DeleteProfileNotificationsSettingsProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileNotificationsSettingsProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostProfileLoginAsResponseObject interface {
-	VisitPostProfileLoginAsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostProfileLoginAs200ResponseHeaders struct {
-	SetCookie string
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostProfileLoginAs200JSONResponse struct {
-	Body    ProfileLoginResponse
-	Headers PostProfileLoginAs200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileNotificationsSettingsProjectUUID(ctx, uUID)
+	return err
 }
 
-func (response PostProfileLoginAs200JSONResponse) VisitPostProfileLoginAsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// PutProfileNotificationsSettingsProjectUUID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProfileNotificationsSettingsProjectUUID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type GetProfileLogoutRequestObject struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetProfileLogoutResponseObject interface {
-	VisitGetProfileLogoutResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProfileNotificationsSettingsProjectUUID(ctx, uUID)
+	return err
 }
 
-type GetProfileLogout200ResponseHeaders struct {
-	SetCookie string
-}
+// PostProfileNotificationsTaskUUIDHide converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDHide(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetProfileLogout200Response struct {
-	Headers GetProfileLogout200ResponseHeaders
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response GetProfileLogout200Response) VisitGetProfileLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteProfileNotificationsRequestObject struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileNotificationsTaskUUIDHide(ctx, uUID)
+	return err
 }
 
-type DeleteProfileNotificationsResponseObject interface {
-	VisitDeleteProfileNotificationsResponse(w http.ResponseWriter) error
-}
+// DeleteProfileNotificationsTaskUUIDRead converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileNotificationsTaskUUIDRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteProfileNotifications200Response struct {
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response DeleteProfileNotifications200Response) VisitDeleteProfileNotificationsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetProfileNotificationsRequestObject struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileNotificationsTaskUUIDRead(ctx, uUID)
+	return err
 }
 
-type GetProfileNotificationsResponseObject interface {
-	VisitGetProfileNotificationsResponse(w http.ResponseWriter) error
-}
+// PostProfileNotificationsTaskUUIDRead converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDRead(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type GetProfileNotifications200JSONResponse struct {
-	Count int           `json:"count"`
-	Items []interface{} `json:"items"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-func (response GetProfileNotifications200JSONResponse) VisitGetProfileNotificationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileNotificationsTaskUUIDRead(ctx, uUID)
+	return err
 }
 
-type PostProfileNotificationsTaskUUIDHideRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// DeleteProfileNotificationsTaskUUIDStar converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileNotificationsTaskUUIDStar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type PostProfileNotificationsTaskUUIDHideResponseObject interface {
-	VisitPostProfileNotificationsTaskUUIDHideResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type PostProfileNotificationsTaskUUIDHide200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PostProfileNotificationsTaskUUIDHide200Response) VisitPostProfileNotificationsTaskUUIDHideResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileNotificationsTaskUUIDStar(ctx, uUID)
+	return err
 }
 
-type DeleteProfileNotificationsTaskUUIDStarRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PostProfileNotificationsTaskUUIDStar converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileNotificationsTaskUUIDStar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
 
-type DeleteProfileNotificationsTaskUUIDStarResponseObject interface {
-	VisitDeleteProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
 
-type DeleteProfileNotificationsTaskUUIDStar200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response DeleteProfileNotificationsTaskUUIDStar200Response) VisitDeleteProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileNotificationsTaskUUIDStar(ctx, uUID)
+	return err
 }
 
-type PostProfileNotificationsTaskUUIDStarRequestObject struct {
-	UUID Uuid `json:"UUID"`
-}
+// PatchProfilePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfilePassword(ctx echo.Context) error {
+	var err error
 
-type PostProfileNotificationsTaskUUIDStarResponseObject interface {
-	VisitPostProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfilePassword(ctx)
+	return err
 }
 
-type PostProfileNotificationsTaskUUIDStar200Response struct {
+// PatchProfilePhone converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfilePhone(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfilePhone(ctx)
+	return err
 }
 
-func (response PostProfileNotificationsTaskUUIDStar200Response) VisitPostProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// DeleteProfilePhoto converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfilePhoto(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfilePhoto(ctx)
+	return err
 }
 
-type PatchProfilePasswordRequestObject struct {
-	Body *PatchProfilePasswordJSONRequestBody
+// PatchProfilePhoto converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfilePhoto(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfilePhoto(ctx)
+	return err
 }
 
-type PatchProfilePasswordResponseObject interface {
-	VisitPatchProfilePasswordResponse(w http.ResponseWriter) error
+// PatchProfilePreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfilePreferences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfilePreferences(ctx)
+	return err
 }
 
-type PatchProfilePassword200Response struct {
+// PostProfileReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileReset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileReset(ctx)
+	return err
 }
 
-func (response PatchProfilePassword200Response) VisitPatchProfilePasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// PostProfileResetSend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileResetSend(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileResetSend(ctx)
+	return err
 }
 
-type PatchProfilePassword401Response struct {
+// PostProfileValidate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileValidate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileValidate(ctx)
+	return err
 }
 
-func (response PatchProfilePassword401Response) VisitPatchProfilePasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// PostProfileValidateSimple converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileValidateSimple(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileValidateSimple(ctx)
+	return err
 }
 
-type PatchProfilePhoneRequestObject struct {
-	Body *PatchProfilePhoneJSONRequestBody
+// PostProfileValidateSimpleSend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileValidateSimpleSend(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileValidateSimpleSend(ctx)
+	return err
 }
 
-type PatchProfilePhoneResponseObject interface {
-	VisitPatchProfilePhoneResponse(w http.ResponseWriter) error
+// PostProfileValidateSend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileValidateSend(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileValidateSend(ctx)
+	return err
 }
 
-type PatchProfilePhone200Response struct {
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
-func (response PatchProfilePhone200Response) VisitPatchProfilePhoneResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
 }
 
-type DeleteProfilePhotoRequestObject struct {
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.DELETE(baseURL+"/profile", wrapper.DeleteProfile)
+	router.GET(baseURL+"/profile", wrapper.GetProfile)
+	router.POST(baseURL+"/profile", wrapper.PostProfile)
+	router.DELETE(baseURL+"/profile/admin/email/templates", wrapper.DeleteProfileAdminEmailTemplates)
+	router.GET(baseURL+"/profile/admin/email/templates", wrapper.GetProfileAdminEmailTemplates)
+	router.PUT(baseURL+"/profile/admin/email/templates", wrapper.PutProfileAdminEmailTemplates)
+	router.POST(baseURL+"/profile/admin/email/templates/preview", wrapper.PostProfileAdminEmailTemplatesPreview)
+	router.GET(baseURL+"/profile/admin/mails", wrapper.GetProfileAdminMails)
+	router.POST(baseURL+"/profile/admin/mails/:UUID/resend", wrapper.PostProfileAdminMailsUUIDResend)
+	router.GET(baseURL+"/profile/calendar", wrapper.GetProfileCalendar)
+	router.GET(baseURL+"/profile/calendar/events", wrapper.GetProfileCalendarEvents)
+	router.GET(baseURL+"/profile/calendar/feed", wrapper.GetProfileCalendarFeed)
+	router.POST(baseURL+"/profile/calendar/reset", wrapper.PostProfileCalendarReset)
+	router.PATCH(baseURL+"/profile/color", wrapper.PatchProfileColor)
+	router.GET(baseURL+"/profile/digest/unsubscribe", wrapper.GetProfileDigestUnsubscribe)
+	router.POST(baseURL+"/profile/digest/unsubscribe", wrapper.PostProfileDigestUnsubscribe)
+	router.POST(baseURL+"/profile/dislike", wrapper.PostProfileDislike)
+	router.PATCH(baseURL+"/profile/fio", wrapper.PatchProfileFio)
+	router.GET(baseURL+"/profile/invite", wrapper.GetProfileInvite)
+	router.PATCH(baseURL+"/profile/invite/:UUID/accept", wrapper.PatchProfileInviteUUIDAccept)
+	router.PATCH(baseURL+"/profile/invite/:UUID/decline", wrapper.PatchProfileInviteUUIDDecline)
+	router.POST(baseURL+"/profile/like", wrapper.PostProfileLike)
+	router.GET(baseURL+"/profile/likes", wrapper.GetProfileLikes)
+	router.POST(baseURL+"/profile/login", wrapper.PostProfileLogin)
+	router.POST(baseURL+"/profile/login_as", wrapper.PostProfileLoginAs)
+	router.GET(baseURL+"/profile/logout", wrapper.GetProfileLogout)
+	router.GET(baseURL+"/profile/mailboxes", wrapper.GetProfileMailboxes)
+	router.POST(baseURL+"/profile/mailboxes", wrapper.PostProfileMailboxes)
+	router.GET(baseURL+"/profile/mailboxes/emails", wrapper.GetProfileMailboxesEmails)
+	router.DELETE(baseURL+"/profile/mailboxes/:UUID", wrapper.DeleteProfileMailboxesUUID)
+	router.PATCH(baseURL+"/profile/mailboxes/:UUID", wrapper.PatchProfileMailboxesUUID)
+	router.POST(baseURL+"/profile/mailboxes/:UUID/fetch", wrapper.PostProfileMailboxesUUIDFetch)
+	router.POST(baseURL+"/profile/mailboxes/:UUID/send", wrapper.PostProfileMailboxesUUIDSend)
+	router.GET(baseURL+"/profile/mailboxes/:UUID/sent", wrapper.GetProfileMailboxesUUIDSent)
+	router.GET(baseURL+"/profile/mentions", wrapper.GetProfileMentions)
+	router.DELETE(baseURL+"/profile/notifications", wrapper.DeleteProfileNotifications)
+	router.GET(baseURL+"/profile/notifications", wrapper.GetProfileNotifications)
+	router.GET(baseURL+"/profile/notifications/deliveries", wrapper.GetProfileNotificationsDeliveries)
+	router.GET(baseURL+"/profile/notifications/history", wrapper.GetProfileNotificationsHistory)
+	router.POST(baseURL+"/profile/notifications/rebuild", wrapper.PostProfileNotificationsRebuild)
+	router.GET(baseURL+"/profile/notifications/settings", wrapper.GetProfileNotificationsSettings)
+	router.PUT(baseURL+"/profile/notifications/settings", wrapper.PutProfileNotificationsSettings)
+	router.DELETE(baseURL+"/profile/notifications/settings/project/:UUID", wrapper.DeleteProfileNotificationsSettingsProjectUUID)
+	router.PUT(baseURL+"/profile/notifications/settings/project/:UUID", wrapper.PutProfileNotificationsSettingsProjectUUID)
+	router.POST(baseURL+"/profile/notifications/task/:UUID/hide", wrapper.PostProfileNotificationsTaskUUIDHide)
+	router.DELETE(baseURL+"/profile/notifications/task/:UUID/read", wrapper.DeleteProfileNotificationsTaskUUIDRead)
+	router.POST(baseURL+"/profile/notifications/task/:UUID/read", wrapper.PostProfileNotificationsTaskUUIDRead)
+	router.DELETE(baseURL+"/profile/notifications/task/:UUID/star", wrapper.DeleteProfileNotificationsTaskUUIDStar)
+	router.POST(baseURL+"/profile/notifications/task/:UUID/star", wrapper.PostProfileNotificationsTaskUUIDStar)
+	router.PATCH(baseURL+"/profile/password", wrapper.PatchProfilePassword)
+	router.PATCH(baseURL+"/profile/phone", wrapper.PatchProfilePhone)
+	router.DELETE(baseURL+"/profile/photo", wrapper.DeleteProfilePhoto)
+	router.PATCH(baseURL+"/profile/photo", wrapper.PatchProfilePhoto)
+	router.PATCH(baseURL+"/profile/preferences", wrapper.PatchProfilePreferences)
+	router.POST(baseURL+"/profile/reset", wrapper.PostProfileReset)
+	router.POST(baseURL+"/profile/reset/send", wrapper.PostProfileResetSend)
+	router.POST(baseURL+"/profile/validate", wrapper.PostProfileValidate)
+	router.POST(baseURL+"/profile/validate-simple", wrapper.PostProfileValidateSimple)
+	router.POST(baseURL+"/profile/validate-simple/send", wrapper.PostProfileValidateSimpleSend)
+	router.POST(baseURL+"/profile/validate/send", wrapper.PostProfileValidateSend)
+
 }
 
-type DeleteProfilePhotoResponseObject interface {
-	VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error
+type DeleteProfileRequestObject struct {
 }
 
-type DeleteProfilePhoto200Response struct {
+type DeleteProfileResponseObject interface {
+	VisitDeleteProfileResponse(w http.ResponseWriter) error
 }
 
-func (response DeleteProfilePhoto200Response) VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type DeleteProfile200ResponseHeaders struct {
+	SetCookie string
 }
 
-type DeleteProfilePhoto401Response struct {
+type DeleteProfile200Response struct {
+	Headers DeleteProfile200ResponseHeaders
 }
 
-func (response DeleteProfilePhoto401Response) VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response DeleteProfile200Response) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
 	return nil
 }
 
-type PatchProfilePhotoRequestObject struct {
-	Body *multipart.Reader
+type GetProfileRequestObject struct {
 }
 
-type PatchProfilePhotoResponseObject interface {
-	VisitPatchProfilePhotoResponse(w http.ResponseWriter) error
+type GetProfileResponseObject interface {
+	VisitGetProfileResponse(w http.ResponseWriter) error
 }
 
-type PatchProfilePhoto200JSONResponse ProfilePhotoDTO
+type GetProfile200JSONResponse ProfileDTO
 
-func (response PatchProfilePhoto200JSONResponse) VisitPatchProfilePhotoResponse(w http.ResponseWriter) error {
+func (response GetProfile200JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchProfilePreferencesRequestObject struct {
-	Body *PatchProfilePreferencesJSONRequestBody
+type PostProfileRequestObject struct {
+	Body *PostProfileJSONRequestBody
 }
 
-type PatchProfilePreferencesResponseObject interface {
-	VisitPatchProfilePreferencesResponse(w http.ResponseWriter) error
+type PostProfileResponseObject interface {
+	VisitPostProfileResponse(w http.ResponseWriter) error
 }
 
-type PatchProfilePreferences200Response struct {
+type PostProfile200ResponseHeaders struct {
+	Hint string
 }
 
-func (response PatchProfilePreferences200Response) VisitPatchProfilePreferencesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type PostProfile200JSONResponse struct {
+	Body    UUIDResponse
+	Headers PostProfile200ResponseHeaders
 }
 
-type PostProfileResetRequestObject struct {
-	Body *PostProfileResetJSONRequestBody
-}
+func (response PostProfile200JSONResponse) VisitPostProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+	w.WriteHeader(200)
 
-type PostProfileResetResponseObject interface {
-	VisitPostProfileResetResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type PostProfileReset200Response struct {
+type DeleteProfileAdminEmailTemplatesRequestObject struct {
+	Params DeleteProfileAdminEmailTemplatesParams
 }
 
-func (response PostProfileReset200Response) VisitPostProfileResetResponse(w http.ResponseWriter) error {
+type DeleteProfileAdminEmailTemplatesResponseObject interface {
+	VisitDeleteProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileAdminEmailTemplates200Response struct {
+}
+
+func (response DeleteProfileAdminEmailTemplates200Response) VisitDeleteProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostProfileResetSendRequestObject struct {
-	Body *PostProfileResetSendJSONRequestBody
+type GetProfileAdminEmailTemplatesRequestObject struct {
 }
 
-type PostProfileResetSendResponseObject interface {
-	VisitPostProfileResetSendResponse(w http.ResponseWriter) error
+type GetProfileAdminEmailTemplatesResponseObject interface {
+	VisitGetProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error
 }
 
-type PostProfileResetSend200ResponseHeaders struct {
-	Hint string
+type GetProfileAdminEmailTemplates200JSONResponse struct {
+	Count     int                `json:"count"`
+	Items     []EmailTemplateDTO `json:"items"`
+	Languages []string           `json:"languages"`
+	Types     []string           `json:"types"`
 }
 
-type PostProfileResetSend200Response struct {
-	Headers PostProfileResetSend200ResponseHeaders
+func (response GetProfileAdminEmailTemplates200JSONResponse) VisitGetProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response PostProfileResetSend200Response) VisitPostProfileResetSendResponse(w http.ResponseWriter) error {
-	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+type PutProfileAdminEmailTemplatesRequestObject struct {
+	Body *PutProfileAdminEmailTemplatesJSONRequestBody
+}
+
+type PutProfileAdminEmailTemplatesResponseObject interface {
+	VisitPutProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error
+}
+
+type PutProfileAdminEmailTemplates200JSONResponse EmailTemplateDTO
+
+func (response PutProfileAdminEmailTemplates200JSONResponse) VisitPutProfileAdminEmailTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProfileValidateRequestObject struct {
-	Body *PostProfileValidateJSONRequestBody
+type PostProfileAdminEmailTemplatesPreviewRequestObject struct {
+	Body *PostProfileAdminEmailTemplatesPreviewJSONRequestBody
 }
 
-type PostProfileValidateResponseObject interface {
-	VisitPostProfileValidateResponse(w http.ResponseWriter) error
+type PostProfileAdminEmailTemplatesPreviewResponseObject interface {
+	VisitPostProfileAdminEmailTemplatesPreviewResponse(w http.ResponseWriter) error
 }
 
-type PostProfileValidate200Response struct {
+type PostProfileAdminEmailTemplatesPreview200JSONResponse struct {
+	Html       string `json:"html"`
+	SenderName string `json:"sender_name"`
+	Subject    string `json:"subject"`
 }
 
-func (response PostProfileValidate200Response) VisitPostProfileValidateResponse(w http.ResponseWriter) error {
+func (response PostProfileAdminEmailTemplatesPreview200JSONResponse) VisitPostProfileAdminEmailTemplatesPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProfileValidateSimpleRequestObject struct {
-	Body *PostProfileValidateSimpleJSONRequestBody
+type GetProfileAdminMailsRequestObject struct {
+	Params GetProfileAdminMailsParams
 }
 
-type PostProfileValidateSimpleResponseObject interface {
-	VisitPostProfileValidateSimpleResponse(w http.ResponseWriter) error
+type GetProfileAdminMailsResponseObject interface {
+	VisitGetProfileAdminMailsResponse(w http.ResponseWriter) error
 }
 
-type PostProfileValidateSimple200Response struct {
+type GetProfileAdminMails200JSONResponse struct {
+	Count int       `json:"count"`
+	Items []MailDTO `json:"items"`
+	Total int64     `json:"total"`
 }
 
-func (response PostProfileValidateSimple200Response) VisitPostProfileValidateSimpleResponse(w http.ResponseWriter) error {
+func (response GetProfileAdminMails200JSONResponse) VisitGetProfileAdminMailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type PostProfileValidateSimpleSendRequestObject struct {
-	Body *PostProfileValidateSimpleSendJSONRequestBody
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProfileValidateSimpleSendResponseObject interface {
-	VisitPostProfileValidateSimpleSendResponse(w http.ResponseWriter) error
+type PostProfileAdminMailsUUIDResendRequestObject struct {
+	UUID Uuid `json:"UUID"`
 }
 
-type PostProfileValidateSimpleSend200ResponseHeaders struct {
-	Hint string
+type PostProfileAdminMailsUUIDResendResponseObject interface {
+	VisitPostProfileAdminMailsUUIDResendResponse(w http.ResponseWriter) error
 }
 
-type PostProfileValidateSimpleSend200Response struct {
-	Headers PostProfileValidateSimpleSend200ResponseHeaders
+type PostProfileAdminMailsUUIDResend200Response struct {
 }
 
-func (response PostProfileValidateSimpleSend200Response) VisitPostProfileValidateSimpleSendResponse(w http.ResponseWriter) error {
-	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+func (response PostProfileAdminMailsUUIDResend200Response) VisitPostProfileAdminMailsUUIDResendResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostProfileValidateSendRequestObject struct {
-	Body *PostProfileValidateSendJSONRequestBody
+type GetProfileCalendarRequestObject struct {
 }
 
-type PostProfileValidateSendResponseObject interface {
-	VisitPostProfileValidateSendResponse(w http.ResponseWriter) error
+type GetProfileCalendarResponseObject interface {
+	VisitGetProfileCalendarResponse(w http.ResponseWriter) error
 }
 
-type PostProfileValidateSend200ResponseHeaders struct {
-	Hint string
+type GetProfileCalendar200JSONResponse struct {
+	Url string `json:"url"`
 }
 
-type PostProfileValidateSend200Response struct {
-	Headers PostProfileValidateSend200ResponseHeaders
+func (response GetProfileCalendar200JSONResponse) VisitGetProfileCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response PostProfileValidateSend200Response) VisitPostProfileValidateSendResponse(w http.ResponseWriter) error {
-	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+type GetProfileCalendarEventsRequestObject struct {
+	Params GetProfileCalendarEventsParams
+}
+
+type GetProfileCalendarEventsResponseObject interface {
+	VisitGetProfileCalendarEventsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileCalendarEvents200JSONResponse struct {
+	Count    int                `json:"count"`
+	Items    []CalendarEventDTO `json:"items"`
+	Timezone string             `json:"timezone"`
+}
+
+func (response GetProfileCalendarEvents200JSONResponse) VisitGetProfileCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+type GetProfileCalendarFeedRequestObject struct {
+	Params GetProfileCalendarFeedParams
+}
 
-	// (DELETE /profile)
-	DeleteProfile(ctx context.Context, request DeleteProfileRequestObject) (DeleteProfileResponseObject, error)
+type GetProfileCalendarFeedResponseObject interface {
+	VisitGetProfileCalendarFeedResponse(w http.ResponseWriter) error
+}
 
-	// (GET /profile)
-	GetProfile(ctx context.Context, request GetProfileRequestObject) (GetProfileResponseObject, error)
+type GetProfileCalendarFeed200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-	// (POST /profile)
-	PostProfile(ctx context.Context, request PostProfileRequestObject) (PostProfileResponseObject, error)
+func (response GetProfileCalendarFeed200TextcalendarResponse) VisitGetProfileCalendarFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-	// (PATCH /profile/color)
-	PatchProfileColor(ctx context.Context, request PatchProfileColorRequestObject) (PatchProfileColorResponseObject, error)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	// (POST /profile/dislike)
-	PostProfileDislike(ctx context.Context, request PostProfileDislikeRequestObject) (PostProfileDislikeResponseObject, error)
+type PostProfileCalendarResetRequestObject struct {
+}
 
-	// (PATCH /profile/fio)
-	PatchProfileFio(ctx context.Context, request PatchProfileFioRequestObject) (PatchProfileFioResponseObject, error)
+type PostProfileCalendarResetResponseObject interface {
+	VisitPostProfileCalendarResetResponse(w http.ResponseWriter) error
+}
 
-	// (GET /profile/invite)
-	GetProfileInvite(ctx context.Context, request GetProfileInviteRequestObject) (GetProfileInviteResponseObject, error)
+type PostProfileCalendarReset200JSONResponse struct {
+	Url string `json:"url"`
+}
 
-	// (PATCH /profile/invite/{UUID}/accept)
-	PatchProfileInviteUUIDAccept(ctx context.Context, request PatchProfileInviteUUIDAcceptRequestObject) (PatchProfileInviteUUIDAcceptResponseObject, error)
+func (response PostProfileCalendarReset200JSONResponse) VisitPostProfileCalendarResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (PATCH /profile/invite/{UUID}/decline)
-	PatchProfileInviteUUIDDecline(ctx context.Context, request PatchProfileInviteUUIDDeclineRequestObject) (PatchProfileInviteUUIDDeclineResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (POST /profile/like)
-	PostProfileLike(ctx context.Context, request PostProfileLikeRequestObject) (PostProfileLikeResponseObject, error)
+type PatchProfileColorRequestObject struct {
+	Body *PatchProfileColorJSONRequestBody
+}
 
-	// (GET /profile/likes)
-	GetProfileLikes(ctx context.Context, request GetProfileLikesRequestObject) (GetProfileLikesResponseObject, error)
+type PatchProfileColorResponseObject interface {
+	VisitPatchProfileColorResponse(w http.ResponseWriter) error
+}
 
-	// (POST /profile/login)
-	PostProfileLogin(ctx context.Context, request PostProfileLoginRequestObject) (PostProfileLoginResponseObject, error)
+type PatchProfileColor200Response struct {
+}
 
-	// (POST /profile/login_as)
-	PostProfileLoginAs(ctx context.Context, request PostProfileLoginAsRequestObject) (PostProfileLoginAsResponseObject, error)
+func (response PatchProfileColor200Response) VisitPatchProfileColorResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (GET /profile/logout)
-	GetProfileLogout(ctx context.Context, request GetProfileLogoutRequestObject) (GetProfileLogoutResponseObject, error)
+type GetProfileDigestUnsubscribeRequestObject struct {
+	Params GetProfileDigestUnsubscribeParams
+}
 
-	// (DELETE /profile/notifications)
-	DeleteProfileNotifications(ctx context.Context, request DeleteProfileNotificationsRequestObject) (DeleteProfileNotificationsResponseObject, error)
+type GetProfileDigestUnsubscribeResponseObject interface {
+	VisitGetProfileDigestUnsubscribeResponse(w http.ResponseWriter) error
+}
 
-	// (GET /profile/notifications)
-	GetProfileNotifications(ctx context.Context, request GetProfileNotificationsRequestObject) (GetProfileNotificationsResponseObject, error)
+type GetProfileDigestUnsubscribe200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-	// (POST /profile/notifications/task/{UUID}/hide)
-	PostProfileNotificationsTaskUUIDHide(ctx context.Context, request PostProfileNotificationsTaskUUIDHideRequestObject) (PostProfileNotificationsTaskUUIDHideResponseObject, error)
+func (response GetProfileDigestUnsubscribe200TexthtmlResponse) VisitGetProfileDigestUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-	// (DELETE /profile/notifications/task/{UUID}/star)
-	DeleteProfileNotificationsTaskUUIDStar(ctx context.Context, request DeleteProfileNotificationsTaskUUIDStarRequestObject) (DeleteProfileNotificationsTaskUUIDStarResponseObject, error)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	// (POST /profile/notifications/task/{UUID}/star)
-	PostProfileNotificationsTaskUUIDStar(ctx context.Context, request PostProfileNotificationsTaskUUIDStarRequestObject) (PostProfileNotificationsTaskUUIDStarResponseObject, error)
+type PostProfileDigestUnsubscribeRequestObject struct {
+	Params PostProfileDigestUnsubscribeParams
+}
 
-	// (PATCH /profile/password)
-	PatchProfilePassword(ctx context.Context, request PatchProfilePasswordRequestObject) (PatchProfilePasswordResponseObject, error)
+type PostProfileDigestUnsubscribeResponseObject interface {
+	VisitPostProfileDigestUnsubscribeResponse(w http.ResponseWriter) error
+}
 
-	// (PATCH /profile/phone)
-	PatchProfilePhone(ctx context.Context, request PatchProfilePhoneRequestObject) (PatchProfilePhoneResponseObject, error)
+type PostProfileDigestUnsubscribe200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-	// (DELETE /profile/photo)
-	DeleteProfilePhoto(ctx context.Context, request DeleteProfilePhotoRequestObject) (DeleteProfilePhotoResponseObject, error)
+func (response PostProfileDigestUnsubscribe200TexthtmlResponse) VisitPostProfileDigestUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-	// (PATCH /profile/photo)
-	PatchProfilePhoto(ctx context.Context, request PatchProfilePhotoRequestObject) (PatchProfilePhotoResponseObject, error)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	// (PATCH /profile/preferences)
-	PatchProfilePreferences(ctx context.Context, request PatchProfilePreferencesRequestObject) (PatchProfilePreferencesResponseObject, error)
+type PostProfileDislikeRequestObject struct {
+	Body *PostProfileDislikeJSONRequestBody
+}
 
-	// (POST /profile/reset)
-	PostProfileReset(ctx context.Context, request PostProfileResetRequestObject) (PostProfileResetResponseObject, error)
+type PostProfileDislikeResponseObject interface {
+	VisitPostProfileDislikeResponse(w http.ResponseWriter) error
+}
 
-	// (POST /profile/reset/send)
-	PostProfileResetSend(ctx context.Context, request PostProfileResetSendRequestObject) (PostProfileResetSendResponseObject, error)
+type PostProfileDislike200Response struct {
+}
 
-	// (POST /profile/validate)
-	PostProfileValidate(ctx context.Context, request PostProfileValidateRequestObject) (PostProfileValidateResponseObject, error)
+func (response PostProfileDislike200Response) VisitPostProfileDislikeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	// (POST /profile/validate-simple)
-	PostProfileValidateSimple(ctx context.Context, request PostProfileValidateSimpleRequestObject) (PostProfileValidateSimpleResponseObject, error)
+type PatchProfileFioRequestObject struct {
+	Body *PatchProfileFioJSONRequestBody
+}
 
-	// (POST /profile/validate-simple/send)
-	PostProfileValidateSimpleSend(ctx context.Context, request PostProfileValidateSimpleSendRequestObject) (PostProfileValidateSimpleSendResponseObject, error)
+type PatchProfileFioResponseObject interface {
+	VisitPatchProfileFioResponse(w http.ResponseWriter) error
+}
 
-	// (POST /profile/validate/send)
-	PostProfileValidateSend(ctx context.Context, request PostProfileValidateSendRequestObject) (PostProfileValidateSendResponseObject, error)
+type PatchProfileFio200Response struct {
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+func (response PatchProfileFio200Response) VisitPatchProfileFioResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type GetProfileInviteRequestObject struct {
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+type GetProfileInviteResponseObject interface {
+	VisitGetProfileInviteResponse(w http.ResponseWriter) error
+}
+
+type GetProfileInvite200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []InviteDTO `json:"items"`
+}
+
+func (response GetProfileInvite200JSONResponse) VisitGetProfileInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProfileInviteUUIDAcceptRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PatchProfileInviteUUIDAcceptResponseObject interface {
+	VisitPatchProfileInviteUUIDAcceptResponse(w http.ResponseWriter) error
+}
+
+type PatchProfileInviteUUIDAccept200Response struct {
+}
+
+func (response PatchProfileInviteUUIDAccept200Response) VisitPatchProfileInviteUUIDAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProfileInviteUUIDDeclineRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PatchProfileInviteUUIDDeclineResponseObject interface {
+	VisitPatchProfileInviteUUIDDeclineResponse(w http.ResponseWriter) error
+}
+
+type PatchProfileInviteUUIDDecline200Response struct {
+}
+
+func (response PatchProfileInviteUUIDDecline200Response) VisitPatchProfileInviteUUIDDeclineResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileLikeRequestObject struct {
+	Body *PostProfileLikeJSONRequestBody
+}
+
+type PostProfileLikeResponseObject interface {
+	VisitPostProfileLikeResponse(w http.ResponseWriter) error
+}
+
+type PostProfileLike200Response struct {
+}
+
+func (response PostProfileLike200Response) VisitPostProfileLikeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileLikesRequestObject struct {
+}
+
+type GetProfileLikesResponseObject interface {
+	VisitGetProfileLikesResponse(w http.ResponseWriter) error
+}
+
+type GetProfileLikes200JSONResponse struct {
+	Companies   []openapi_types.UUID `json:"companies"`
+	Federations []openapi_types.UUID `json:"federations"`
+	Projects    []openapi_types.UUID `json:"projects"`
+	Tasks       []openapi_types.UUID `json:"tasks"`
+}
+
+func (response GetProfileLikes200JSONResponse) VisitGetProfileLikesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileLoginRequestObject struct {
+	Body *PostProfileLoginJSONRequestBody
+}
+
+type PostProfileLoginResponseObject interface {
+	VisitPostProfileLoginResponse(w http.ResponseWriter) error
+}
+
+type PostProfileLogin200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostProfileLogin200JSONResponse struct {
+	Body    ProfileLoginResponse
+	Headers PostProfileLogin200ResponseHeaders
+}
+
+func (response PostProfileLogin200JSONResponse) VisitPostProfileLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostProfileLoginAsRequestObject struct {
+	Body *PostProfileLoginAsJSONRequestBody
+}
+
+type PostProfileLoginAsResponseObject interface {
+	VisitPostProfileLoginAsResponse(w http.ResponseWriter) error
+}
+
+type PostProfileLoginAs200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostProfileLoginAs200JSONResponse struct {
+	Body    ProfileLoginResponse
+	Headers PostProfileLoginAs200ResponseHeaders
+}
+
+func (response PostProfileLoginAs200JSONResponse) VisitPostProfileLoginAsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProfileLogoutRequestObject struct {
+}
+
+type GetProfileLogoutResponseObject interface {
+	VisitGetProfileLogoutResponse(w http.ResponseWriter) error
+}
+
+type GetProfileLogout200ResponseHeaders struct {
+	SetCookie string
+}
+
+type GetProfileLogout200Response struct {
+	Headers GetProfileLogout200ResponseHeaders
+}
+
+func (response GetProfileLogout200Response) VisitGetProfileLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileMailboxesRequestObject struct {
+}
+
+type GetProfileMailboxesResponseObject interface {
+	VisitGetProfileMailboxesResponse(w http.ResponseWriter) error
+}
+
+type GetProfileMailboxes200JSONResponse struct {
+	Count int            `json:"count"`
+	Items []UserEmailDTO `json:"items"`
+}
+
+func (response GetProfileMailboxes200JSONResponse) VisitGetProfileMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileMailboxesRequestObject struct {
+	Body *PostProfileMailboxesJSONRequestBody
+}
+
+type PostProfileMailboxesResponseObject interface {
+	VisitPostProfileMailboxesResponse(w http.ResponseWriter) error
+}
+
+type PostProfileMailboxes200JSONResponse UserEmailDTO
+
+func (response PostProfileMailboxes200JSONResponse) VisitPostProfileMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileMailboxesEmailsRequestObject struct {
+	Params GetProfileMailboxesEmailsParams
+}
+
+type GetProfileMailboxesEmailsResponseObject interface {
+	VisitGetProfileMailboxesEmailsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileMailboxesEmails200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []EmailDTO `json:"items"`
+	Total int64      `json:"total"`
+}
+
+func (response GetProfileMailboxesEmails200JSONResponse) VisitGetProfileMailboxesEmailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileMailboxesUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileMailboxesUUIDResponseObject interface {
+	VisitDeleteProfileMailboxesUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileMailboxesUUID200Response struct {
+}
+
+func (response DeleteProfileMailboxesUUID200Response) VisitDeleteProfileMailboxesUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProfileMailboxesUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PatchProfileMailboxesUUIDJSONRequestBody
+}
+
+type PatchProfileMailboxesUUIDResponseObject interface {
+	VisitPatchProfileMailboxesUUIDResponse(w http.ResponseWriter) error
+}
+
+type PatchProfileMailboxesUUID200JSONResponse UserEmailDTO
+
+func (response PatchProfileMailboxesUUID200JSONResponse) VisitPatchProfileMailboxesUUIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileMailboxesUUIDFetchRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostProfileMailboxesUUIDFetchResponseObject interface {
+	VisitPostProfileMailboxesUUIDFetchResponse(w http.ResponseWriter) error
+}
+
+type PostProfileMailboxesUUIDFetch200Response struct {
+}
+
+func (response PostProfileMailboxesUUIDFetch200Response) VisitPostProfileMailboxesUUIDFetchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileMailboxesUUIDSendRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostProfileMailboxesUUIDSendJSONRequestBody
+}
+
+type PostProfileMailboxesUUIDSendResponseObject interface {
+	VisitPostProfileMailboxesUUIDSendResponse(w http.ResponseWriter) error
+}
+
+type PostProfileMailboxesUUIDSend200JSONResponse SentEmailDTO
+
+func (response PostProfileMailboxesUUIDSend200JSONResponse) VisitPostProfileMailboxesUUIDSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileMailboxesUUIDSentRequestObject struct {
+	UUID   Uuid `json:"UUID"`
+	Params GetProfileMailboxesUUIDSentParams
+}
+
+type GetProfileMailboxesUUIDSentResponseObject interface {
+	VisitGetProfileMailboxesUUIDSentResponse(w http.ResponseWriter) error
+}
+
+type GetProfileMailboxesUUIDSent200JSONResponse struct {
+	Count int            `json:"count"`
+	Items []SentEmailDTO `json:"items"`
+	Total int64          `json:"total"`
+}
+
+func (response GetProfileMailboxesUUIDSent200JSONResponse) VisitGetProfileMailboxesUUIDSentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileMentionsRequestObject struct {
+	Params GetProfileMentionsParams
+}
+
+type GetProfileMentionsResponseObject interface {
+	VisitGetProfileMentionsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileMentions200JSONResponse struct {
+	Count int          `json:"count"`
+	Items []MentionDTO `json:"items"`
+}
+
+func (response GetProfileMentions200JSONResponse) VisitGetProfileMentionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileNotificationsRequestObject struct {
+}
+
+type DeleteProfileNotificationsResponseObject interface {
+	VisitDeleteProfileNotificationsResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileNotifications200Response struct {
+}
+
+func (response DeleteProfileNotifications200Response) VisitDeleteProfileNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileNotificationsRequestObject struct {
+}
+
+type GetProfileNotificationsResponseObject interface {
+	VisitGetProfileNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileNotifications200JSONResponse struct {
+	Count int           `json:"count"`
+	Items []interface{} `json:"items"`
+}
+
+func (response GetProfileNotifications200JSONResponse) VisitGetProfileNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileNotificationsDeliveriesRequestObject struct {
+	Params GetProfileNotificationsDeliveriesParams
+}
+
+type GetProfileNotificationsDeliveriesResponseObject interface {
+	VisitGetProfileNotificationsDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetProfileNotificationsDeliveries200JSONResponse struct {
+	Count int                       `json:"count"`
+	Items []NotificationDeliveryDTO `json:"items"`
+	Total int64                     `json:"total"`
+}
+
+func (response GetProfileNotificationsDeliveries200JSONResponse) VisitGetProfileNotificationsDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileNotificationsHistoryRequestObject struct {
+	Params GetProfileNotificationsHistoryParams
+}
+
+type GetProfileNotificationsHistoryResponseObject interface {
+	VisitGetProfileNotificationsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetProfileNotificationsHistory200JSONResponse struct {
+	Count int                      `json:"count"`
+	Items []NotificationHistoryDTO `json:"items"`
+	Total int64                    `json:"total"`
+}
+
+func (response GetProfileNotificationsHistory200JSONResponse) VisitGetProfileNotificationsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileNotificationsRebuildRequestObject struct {
+}
+
+type PostProfileNotificationsRebuildResponseObject interface {
+	VisitPostProfileNotificationsRebuildResponse(w http.ResponseWriter) error
+}
+
+type PostProfileNotificationsRebuild200Response struct {
+}
+
+func (response PostProfileNotificationsRebuild200Response) VisitPostProfileNotificationsRebuildResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetProfileNotificationsSettingsRequestObject struct {
+}
+
+type GetProfileNotificationsSettingsResponseObject interface {
+	VisitGetProfileNotificationsSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileNotificationsSettings200JSONResponse NotificationSettingsDTO
+
+func (response GetProfileNotificationsSettings200JSONResponse) VisitGetProfileNotificationsSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProfileNotificationsSettingsRequestObject struct {
+	Body *PutProfileNotificationsSettingsJSONRequestBody
+}
+
+type PutProfileNotificationsSettingsResponseObject interface {
+	VisitPutProfileNotificationsSettingsResponse(w http.ResponseWriter) error
+}
+
+type PutProfileNotificationsSettings200Response struct {
+}
+
+func (response PutProfileNotificationsSettings200Response) VisitPutProfileNotificationsSettingsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProfileNotificationsSettingsProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileNotificationsSettingsProjectUUIDResponseObject interface {
+	VisitDeleteProfileNotificationsSettingsProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileNotificationsSettingsProjectUUID200Response struct {
+}
+
+func (response DeleteProfileNotificationsSettingsProjectUUID200Response) VisitDeleteProfileNotificationsSettingsProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutProfileNotificationsSettingsProjectUUIDRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PutProfileNotificationsSettingsProjectUUIDJSONRequestBody
+}
+
+type PutProfileNotificationsSettingsProjectUUIDResponseObject interface {
+	VisitPutProfileNotificationsSettingsProjectUUIDResponse(w http.ResponseWriter) error
+}
+
+type PutProfileNotificationsSettingsProjectUUID200Response struct {
+}
+
+func (response PutProfileNotificationsSettingsProjectUUID200Response) VisitPutProfileNotificationsSettingsProjectUUIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileNotificationsTaskUUIDHideRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostProfileNotificationsTaskUUIDHideResponseObject interface {
+	VisitPostProfileNotificationsTaskUUIDHideResponse(w http.ResponseWriter) error
+}
+
+type PostProfileNotificationsTaskUUIDHide200Response struct {
+}
+
+func (response PostProfileNotificationsTaskUUIDHide200Response) VisitPostProfileNotificationsTaskUUIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProfileNotificationsTaskUUIDReadRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileNotificationsTaskUUIDReadResponseObject interface {
+	VisitDeleteProfileNotificationsTaskUUIDReadResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileNotificationsTaskUUIDRead200Response struct {
+}
+
+func (response DeleteProfileNotificationsTaskUUIDRead200Response) VisitDeleteProfileNotificationsTaskUUIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileNotificationsTaskUUIDReadRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostProfileNotificationsTaskUUIDReadResponseObject interface {
+	VisitPostProfileNotificationsTaskUUIDReadResponse(w http.ResponseWriter) error
+}
+
+type PostProfileNotificationsTaskUUIDRead200Response struct {
+}
+
+func (response PostProfileNotificationsTaskUUIDRead200Response) VisitPostProfileNotificationsTaskUUIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProfileNotificationsTaskUUIDStarRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type DeleteProfileNotificationsTaskUUIDStarResponseObject interface {
+	VisitDeleteProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileNotificationsTaskUUIDStar200Response struct {
+}
+
+func (response DeleteProfileNotificationsTaskUUIDStar200Response) VisitDeleteProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileNotificationsTaskUUIDStarRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostProfileNotificationsTaskUUIDStarResponseObject interface {
+	VisitPostProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error
+}
+
+type PostProfileNotificationsTaskUUIDStar200Response struct {
+}
+
+func (response PostProfileNotificationsTaskUUIDStar200Response) VisitPostProfileNotificationsTaskUUIDStarResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProfilePasswordRequestObject struct {
+	Body *PatchProfilePasswordJSONRequestBody
+}
+
+type PatchProfilePasswordResponseObject interface {
+	VisitPatchProfilePasswordResponse(w http.ResponseWriter) error
+}
+
+type PatchProfilePassword200Response struct {
+}
+
+func (response PatchProfilePassword200Response) VisitPatchProfilePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchProfilePassword401Response struct {
+}
+
+func (response PatchProfilePassword401Response) VisitPatchProfilePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchProfilePhoneRequestObject struct {
+	Body *PatchProfilePhoneJSONRequestBody
+}
+
+type PatchProfilePhoneResponseObject interface {
+	VisitPatchProfilePhoneResponse(w http.ResponseWriter) error
+}
+
+type PatchProfilePhone200Response struct {
+}
+
+func (response PatchProfilePhone200Response) VisitPatchProfilePhoneResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProfilePhotoRequestObject struct {
+}
+
+type DeleteProfilePhotoResponseObject interface {
+	VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfilePhoto200Response struct {
+}
+
+func (response DeleteProfilePhoto200Response) VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProfilePhoto401Response struct {
+}
+
+func (response DeleteProfilePhoto401Response) VisitDeleteProfilePhotoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchProfilePhotoRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PatchProfilePhotoResponseObject interface {
+	VisitPatchProfilePhotoResponse(w http.ResponseWriter) error
+}
+
+type PatchProfilePhoto200JSONResponse ProfilePhotoDTO
+
+func (response PatchProfilePhoto200JSONResponse) VisitPatchProfilePhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProfilePreferencesRequestObject struct {
+	Body *PatchProfilePreferencesJSONRequestBody
+}
+
+type PatchProfilePreferencesResponseObject interface {
+	VisitPatchProfilePreferencesResponse(w http.ResponseWriter) error
+}
+
+type PatchProfilePreferences200Response struct {
+}
+
+func (response PatchProfilePreferences200Response) VisitPatchProfilePreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileResetRequestObject struct {
+	Body *PostProfileResetJSONRequestBody
+}
+
+type PostProfileResetResponseObject interface {
+	VisitPostProfileResetResponse(w http.ResponseWriter) error
+}
+
+type PostProfileReset200Response struct {
+}
+
+func (response PostProfileReset200Response) VisitPostProfileResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileResetSendRequestObject struct {
+	Body *PostProfileResetSendJSONRequestBody
+}
+
+type PostProfileResetSendResponseObject interface {
+	VisitPostProfileResetSendResponse(w http.ResponseWriter) error
+}
+
+type PostProfileResetSend200ResponseHeaders struct {
+	Hint string
+}
+
+type PostProfileResetSend200Response struct {
+	Headers PostProfileResetSend200ResponseHeaders
+}
+
+func (response PostProfileResetSend200Response) VisitPostProfileResetSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileValidateRequestObject struct {
+	Body *PostProfileValidateJSONRequestBody
+}
+
+type PostProfileValidateResponseObject interface {
+	VisitPostProfileValidateResponse(w http.ResponseWriter) error
+}
+
+type PostProfileValidate200Response struct {
+}
+
+func (response PostProfileValidate200Response) VisitPostProfileValidateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileValidateSimpleRequestObject struct {
+	Body *PostProfileValidateSimpleJSONRequestBody
+}
+
+type PostProfileValidateSimpleResponseObject interface {
+	VisitPostProfileValidateSimpleResponse(w http.ResponseWriter) error
+}
+
+type PostProfileValidateSimple200Response struct {
+}
+
+func (response PostProfileValidateSimple200Response) VisitPostProfileValidateSimpleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileValidateSimpleSendRequestObject struct {
+	Body *PostProfileValidateSimpleSendJSONRequestBody
+}
+
+type PostProfileValidateSimpleSendResponseObject interface {
+	VisitPostProfileValidateSimpleSendResponse(w http.ResponseWriter) error
+}
+
+type PostProfileValidateSimpleSend200ResponseHeaders struct {
+	Hint string
+}
+
+type PostProfileValidateSimpleSend200Response struct {
+	Headers PostProfileValidateSimpleSend200ResponseHeaders
+}
+
+func (response PostProfileValidateSimpleSend200Response) VisitPostProfileValidateSimpleSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileValidateSendRequestObject struct {
+	Body *PostProfileValidateSendJSONRequestBody
+}
+
+type PostProfileValidateSendResponseObject interface {
+	VisitPostProfileValidateSendResponse(w http.ResponseWriter) error
+}
+
+type PostProfileValidateSend200ResponseHeaders struct {
+	Hint string
+}
+
+type PostProfileValidateSend200Response struct {
+	Headers PostProfileValidateSend200ResponseHeaders
+}
+
+func (response PostProfileValidateSend200Response) VisitPostProfileValidateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Hint", fmt.Sprint(response.Headers.Hint))
+	w.WriteHeader(200)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (DELETE /profile)
+	DeleteProfile(ctx context.Context, request DeleteProfileRequestObject) (DeleteProfileResponseObject, error)
+
+	// (GET /profile)
+	GetProfile(ctx context.Context, request GetProfileRequestObject) (GetProfileResponseObject, error)
+
+	// (POST /profile)
+	PostProfile(ctx context.Context, request PostProfileRequestObject) (PostProfileResponseObject, error)
+
+	// (DELETE /profile/admin/email/templates)
+	DeleteProfileAdminEmailTemplates(ctx context.Context, request DeleteProfileAdminEmailTemplatesRequestObject) (DeleteProfileAdminEmailTemplatesResponseObject, error)
+
+	// (GET /profile/admin/email/templates)
+	GetProfileAdminEmailTemplates(ctx context.Context, request GetProfileAdminEmailTemplatesRequestObject) (GetProfileAdminEmailTemplatesResponseObject, error)
+
+	// (PUT /profile/admin/email/templates)
+	PutProfileAdminEmailTemplates(ctx context.Context, request PutProfileAdminEmailTemplatesRequestObject) (PutProfileAdminEmailTemplatesResponseObject, error)
+
+	// (POST /profile/admin/email/templates/preview)
+	PostProfileAdminEmailTemplatesPreview(ctx context.Context, request PostProfileAdminEmailTemplatesPreviewRequestObject) (PostProfileAdminEmailTemplatesPreviewResponseObject, error)
+
+	// (GET /profile/admin/mails)
+	GetProfileAdminMails(ctx context.Context, request GetProfileAdminMailsRequestObject) (GetProfileAdminMailsResponseObject, error)
+
+	// (POST /profile/admin/mails/{UUID}/resend)
+	PostProfileAdminMailsUUIDResend(ctx context.Context, request PostProfileAdminMailsUUIDResendRequestObject) (PostProfileAdminMailsUUIDResendResponseObject, error)
+
+	// (GET /profile/calendar)
+	GetProfileCalendar(ctx context.Context, request GetProfileCalendarRequestObject) (GetProfileCalendarResponseObject, error)
+
+	// (GET /profile/calendar/events)
+	GetProfileCalendarEvents(ctx context.Context, request GetProfileCalendarEventsRequestObject) (GetProfileCalendarEventsResponseObject, error)
+
+	// (GET /profile/calendar/feed)
+	GetProfileCalendarFeed(ctx context.Context, request GetProfileCalendarFeedRequestObject) (GetProfileCalendarFeedResponseObject, error)
+
+	// (POST /profile/calendar/reset)
+	PostProfileCalendarReset(ctx context.Context, request PostProfileCalendarResetRequestObject) (PostProfileCalendarResetResponseObject, error)
+
+	// (PATCH /profile/color)
+	PatchProfileColor(ctx context.Context, request PatchProfileColorRequestObject) (PatchProfileColorResponseObject, error)
+
+	// (GET /profile/digest/unsubscribe)
+	GetProfileDigestUnsubscribe(ctx context.Context, request GetProfileDigestUnsubscribeRequestObject) (GetProfileDigestUnsubscribeResponseObject, error)
+
+	// (POST /profile/digest/unsubscribe)
+	PostProfileDigestUnsubscribe(ctx context.Context, request PostProfileDigestUnsubscribeRequestObject) (PostProfileDigestUnsubscribeResponseObject, error)
+
+	// (POST /profile/dislike)
+	PostProfileDislike(ctx context.Context, request PostProfileDislikeRequestObject) (PostProfileDislikeResponseObject, error)
+
+	// (PATCH /profile/fio)
+	PatchProfileFio(ctx context.Context, request PatchProfileFioRequestObject) (PatchProfileFioResponseObject, error)
+
+	// (GET /profile/invite)
+	GetProfileInvite(ctx context.Context, request GetProfileInviteRequestObject) (GetProfileInviteResponseObject, error)
+
+	// (PATCH /profile/invite/{UUID}/accept)
+	PatchProfileInviteUUIDAccept(ctx context.Context, request PatchProfileInviteUUIDAcceptRequestObject) (PatchProfileInviteUUIDAcceptResponseObject, error)
+
+	// (PATCH /profile/invite/{UUID}/decline)
+	PatchProfileInviteUUIDDecline(ctx context.Context, request PatchProfileInviteUUIDDeclineRequestObject) (PatchProfileInviteUUIDDeclineResponseObject, error)
+
+	// (POST /profile/like)
+	PostProfileLike(ctx context.Context, request PostProfileLikeRequestObject) (PostProfileLikeResponseObject, error)
+
+	// (GET /profile/likes)
+	GetProfileLikes(ctx context.Context, request GetProfileLikesRequestObject) (GetProfileLikesResponseObject, error)
+
+	// (POST /profile/login)
+	PostProfileLogin(ctx context.Context, request PostProfileLoginRequestObject) (PostProfileLoginResponseObject, error)
+
+	// (POST /profile/login_as)
+	PostProfileLoginAs(ctx context.Context, request PostProfileLoginAsRequestObject) (PostProfileLoginAsResponseObject, error)
+
+	// (GET /profile/logout)
+	GetProfileLogout(ctx context.Context, request GetProfileLogoutRequestObject) (GetProfileLogoutResponseObject, error)
+
+	// (GET /profile/mailboxes)
+	GetProfileMailboxes(ctx context.Context, request GetProfileMailboxesRequestObject) (GetProfileMailboxesResponseObject, error)
+
+	// (POST /profile/mailboxes)
+	PostProfileMailboxes(ctx context.Context, request PostProfileMailboxesRequestObject) (PostProfileMailboxesResponseObject, error)
+
+	// (GET /profile/mailboxes/emails)
+	GetProfileMailboxesEmails(ctx context.Context, request GetProfileMailboxesEmailsRequestObject) (GetProfileMailboxesEmailsResponseObject, error)
+
+	// (DELETE /profile/mailboxes/{UUID})
+	DeleteProfileMailboxesUUID(ctx context.Context, request DeleteProfileMailboxesUUIDRequestObject) (DeleteProfileMailboxesUUIDResponseObject, error)
+
+	// (PATCH /profile/mailboxes/{UUID})
+	PatchProfileMailboxesUUID(ctx context.Context, request PatchProfileMailboxesUUIDRequestObject) (PatchProfileMailboxesUUIDResponseObject, error)
+
+	// (POST /profile/mailboxes/{UUID}/fetch)
+	PostProfileMailboxesUUIDFetch(ctx context.Context, request PostProfileMailboxesUUIDFetchRequestObject) (PostProfileMailboxesUUIDFetchResponseObject, error)
+
+	// (POST /profile/mailboxes/{UUID}/send)
+	PostProfileMailboxesUUIDSend(ctx context.Context, request PostProfileMailboxesUUIDSendRequestObject) (PostProfileMailboxesUUIDSendResponseObject, error)
+
+	// (GET /profile/mailboxes/{UUID}/sent)
+	GetProfileMailboxesUUIDSent(ctx context.Context, request GetProfileMailboxesUUIDSentRequestObject) (GetProfileMailboxesUUIDSentResponseObject, error)
+
+	// (GET /profile/mentions)
+	GetProfileMentions(ctx context.Context, request GetProfileMentionsRequestObject) (GetProfileMentionsResponseObject, error)
+
+	// (DELETE /profile/notifications)
+	DeleteProfileNotifications(ctx context.Context, request DeleteProfileNotificationsRequestObject) (DeleteProfileNotificationsResponseObject, error)
+
+	// (GET /profile/notifications)
+	GetProfileNotifications(ctx context.Context, request GetProfileNotificationsRequestObject) (GetProfileNotificationsResponseObject, error)
+
+	// (GET /profile/notifications/deliveries)
+	GetProfileNotificationsDeliveries(ctx context.Context, request GetProfileNotificationsDeliveriesRequestObject) (GetProfileNotificationsDeliveriesResponseObject, error)
+
+	// (GET /profile/notifications/history)
+	GetProfileNotificationsHistory(ctx context.Context, request GetProfileNotificationsHistoryRequestObject) (GetProfileNotificationsHistoryResponseObject, error)
+
+	// (POST /profile/notifications/rebuild)
+	PostProfileNotificationsRebuild(ctx context.Context, request PostProfileNotificationsRebuildRequestObject) (PostProfileNotificationsRebuildResponseObject, error)
+
+	// (GET /profile/notifications/settings)
+	GetProfileNotificationsSettings(ctx context.Context, request GetProfileNotificationsSettingsRequestObject) (GetProfileNotificationsSettingsResponseObject, error)
+
+	// (PUT /profile/notifications/settings)
+	PutProfileNotificationsSettings(ctx context.Context, request PutProfileNotificationsSettingsRequestObject) (PutProfileNotificationsSettingsResponseObject, error)
+
+	// (DELETE /profile/notifications/settings/project/{UUID})
+	DeleteProfileNotificationsSettingsProjectUUID(ctx context.Context, request DeleteProfileNotificationsSettingsProjectUUIDRequestObject) (DeleteProfileNotificationsSettingsProjectUUIDResponseObject, error)
+
+	// (PUT /profile/notifications/settings/project/{UUID})
+	PutProfileNotificationsSettingsProjectUUID(ctx context.Context, request PutProfileNotificationsSettingsProjectUUIDRequestObject) (PutProfileNotificationsSettingsProjectUUIDResponseObject, error)
+
+	// (POST /profile/notifications/task/{UUID}/hide)
+	PostProfileNotificationsTaskUUIDHide(ctx context.Context, request PostProfileNotificationsTaskUUIDHideRequestObject) (PostProfileNotificationsTaskUUIDHideResponseObject, error)
+
+	// (DELETE /profile/notifications/task/{UUID}/read)
+	DeleteProfileNotificationsTaskUUIDRead(ctx context.Context, request DeleteProfileNotificationsTaskUUIDReadRequestObject) (DeleteProfileNotificationsTaskUUIDReadResponseObject, error)
+
+	// (POST /profile/notifications/task/{UUID}/read)
+	PostProfileNotificationsTaskUUIDRead(ctx context.Context, request PostProfileNotificationsTaskUUIDReadRequestObject) (PostProfileNotificationsTaskUUIDReadResponseObject, error)
+
+	// (DELETE /profile/notifications/task/{UUID}/star)
+	DeleteProfileNotificationsTaskUUIDStar(ctx context.Context, request DeleteProfileNotificationsTaskUUIDStarRequestObject) (DeleteProfileNotificationsTaskUUIDStarResponseObject, error)
+
+	// (POST /profile/notifications/task/{UUID}/star)
+	PostProfileNotificationsTaskUUIDStar(ctx context.Context, request PostProfileNotificationsTaskUUIDStarRequestObject) (PostProfileNotificationsTaskUUIDStarResponseObject, error)
+
+	// (PATCH /profile/password)
+	PatchProfilePassword(ctx context.Context, request PatchProfilePasswordRequestObject) (PatchProfilePasswordResponseObject, error)
+
+	// (PATCH /profile/phone)
+	PatchProfilePhone(ctx context.Context, request PatchProfilePhoneRequestObject) (PatchProfilePhoneResponseObject, error)
+
+	// (DELETE /profile/photo)
+	DeleteProfilePhoto(ctx context.Context, request DeleteProfilePhotoRequestObject) (DeleteProfilePhotoResponseObject, error)
+
+	// (PATCH /profile/photo)
+	PatchProfilePhoto(ctx context.Context, request PatchProfilePhotoRequestObject) (PatchProfilePhotoResponseObject, error)
+
+	// (PATCH /profile/preferences)
+	PatchProfilePreferences(ctx context.Context, request PatchProfilePreferencesRequestObject) (PatchProfilePreferencesResponseObject, error)
+
+	// (POST /profile/reset)
+	PostProfileReset(ctx context.Context, request PostProfileResetRequestObject) (PostProfileResetResponseObject, error)
+
+	// (POST /profile/reset/send)
+	PostProfileResetSend(ctx context.Context, request PostProfileResetSendRequestObject) (PostProfileResetSendResponseObject, error)
+
+	// (POST /profile/validate)
+	PostProfileValidate(ctx context.Context, request PostProfileValidateRequestObject) (PostProfileValidateResponseObject, error)
+
+	// (POST /profile/validate-simple)
+	PostProfileValidateSimple(ctx context.Context, request PostProfileValidateSimpleRequestObject) (PostProfileValidateSimpleResponseObject, error)
+
+	// (POST /profile/validate-simple/send)
+	PostProfileValidateSimpleSend(ctx context.Context, request PostProfileValidateSimpleSendRequestObject) (PostProfileValidateSimpleSendResponseObject, error)
+
+	// (POST /profile/validate/send)
+	PostProfileValidateSend(ctx context.Context, request PostProfileValidateSendRequestObject) (PostProfileValidateSendResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// DeleteProfile operation middleware
+func (sh *strictHandler) DeleteProfile(ctx echo.Context) error {
+	var request DeleteProfileRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProfile(ctx.Request().Context(), request.(DeleteProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProfileResponseObject); ok {
+		return validResponse.VisitDeleteProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfile operation middleware
+func (sh *strictHandler) GetProfile(ctx echo.Context) error {
+	var request GetProfileRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfile(ctx.Request().Context(), request.(GetProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileResponseObject); ok {
+		return validResponse.VisitGetProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfile operation middleware
+func (sh *strictHandler) PostProfile(ctx echo.Context) error {
+	var request PostProfileRequestObject
+
+	var body PostProfileJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfile(ctx.Request().Context(), request.(PostProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileResponseObject); ok {
+		return validResponse.VisitPostProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteProfileAdminEmailTemplates operation middleware
+func (sh *strictHandler) DeleteProfileAdminEmailTemplates(ctx echo.Context, params DeleteProfileAdminEmailTemplatesParams) error {
+	var request DeleteProfileAdminEmailTemplatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProfileAdminEmailTemplates(ctx.Request().Context(), request.(DeleteProfileAdminEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProfileAdminEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProfileAdminEmailTemplatesResponseObject); ok {
+		return validResponse.VisitDeleteProfileAdminEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileAdminEmailTemplates operation middleware
+func (sh *strictHandler) GetProfileAdminEmailTemplates(ctx echo.Context) error {
+	var request GetProfileAdminEmailTemplatesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileAdminEmailTemplates(ctx.Request().Context(), request.(GetProfileAdminEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileAdminEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileAdminEmailTemplatesResponseObject); ok {
+		return validResponse.VisitGetProfileAdminEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutProfileAdminEmailTemplates operation middleware
+func (sh *strictHandler) PutProfileAdminEmailTemplates(ctx echo.Context) error {
+	var request PutProfileAdminEmailTemplatesRequestObject
+
+	var body PutProfileAdminEmailTemplatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProfileAdminEmailTemplates(ctx.Request().Context(), request.(PutProfileAdminEmailTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProfileAdminEmailTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutProfileAdminEmailTemplatesResponseObject); ok {
+		return validResponse.VisitPutProfileAdminEmailTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileAdminEmailTemplatesPreview operation middleware
+func (sh *strictHandler) PostProfileAdminEmailTemplatesPreview(ctx echo.Context) error {
+	var request PostProfileAdminEmailTemplatesPreviewRequestObject
+
+	var body PostProfileAdminEmailTemplatesPreviewJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileAdminEmailTemplatesPreview(ctx.Request().Context(), request.(PostProfileAdminEmailTemplatesPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileAdminEmailTemplatesPreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileAdminEmailTemplatesPreviewResponseObject); ok {
+		return validResponse.VisitPostProfileAdminEmailTemplatesPreviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileAdminMails operation middleware
+func (sh *strictHandler) GetProfileAdminMails(ctx echo.Context, params GetProfileAdminMailsParams) error {
+	var request GetProfileAdminMailsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileAdminMails(ctx.Request().Context(), request.(GetProfileAdminMailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileAdminMails")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileAdminMailsResponseObject); ok {
+		return validResponse.VisitGetProfileAdminMailsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileAdminMailsUUIDResend operation middleware
+func (sh *strictHandler) PostProfileAdminMailsUUIDResend(ctx echo.Context, uUID Uuid) error {
+	var request PostProfileAdminMailsUUIDResendRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileAdminMailsUUIDResend(ctx.Request().Context(), request.(PostProfileAdminMailsUUIDResendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileAdminMailsUUIDResend")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileAdminMailsUUIDResendResponseObject); ok {
+		return validResponse.VisitPostProfileAdminMailsUUIDResendResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileCalendar operation middleware
+func (sh *strictHandler) GetProfileCalendar(ctx echo.Context) error {
+	var request GetProfileCalendarRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileCalendar(ctx.Request().Context(), request.(GetProfileCalendarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileCalendar")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileCalendarResponseObject); ok {
+		return validResponse.VisitGetProfileCalendarResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileCalendarEvents operation middleware
+func (sh *strictHandler) GetProfileCalendarEvents(ctx echo.Context, params GetProfileCalendarEventsParams) error {
+	var request GetProfileCalendarEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileCalendarEvents(ctx.Request().Context(), request.(GetProfileCalendarEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileCalendarEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileCalendarEventsResponseObject); ok {
+		return validResponse.VisitGetProfileCalendarEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileCalendarFeed operation middleware
+func (sh *strictHandler) GetProfileCalendarFeed(ctx echo.Context, params GetProfileCalendarFeedParams) error {
+	var request GetProfileCalendarFeedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileCalendarFeed(ctx.Request().Context(), request.(GetProfileCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileCalendarFeed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileCalendarFeedResponseObject); ok {
+		return validResponse.VisitGetProfileCalendarFeedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileCalendarReset operation middleware
+func (sh *strictHandler) PostProfileCalendarReset(ctx echo.Context) error {
+	var request PostProfileCalendarResetRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileCalendarReset(ctx.Request().Context(), request.(PostProfileCalendarResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileCalendarReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileCalendarResetResponseObject); ok {
+		return validResponse.VisitPostProfileCalendarResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchProfileColor operation middleware
+func (sh *strictHandler) PatchProfileColor(ctx echo.Context) error {
+	var request PatchProfileColorRequestObject
+
+	var body PatchProfileColorJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProfileColor(ctx.Request().Context(), request.(PatchProfileColorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProfileColor")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchProfileColorResponseObject); ok {
+		return validResponse.VisitPatchProfileColorResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileDigestUnsubscribe operation middleware
+func (sh *strictHandler) GetProfileDigestUnsubscribe(ctx echo.Context, params GetProfileDigestUnsubscribeParams) error {
+	var request GetProfileDigestUnsubscribeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileDigestUnsubscribe(ctx.Request().Context(), request.(GetProfileDigestUnsubscribeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileDigestUnsubscribe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileDigestUnsubscribeResponseObject); ok {
+		return validResponse.VisitGetProfileDigestUnsubscribeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileDigestUnsubscribe operation middleware
+func (sh *strictHandler) PostProfileDigestUnsubscribe(ctx echo.Context, params PostProfileDigestUnsubscribeParams) error {
+	var request PostProfileDigestUnsubscribeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileDigestUnsubscribe(ctx.Request().Context(), request.(PostProfileDigestUnsubscribeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileDigestUnsubscribe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileDigestUnsubscribeResponseObject); ok {
+		return validResponse.VisitPostProfileDigestUnsubscribeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileDislike operation middleware
+func (sh *strictHandler) PostProfileDislike(ctx echo.Context) error {
+	var request PostProfileDislikeRequestObject
+
+	var body PostProfileDislikeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileDislike(ctx.Request().Context(), request.(PostProfileDislikeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileDislike")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileDislikeResponseObject); ok {
+		return validResponse.VisitPostProfileDislikeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchProfileFio operation middleware
+func (sh *strictHandler) PatchProfileFio(ctx echo.Context) error {
+	var request PatchProfileFioRequestObject
+
+	var body PatchProfileFioJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProfileFio(ctx.Request().Context(), request.(PatchProfileFioRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProfileFio")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchProfileFioResponseObject); ok {
+		return validResponse.VisitPatchProfileFioResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileInvite operation middleware
+func (sh *strictHandler) GetProfileInvite(ctx echo.Context) error {
+	var request GetProfileInviteRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileInvite(ctx.Request().Context(), request.(GetProfileInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileInviteResponseObject); ok {
+		return validResponse.VisitGetProfileInviteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchProfileInviteUUIDAccept operation middleware
+func (sh *strictHandler) PatchProfileInviteUUIDAccept(ctx echo.Context, uUID Uuid) error {
+	var request PatchProfileInviteUUIDAcceptRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProfileInviteUUIDAccept(ctx.Request().Context(), request.(PatchProfileInviteUUIDAcceptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProfileInviteUUIDAccept")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchProfileInviteUUIDAcceptResponseObject); ok {
+		return validResponse.VisitPatchProfileInviteUUIDAcceptResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchProfileInviteUUIDDecline operation middleware
+func (sh *strictHandler) PatchProfileInviteUUIDDecline(ctx echo.Context, uUID Uuid) error {
+	var request PatchProfileInviteUUIDDeclineRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProfileInviteUUIDDecline(ctx.Request().Context(), request.(PatchProfileInviteUUIDDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProfileInviteUUIDDecline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchProfileInviteUUIDDeclineResponseObject); ok {
+		return validResponse.VisitPatchProfileInviteUUIDDeclineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileLike operation middleware
+func (sh *strictHandler) PostProfileLike(ctx echo.Context) error {
+	var request PostProfileLikeRequestObject
+
+	var body PostProfileLikeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileLike(ctx.Request().Context(), request.(PostProfileLikeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileLike")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileLikeResponseObject); ok {
+		return validResponse.VisitPostProfileLikeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetProfileLikes operation middleware
+func (sh *strictHandler) GetProfileLikes(ctx echo.Context) error {
+	var request GetProfileLikesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileLikes(ctx.Request().Context(), request.(GetProfileLikesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileLikes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileLikesResponseObject); ok {
+		return validResponse.VisitGetProfileLikesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileLogin operation middleware
+func (sh *strictHandler) PostProfileLogin(ctx echo.Context) error {
+	var request PostProfileLoginRequestObject
+
+	var body PostProfileLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileLogin(ctx.Request().Context(), request.(PostProfileLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileLoginResponseObject); ok {
+		return validResponse.VisitPostProfileLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileLoginAs operation middleware
+func (sh *strictHandler) PostProfileLoginAs(ctx echo.Context) error {
+	var request PostProfileLoginAsRequestObject
+
+	var body PostProfileLoginAsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileLoginAs(ctx.Request().Context(), request.(PostProfileLoginAsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileLoginAs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileLoginAsResponseObject); ok {
+		return validResponse.VisitPostProfileLoginAsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// DeleteProfile operation middleware
-func (sh *strictHandler) DeleteProfile(ctx echo.Context) error {
-	var request DeleteProfileRequestObject
+// GetProfileLogout operation middleware
+func (sh *strictHandler) GetProfileLogout(ctx echo.Context) error {
+	var request GetProfileLogoutRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProfile(ctx.Request().Context(), request.(DeleteProfileRequestObject))
+		return sh.ssi.GetProfileLogout(ctx.Request().Context(), request.(GetProfileLogoutRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProfile")
+		handler = middleware(handler, "GetProfileLogout")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProfileResponseObject); ok {
-		return validResponse.VisitDeleteProfileResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileLogoutResponseObject); ok {
+		return validResponse.VisitGetProfileLogoutResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfile operation middleware
-func (sh *strictHandler) GetProfile(ctx echo.Context) error {
-	var request GetProfileRequestObject
+// GetProfileMailboxes operation middleware
+func (sh *strictHandler) GetProfileMailboxes(ctx echo.Context) error {
+	var request GetProfileMailboxesRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfile(ctx.Request().Context(), request.(GetProfileRequestObject))
+		return sh.ssi.GetProfileMailboxes(ctx.Request().Context(), request.(GetProfileMailboxesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfile")
+		handler = middleware(handler, "GetProfileMailboxes")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileResponseObject); ok {
-		return validResponse.VisitGetProfileResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileMailboxesResponseObject); ok {
+		return validResponse.VisitGetProfileMailboxesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfile operation middleware
-func (sh *strictHandler) PostProfile(ctx echo.Context) error {
-	var request PostProfileRequestObject
+// PostProfileMailboxes operation middleware
+func (sh *strictHandler) PostProfileMailboxes(ctx echo.Context) error {
+	var request PostProfileMailboxesRequestObject
 
-	var body PostProfileJSONRequestBody
+	var body PostProfileMailboxesJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfile(ctx.Request().Context(), request.(PostProfileRequestObject))
+		return sh.ssi.PostProfileMailboxes(ctx.Request().Context(), request.(PostProfileMailboxesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfile")
+		handler = middleware(handler, "PostProfileMailboxes")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileResponseObject); ok {
-		return validResponse.VisitPostProfileResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileMailboxesResponseObject); ok {
+		return validResponse.VisitPostProfileMailboxesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProfileColor operation middleware
-func (sh *strictHandler) PatchProfileColor(ctx echo.Context) error {
-	var request PatchProfileColorRequestObject
+// GetProfileMailboxesEmails operation middleware
+func (sh *strictHandler) GetProfileMailboxesEmails(ctx echo.Context, params GetProfileMailboxesEmailsParams) error {
+	var request GetProfileMailboxesEmailsRequestObject
 
-	var body PatchProfileColorJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProfileColor(ctx.Request().Context(), request.(PatchProfileColorRequestObject))
+		return sh.ssi.GetProfileMailboxesEmails(ctx.Request().Context(), request.(GetProfileMailboxesEmailsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProfileColor")
+		handler = middleware(handler, "GetProfileMailboxesEmails")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProfileColorResponseObject); ok {
-		return validResponse.VisitPatchProfileColorResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileMailboxesEmailsResponseObject); ok {
+		return validResponse.VisitGetProfileMailboxesEmailsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileDislike operation middleware
-func (sh *strictHandler) PostProfileDislike(ctx echo.Context) error {
-	var request PostProfileDislikeRequestObject
+// DeleteProfileMailboxesUUID operation middleware
+func (sh *strictHandler) DeleteProfileMailboxesUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProfileMailboxesUUIDRequestObject
 
-	var body PostProfileDislikeJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileDislike(ctx.Request().Context(), request.(PostProfileDislikeRequestObject))
+		return sh.ssi.DeleteProfileMailboxesUUID(ctx.Request().Context(), request.(DeleteProfileMailboxesUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileDislike")
+		handler = middleware(handler, "DeleteProfileMailboxesUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileDislikeResponseObject); ok {
-		return validResponse.VisitPostProfileDislikeResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileMailboxesUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProfileMailboxesUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProfileFio operation middleware
-func (sh *strictHandler) PatchProfileFio(ctx echo.Context) error {
-	var request PatchProfileFioRequestObject
+// PatchProfileMailboxesUUID operation middleware
+func (sh *strictHandler) PatchProfileMailboxesUUID(ctx echo.Context, uUID Uuid) error {
+	var request PatchProfileMailboxesUUIDRequestObject
 
-	var body PatchProfileFioJSONRequestBody
+	request.UUID = uUID
+
+	var body PatchProfileMailboxesUUIDJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProfileFio(ctx.Request().Context(), request.(PatchProfileFioRequestObject))
+		return sh.ssi.PatchProfileMailboxesUUID(ctx.Request().Context(), request.(PatchProfileMailboxesUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProfileFio")
+		handler = middleware(handler, "PatchProfileMailboxesUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProfileFioResponseObject); ok {
-		return validResponse.VisitPatchProfileFioResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchProfileMailboxesUUIDResponseObject); ok {
+		return validResponse.VisitPatchProfileMailboxesUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileInvite operation middleware
-func (sh *strictHandler) GetProfileInvite(ctx echo.Context) error {
-	var request GetProfileInviteRequestObject
+// PostProfileMailboxesUUIDFetch operation middleware
+func (sh *strictHandler) PostProfileMailboxesUUIDFetch(ctx echo.Context, uUID Uuid) error {
+	var request PostProfileMailboxesUUIDFetchRequestObject
+
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileInvite(ctx.Request().Context(), request.(GetProfileInviteRequestObject))
+		return sh.ssi.PostProfileMailboxesUUIDFetch(ctx.Request().Context(), request.(PostProfileMailboxesUUIDFetchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileInvite")
+		handler = middleware(handler, "PostProfileMailboxesUUIDFetch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileInviteResponseObject); ok {
-		return validResponse.VisitGetProfileInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileMailboxesUUIDFetchResponseObject); ok {
+		return validResponse.VisitPostProfileMailboxesUUIDFetchResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProfileInviteUUIDAccept operation middleware
-func (sh *strictHandler) PatchProfileInviteUUIDAccept(ctx echo.Context, uUID Uuid) error {
-	var request PatchProfileInviteUUIDAcceptRequestObject
+// PostProfileMailboxesUUIDSend operation middleware
+func (sh *strictHandler) PostProfileMailboxesUUIDSend(ctx echo.Context, uUID Uuid) error {
+	var request PostProfileMailboxesUUIDSendRequestObject
 
 	request.UUID = uUID
 
+	var body PostProfileMailboxesUUIDSendJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProfileInviteUUIDAccept(ctx.Request().Context(), request.(PatchProfileInviteUUIDAcceptRequestObject))
+		return sh.ssi.PostProfileMailboxesUUIDSend(ctx.Request().Context(), request.(PostProfileMailboxesUUIDSendRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProfileInviteUUIDAccept")
+		handler = middleware(handler, "PostProfileMailboxesUUIDSend")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProfileInviteUUIDAcceptResponseObject); ok {
-		return validResponse.VisitPatchProfileInviteUUIDAcceptResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileMailboxesUUIDSendResponseObject); ok {
+		return validResponse.VisitPostProfileMailboxesUUIDSendResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchProfileInviteUUIDDecline operation middleware
-func (sh *strictHandler) PatchProfileInviteUUIDDecline(ctx echo.Context, uUID Uuid) error {
-	var request PatchProfileInviteUUIDDeclineRequestObject
+// GetProfileMailboxesUUIDSent operation middleware
+func (sh *strictHandler) GetProfileMailboxesUUIDSent(ctx echo.Context, uUID Uuid, params GetProfileMailboxesUUIDSentParams) error {
+	var request GetProfileMailboxesUUIDSentRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchProfileInviteUUIDDecline(ctx.Request().Context(), request.(PatchProfileInviteUUIDDeclineRequestObject))
+		return sh.ssi.GetProfileMailboxesUUIDSent(ctx.Request().Context(), request.(GetProfileMailboxesUUIDSentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchProfileInviteUUIDDecline")
+		handler = middleware(handler, "GetProfileMailboxesUUIDSent")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchProfileInviteUUIDDeclineResponseObject); ok {
-		return validResponse.VisitPatchProfileInviteUUIDDeclineResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileMailboxesUUIDSentResponseObject); ok {
+		return validResponse.VisitGetProfileMailboxesUUIDSentResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileLike operation middleware
-func (sh *strictHandler) PostProfileLike(ctx echo.Context) error {
-	var request PostProfileLikeRequestObject
+// GetProfileMentions operation middleware
+func (sh *strictHandler) GetProfileMentions(ctx echo.Context, params GetProfileMentionsParams) error {
+	var request GetProfileMentionsRequestObject
 
-	var body PostProfileLikeJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileMentions(ctx.Request().Context(), request.(GetProfileMentionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileMentions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetProfileMentionsResponseObject); ok {
+		return validResponse.VisitGetProfileMentionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// DeleteProfileNotifications operation middleware
+func (sh *strictHandler) DeleteProfileNotifications(ctx echo.Context) error {
+	var request DeleteProfileNotificationsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileLike(ctx.Request().Context(), request.(PostProfileLikeRequestObject))
+		return sh.ssi.DeleteProfileNotifications(ctx.Request().Context(), request.(DeleteProfileNotificationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileLike")
+		handler = middleware(handler, "DeleteProfileNotifications")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileLikeResponseObject); ok {
-		return validResponse.VisitPostProfileLikeResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileNotificationsResponseObject); ok {
+		return validResponse.VisitDeleteProfileNotificationsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileLikes operation middleware
-func (sh *strictHandler) GetProfileLikes(ctx echo.Context) error {
-	var request GetProfileLikesRequestObject
+// GetProfileNotifications operation middleware
+func (sh *strictHandler) GetProfileNotifications(ctx echo.Context) error {
+	var request GetProfileNotificationsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileLikes(ctx.Request().Context(), request.(GetProfileLikesRequestObject))
+		return sh.ssi.GetProfileNotifications(ctx.Request().Context(), request.(GetProfileNotificationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileLikes")
+		handler = middleware(handler, "GetProfileNotifications")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileLikesResponseObject); ok {
-		return validResponse.VisitGetProfileLikesResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileNotificationsResponseObject); ok {
+		return validResponse.VisitGetProfileNotificationsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileLogin operation middleware
-func (sh *strictHandler) PostProfileLogin(ctx echo.Context) error {
-	var request PostProfileLoginRequestObject
+// GetProfileNotificationsDeliveries operation middleware
+func (sh *strictHandler) GetProfileNotificationsDeliveries(ctx echo.Context, params GetProfileNotificationsDeliveriesParams) error {
+	var request GetProfileNotificationsDeliveriesRequestObject
 
-	var body PostProfileLoginJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfileNotificationsDeliveries(ctx.Request().Context(), request.(GetProfileNotificationsDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfileNotificationsDeliveries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetProfileNotificationsDeliveriesResponseObject); ok {
+		return validResponse.VisitGetProfileNotificationsDeliveriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetProfileNotificationsHistory operation middleware
+func (sh *strictHandler) GetProfileNotificationsHistory(ctx echo.Context, params GetProfileNotificationsHistoryParams) error {
+	var request GetProfileNotificationsHistoryRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileLogin(ctx.Request().Context(), request.(PostProfileLoginRequestObject))
+		return sh.ssi.GetProfileNotificationsHistory(ctx.Request().Context(), request.(GetProfileNotificationsHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileLogin")
+		handler = middleware(handler, "GetProfileNotificationsHistory")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileLoginResponseObject); ok {
-		return validResponse.VisitPostProfileLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileNotificationsHistoryResponseObject); ok {
+		return validResponse.VisitGetProfileNotificationsHistoryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostProfileLoginAs operation middleware
-func (sh *strictHandler) PostProfileLoginAs(ctx echo.Context) error {
-	var request PostProfileLoginAsRequestObject
+// PostProfileNotificationsRebuild operation middleware
+func (sh *strictHandler) PostProfileNotificationsRebuild(ctx echo.Context) error {
+	var request PostProfileNotificationsRebuildRequestObject
 
-	var body PostProfileLoginAsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileNotificationsRebuild(ctx.Request().Context(), request.(PostProfileNotificationsRebuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileNotificationsRebuild")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(PostProfileNotificationsRebuildResponseObject); ok {
+		return validResponse.VisitPostProfileNotificationsRebuildResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetProfileNotificationsSettings operation middleware
+func (sh *strictHandler) GetProfileNotificationsSettings(ctx echo.Context) error {
+	var request GetProfileNotificationsSettingsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProfileLoginAs(ctx.Request().Context(), request.(PostProfileLoginAsRequestObject))
+		return sh.ssi.GetProfileNotificationsSettings(ctx.Request().Context(), request.(GetProfileNotificationsSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProfileLoginAs")
+		handler = middleware(handler, "GetProfileNotificationsSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostProfileLoginAsResponseObject); ok {
-		return validResponse.VisitPostProfileLoginAsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileNotificationsSettingsResponseObject); ok {
+		return validResponse.VisitGetProfileNotificationsSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileLogout operation middleware
-func (sh *strictHandler) GetProfileLogout(ctx echo.Context) error {
-	var request GetProfileLogoutRequestObject
+// PutProfileNotificationsSettings operation middleware
+func (sh *strictHandler) PutProfileNotificationsSettings(ctx echo.Context) error {
+	var request PutProfileNotificationsSettingsRequestObject
+
+	var body PutProfileNotificationsSettingsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileLogout(ctx.Request().Context(), request.(GetProfileLogoutRequestObject))
+		return sh.ssi.PutProfileNotificationsSettings(ctx.Request().Context(), request.(PutProfileNotificationsSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileLogout")
+		handler = middleware(handler, "PutProfileNotificationsSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileLogoutResponseObject); ok {
-		return validResponse.VisitGetProfileLogoutResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutProfileNotificationsSettingsResponseObject); ok {
+		return validResponse.VisitPutProfileNotificationsSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteProfileNotifications operation middleware
-func (sh *strictHandler) DeleteProfileNotifications(ctx echo.Context) error {
-	var request DeleteProfileNotificationsRequestObject
+// DeleteProfileNotificationsSettingsProjectUUID operation middleware
+func (sh *strictHandler) DeleteProfileNotificationsSettingsProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProfileNotificationsSettingsProjectUUIDRequestObject
+
+	request.UUID = uUID
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProfileNotifications(ctx.Request().Context(), request.(DeleteProfileNotificationsRequestObject))
+		return sh.ssi.DeleteProfileNotificationsSettingsProjectUUID(ctx.Request().Context(), request.(DeleteProfileNotificationsSettingsProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProfileNotifications")
+		handler = middleware(handler, "DeleteProfileNotificationsSettingsProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteProfileNotificationsResponseObject); ok {
-		return validResponse.VisitDeleteProfileNotificationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileNotificationsSettingsProjectUUIDResponseObject); ok {
+		return validResponse.VisitDeleteProfileNotificationsSettingsProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfileNotifications operation middleware
-func (sh *strictHandler) GetProfileNotifications(ctx echo.Context) error {
-	var request GetProfileNotificationsRequestObject
+// PutProfileNotificationsSettingsProjectUUID operation middleware
+func (sh *strictHandler) PutProfileNotificationsSettingsProjectUUID(ctx echo.Context, uUID Uuid) error {
+	var request PutProfileNotificationsSettingsProjectUUIDRequestObject
+
+	request.UUID = uUID
+
+	var body PutProfileNotificationsSettingsProjectUUIDJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfileNotifications(ctx.Request().Context(), request.(GetProfileNotificationsRequestObject))
+		return sh.ssi.PutProfileNotificationsSettingsProjectUUID(ctx.Request().Context(), request.(PutProfileNotificationsSettingsProjectUUIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfileNotifications")
+		handler = middleware(handler, "PutProfileNotificationsSettingsProjectUUID")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileNotificationsResponseObject); ok {
-		return validResponse.VisitGetProfileNotificationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutProfileNotificationsSettingsProjectUUIDResponseObject); ok {
+		return validResponse.VisitPutProfileNotificationsSettingsProjectUUIDResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -1872,6 +4260,56 @@ func (sh *strictHandler) PostProfileNotificationsTaskUUIDHide(ctx echo.Context,
 	return nil
 }
 
+// DeleteProfileNotificationsTaskUUIDRead operation middleware
+func (sh *strictHandler) DeleteProfileNotificationsTaskUUIDRead(ctx echo.Context, uUID Uuid) error {
+	var request DeleteProfileNotificationsTaskUUIDReadRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProfileNotificationsTaskUUIDRead(ctx.Request().Context(), request.(DeleteProfileNotificationsTaskUUIDReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProfileNotificationsTaskUUIDRead")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProfileNotificationsTaskUUIDReadResponseObject); ok {
+		return validResponse.VisitDeleteProfileNotificationsTaskUUIDReadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileNotificationsTaskUUIDRead operation middleware
+func (sh *strictHandler) PostProfileNotificationsTaskUUIDRead(ctx echo.Context, uUID Uuid) error {
+	var request PostProfileNotificationsTaskUUIDReadRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileNotificationsTaskUUIDRead(ctx.Request().Context(), request.(PostProfileNotificationsTaskUUIDReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileNotificationsTaskUUIDRead")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileNotificationsTaskUUIDReadResponseObject); ok {
+		return validResponse.VisitPostProfileNotificationsTaskUUIDReadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteProfileNotificationsTaskUUIDStar operation middleware
 func (sh *strictHandler) DeleteProfileNotificationsTaskUUIDStar(ctx echo.Context, uUID Uuid) error {
 	var request DeleteProfileNotificationsTaskUUIDStarRequestObject
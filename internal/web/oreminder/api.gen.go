@@ -23,27 +23,41 @@ const (
 
 // ReminderCreateRequest defines model for ReminderCreateRequest.
 type ReminderCreateRequest struct {
-	DateFrom    *time.Time          `json:"date_from,omitempty"`
-	DateTo      *time.Time          `json:"date_to,omitempty"`
-	Description string              `json:"description" validate:"trim,name,min=0,max=2000"`
-	TaskUuid    openapi_types.UUID  `json:"task_uuid" validate:"uuid"`
-	Type        string              `json:"type" validate:"trim,name,min=0,max=50"`
-	UserUuid    *openapi_types.UUID `json:"user_uuid,omitempty"`
+	DateFrom *time.Time `json:"date_from,omitempty"`
+	DateTo   *time.Time `json:"date_to,omitempty"`
+
+	// DeadlineOffset Minutes relative to task deadline, negative - before deadline
+	DeadlineOffset *int                   `json:"deadline_offset,omitempty" validate:"omitempty,min=-525600,max=525600"`
+	Description    string                 `json:"description" validate:"trim,name,min=0,max=2000"`
+	Recurrence     *ReminderRecurrenceDTO `json:"recurrence,omitempty"`
+	TaskUuid       openapi_types.UUID     `json:"task_uuid" validate:"uuid"`
+	Type           string                 `json:"type" validate:"trim,name,min=0,max=50"`
+	UserUuid       *openapi_types.UUID    `json:"user_uuid,omitempty"`
 }
 
 // ReminderDTO defines model for ReminderDTO.
 type ReminderDTO = dto.ReminderDTO
 
+// ReminderDispatchDTO defines model for ReminderDispatchDTO.
+type ReminderDispatchDTO = dto.ReminderDispatchDTO
+
 // ReminderPutRequest defines model for ReminderPutRequest.
 type ReminderPutRequest struct {
-	Comment     string              `json:"comment" validate:"trim,min=0,max=5000"`
-	DateFrom    *time.Time          `json:"date_from,omitempty"`
-	DateTo      *time.Time          `json:"date_to,omitempty"`
-	Description string              `json:"description" validate:"trim,name,min=0,max=2000"`
-	Type        string              `json:"type" validate:"trim,name,min=0,max=50"`
-	UserUuid    *openapi_types.UUID `json:"user_uuid,omitempty"`
+	Comment  string     `json:"comment" validate:"trim,min=0,max=5000"`
+	DateFrom *time.Time `json:"date_from,omitempty"`
+	DateTo   *time.Time `json:"date_to,omitempty"`
+
+	// DeadlineOffset Minutes relative to task deadline, negative - before deadline
+	DeadlineOffset *int                   `json:"deadline_offset,omitempty" validate:"omitempty,min=-525600,max=525600"`
+	Description    string                 `json:"description" validate:"trim,name,min=0,max=2000"`
+	Recurrence     *ReminderRecurrenceDTO `json:"recurrence,omitempty"`
+	Type           string                 `json:"type" validate:"trim,name,min=0,max=50"`
+	UserUuid       *openapi_types.UUID    `json:"user_uuid,omitempty"`
 }
 
+// ReminderRecurrenceDTO defines model for ReminderRecurrenceDTO.
+type ReminderRecurrenceDTO = dto.ReminderRecurrenceDTO
+
 // StatusRequest defines model for StatusRequest.
 type StatusRequest struct {
 	Comment string `json:"comment" validate:"trim,min=0,max=300"`
@@ -61,12 +75,21 @@ type EntityUUID = openapi_types.UUID
 // Uuid defines model for uuid.
 type Uuid = openapi_types.UUID
 
+// PostReminderUUIDSnoozeJSONBody defines parameters for PostReminderUUIDSnooze.
+type PostReminderUUIDSnoozeJSONBody struct {
+	// Until 10m, 1h or tomorrow (9:00 in user's timezone)
+	Until string `json:"until" validate:"oneof=10m 1h tomorrow"`
+}
+
 // PostReminderJSONRequestBody defines body for PostReminder for application/json ContentType.
 type PostReminderJSONRequestBody = ReminderCreateRequest
 
 // PutReminderUUIDJSONRequestBody defines body for PutReminderUUID for application/json ContentType.
 type PutReminderUUIDJSONRequestBody = ReminderPutRequest
 
+// PostReminderUUIDSnoozeJSONRequestBody defines body for PostReminderUUIDSnooze for application/json ContentType.
+type PostReminderUUIDSnoozeJSONRequestBody = PostReminderUUIDSnoozeJSONBody
+
 // PatchReminderUUIDStatusJSONRequestBody defines body for PatchReminderUUIDStatus for application/json ContentType.
 type PatchReminderUUIDStatusJSONRequestBody = StatusRequest
 
@@ -79,12 +102,21 @@ type ServerInterface interface {
 	// (POST /reminder)
 	PostReminder(ctx echo.Context) error
 
+	// (GET /reminder/dead-letters)
+	GetReminderDeadLetters(ctx echo.Context) error
+
+	// (POST /reminder/dead-letters/{UUID}/retry)
+	PostReminderDeadLettersUUIDRetry(ctx echo.Context, uUID Uuid) error
+
 	// (DELETE /reminder/{UUID})
 	DeleteReminderUUID(ctx echo.Context, uUID Uuid) error
 
 	// (PUT /reminder/{UUID})
 	PutReminderUUID(ctx echo.Context, uUID Uuid) error
 
+	// (POST /reminder/{UUID}/snooze)
+	PostReminderUUIDSnooze(ctx echo.Context, uUID Uuid) error
+
 	// (PATCH /reminder/{UUID}/status)
 	PatchReminderUUIDStatus(ctx echo.Context, uUID Uuid) error
 }
@@ -116,6 +148,35 @@ func (w *ServerInterfaceWrapper) PostReminder(ctx echo.Context) error {
 	return err
 }
 
+// GetReminderDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReminderDeadLetters(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReminderDeadLetters(ctx)
+	return err
+}
+
+// PostReminderDeadLettersUUIDRetry converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReminderDeadLettersUUIDRetry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReminderDeadLettersUUIDRetry(ctx, uUID)
+	return err
+}
+
 // DeleteReminderUUID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteReminderUUID(ctx echo.Context) error {
 	var err error
@@ -152,6 +213,24 @@ func (w *ServerInterfaceWrapper) PutReminderUUID(ctx echo.Context) error {
 	return err
 }
 
+// PostReminderUUIDSnooze converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReminderUUIDSnooze(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostReminderUUIDSnooze(ctx, uUID)
+	return err
+}
+
 // PatchReminderUUIDStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchReminderUUIDStatus(ctx echo.Context) error {
 	var err error
@@ -200,8 +279,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/reminder", wrapper.GetReminder)
 	router.POST(baseURL+"/reminder", wrapper.PostReminder)
+	router.GET(baseURL+"/reminder/dead-letters", wrapper.GetReminderDeadLetters)
+	router.POST(baseURL+"/reminder/dead-letters/:UUID/retry", wrapper.PostReminderDeadLettersUUIDRetry)
 	router.DELETE(baseURL+"/reminder/:UUID", wrapper.DeleteReminderUUID)
 	router.PUT(baseURL+"/reminder/:UUID", wrapper.PutReminderUUID)
+	router.POST(baseURL+"/reminder/:UUID/snooze", wrapper.PostReminderUUIDSnooze)
 	router.PATCH(baseURL+"/reminder/:UUID/status", wrapper.PatchReminderUUIDStatus)
 
 }
@@ -242,6 +324,41 @@ func (response PostReminder200JSONResponse) VisitPostReminderResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetReminderDeadLettersRequestObject struct {
+}
+
+type GetReminderDeadLettersResponseObject interface {
+	VisitGetReminderDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetReminderDeadLetters200JSONResponse struct {
+	Count int                   `json:"count"`
+	Items []ReminderDispatchDTO `json:"items"`
+}
+
+func (response GetReminderDeadLetters200JSONResponse) VisitGetReminderDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReminderDeadLettersUUIDRetryRequestObject struct {
+	UUID Uuid `json:"UUID"`
+}
+
+type PostReminderDeadLettersUUIDRetryResponseObject interface {
+	VisitPostReminderDeadLettersUUIDRetryResponse(w http.ResponseWriter) error
+}
+
+type PostReminderDeadLettersUUIDRetry200Response struct {
+}
+
+func (response PostReminderDeadLettersUUIDRetry200Response) VisitPostReminderDeadLettersUUIDRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
 type DeleteReminderUUIDRequestObject struct {
 	UUID Uuid `json:"UUID"`
 }
@@ -275,6 +392,26 @@ func (response PutReminderUUID200Response) VisitPutReminderUUIDResponse(w http.R
 	return nil
 }
 
+type PostReminderUUIDSnoozeRequestObject struct {
+	UUID Uuid `json:"UUID"`
+	Body *PostReminderUUIDSnoozeJSONRequestBody
+}
+
+type PostReminderUUIDSnoozeResponseObject interface {
+	VisitPostReminderUUIDSnoozeResponse(w http.ResponseWriter) error
+}
+
+type PostReminderUUIDSnooze200JSONResponse struct {
+	SnoozedUntil time.Time `json:"snoozed_until"`
+}
+
+func (response PostReminderUUIDSnooze200JSONResponse) VisitPostReminderUUIDSnoozeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchReminderUUIDStatusRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchReminderUUIDStatusJSONRequestBody
@@ -301,12 +438,21 @@ type StrictServerInterface interface {
 	// (POST /reminder)
 	PostReminder(ctx context.Context, request PostReminderRequestObject) (PostReminderResponseObject, error)
 
+	// (GET /reminder/dead-letters)
+	GetReminderDeadLetters(ctx context.Context, request GetReminderDeadLettersRequestObject) (GetReminderDeadLettersResponseObject, error)
+
+	// (POST /reminder/dead-letters/{UUID}/retry)
+	PostReminderDeadLettersUUIDRetry(ctx context.Context, request PostReminderDeadLettersUUIDRetryRequestObject) (PostReminderDeadLettersUUIDRetryResponseObject, error)
+
 	// (DELETE /reminder/{UUID})
 	DeleteReminderUUID(ctx context.Context, request DeleteReminderUUIDRequestObject) (DeleteReminderUUIDResponseObject, error)
 
 	// (PUT /reminder/{UUID})
 	PutReminderUUID(ctx context.Context, request PutReminderUUIDRequestObject) (PutReminderUUIDResponseObject, error)
 
+	// (POST /reminder/{UUID}/snooze)
+	PostReminderUUIDSnooze(ctx context.Context, request PostReminderUUIDSnoozeRequestObject) (PostReminderUUIDSnoozeResponseObject, error)
+
 	// (PATCH /reminder/{UUID}/status)
 	PatchReminderUUIDStatus(ctx context.Context, request PatchReminderUUIDStatusRequestObject) (PatchReminderUUIDStatusResponseObject, error)
 }
@@ -375,6 +521,54 @@ func (sh *strictHandler) PostReminder(ctx echo.Context) error {
 	return nil
 }
 
+// GetReminderDeadLetters operation middleware
+func (sh *strictHandler) GetReminderDeadLetters(ctx echo.Context) error {
+	var request GetReminderDeadLettersRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReminderDeadLetters(ctx.Request().Context(), request.(GetReminderDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReminderDeadLetters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReminderDeadLettersResponseObject); ok {
+		return validResponse.VisitGetReminderDeadLettersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostReminderDeadLettersUUIDRetry operation middleware
+func (sh *strictHandler) PostReminderDeadLettersUUIDRetry(ctx echo.Context, uUID Uuid) error {
+	var request PostReminderDeadLettersUUIDRetryRequestObject
+
+	request.UUID = uUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReminderDeadLettersUUIDRetry(ctx.Request().Context(), request.(PostReminderDeadLettersUUIDRetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReminderDeadLettersUUIDRetry")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostReminderDeadLettersUUIDRetryResponseObject); ok {
+		return validResponse.VisitPostReminderDeadLettersUUIDRetryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteReminderUUID operation middleware
 func (sh *strictHandler) DeleteReminderUUID(ctx echo.Context, uUID Uuid) error {
 	var request DeleteReminderUUIDRequestObject
@@ -431,6 +625,37 @@ func (sh *strictHandler) PutReminderUUID(ctx echo.Context, uUID Uuid) error {
 	return nil
 }
 
+// PostReminderUUIDSnooze operation middleware
+func (sh *strictHandler) PostReminderUUIDSnooze(ctx echo.Context, uUID Uuid) error {
+	var request PostReminderUUIDSnoozeRequestObject
+
+	request.UUID = uUID
+
+	var body PostReminderUUIDSnoozeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReminderUUIDSnooze(ctx.Request().Context(), request.(PostReminderUUIDSnoozeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReminderUUIDSnooze")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostReminderUUIDSnoozeResponseObject); ok {
+		return validResponse.VisitPostReminderUUIDSnoozeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchReminderUUIDStatus operation middleware
 func (sh *strictHandler) PatchReminderUUIDStatus(ctx echo.Context, uUID Uuid) error {
 	var request PatchReminderUUIDStatusRequestObject
@@ -30,6 +30,23 @@ type ActivityDTO = dto.ActivityDTO
 // CommentDTO defines model for CommentDTO.
 type CommentDTO = dto.CommentDTO
 
+// CommentReactionDTO defines model for CommentReactionDTO.
+type CommentReactionDTO = dto.CommentReactionDTO
+
+// CommentReactionRequest defines model for CommentReactionRequest.
+type CommentReactionRequest struct {
+	Emoji string `json:"emoji" validate:"trim,min=1,max=40"`
+}
+
+// CommentToTaskRequest defines model for CommentToTaskRequest.
+type CommentToTaskRequest struct {
+	Mode string  `json:"mode" validate:"oneof=subtask sibling"`
+	Name *string `json:"name,omitempty" validate:"omitempty,trim,min=3,max=100"`
+}
+
+// CommentVersionDTO defines model for CommentVersionDTO.
+type CommentVersionDTO = dto.CommentVersionDTO
+
 // NameRequest defines model for NameRequest.
 type NameRequest struct {
 	Name string `json:"name" validate:"trim,name,min=0,max=100"`
@@ -76,6 +93,9 @@ type TaskPutRequest struct {
 	Tags        *[]string               `json:"tags,omitempty" validate:"dive,trim,name,max=40"`
 }
 
+// TextDiffDTO defines model for TextDiffDTO.
+type TextDiffDTO = dto.TextDiffDTO
+
 // UploadDTO defines model for UploadDTO.
 type UploadDTO = dto.UploadDTO
 
@@ -110,12 +130,24 @@ type GetTaskParams struct {
 	Format         *string            `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetTaskUUIDParams defines parameters for GetTaskUUID.
+type GetTaskUUIDParams struct {
+	Html *bool `form:"html,omitempty" json:"html,omitempty"`
+}
+
 // GetTaskUUIDActivityParams defines parameters for GetTaskUUIDActivity.
 type GetTaskUUIDActivityParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetTaskUUIDCommentParams defines parameters for GetTaskUUIDComment.
+type GetTaskUUIDCommentParams struct {
+	Collapsed *bool               `form:"collapsed,omitempty" json:"collapsed,omitempty"`
+	Html      *bool               `form:"html,omitempty" json:"html,omitempty"`
+	Thread    *openapi_types.UUID `form:"thread,omitempty" json:"thread,omitempty"`
+}
+
 // PostTaskUUIDCommentMultipartBody defines parameters for PostTaskUUIDComment.
 type PostTaskUUIDCommentMultipartBody struct {
 	Comment   *string             `json:"comment,omitempty"`
@@ -132,6 +164,12 @@ type PatchTaskUUIDCommentEntityUUIDMultipartBody struct {
 	ReplyUuid *openapi_types.UUID `json:"reply_uuid,omitempty"`
 }
 
+// GetTaskUUIDCommentEntityUUIDDiffParams defines parameters for GetTaskUUIDCommentEntityUUIDDiff.
+type GetTaskUUIDCommentEntityUUIDDiffParams struct {
+	From *int `form:"from,omitempty" json:"from,omitempty"`
+	To   *int `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // PatchTaskUUIDParentJSONBody defines parameters for PatchTaskUUIDParent.
 type PatchTaskUUIDParentJSONBody struct {
 	Uuid *openapi_types.UUID `json:"uuid,omitempty" validate:"omitempty,uuid"`
@@ -175,6 +213,12 @@ type PostTaskUUIDCommentMultipartRequestBody PostTaskUUIDCommentMultipartBody
 // PatchTaskUUIDCommentEntityUUIDMultipartRequestBody defines body for PatchTaskUUIDCommentEntityUUID for multipart/form-data ContentType.
 type PatchTaskUUIDCommentEntityUUIDMultipartRequestBody PatchTaskUUIDCommentEntityUUIDMultipartBody
 
+// PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody defines body for PatchTaskUUIDCommentEntityUUIDReaction for application/json ContentType.
+type PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody = CommentReactionRequest
+
+// PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody defines body for PostTaskUUIDCommentEntityUUIDTask for application/json ContentType.
+type PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody = CommentToTaskRequest
+
 // PatchTaskUUIDNameJSONRequestBody defines body for PatchTaskUUIDName for application/json ContentType.
 type PatchTaskUUIDNameJSONRequestBody = NameRequest
 
@@ -209,7 +253,7 @@ type ServerInterface interface {
 	DeleteTaskUUID(ctx echo.Context, uUID Uuid) error
 
 	// (GET /task/{UUID})
-	GetTaskUUID(ctx echo.Context, uUID Uuid) error
+	GetTaskUUID(ctx echo.Context, uUID Uuid, params GetTaskUUIDParams) error
 
 	// (PUT /task/{UUID})
 	PutTaskUUID(ctx echo.Context, uUID Uuid) error
@@ -218,7 +262,7 @@ type ServerInterface interface {
 	GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params GetTaskUUIDActivityParams) error
 
 	// (GET /task/{UUID}/comment)
-	GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error
+	GetTaskUUIDComment(ctx echo.Context, uUID Uuid, params GetTaskUUIDCommentParams) error
 
 	// (POST /task/{UUID}/comment)
 	PostTaskUUIDComment(ctx echo.Context, uUID Uuid) error
@@ -229,6 +273,9 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID})
 	PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (GET /task/{UUID}/comment/{entityUUID}/diff)
+	GetTaskUUIDCommentEntityUUIDDiff(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetTaskUUIDCommentEntityUUIDDiffParams) error
+
 	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
 	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error
 
@@ -238,6 +285,15 @@ type ServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/reaction)
+	PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (POST /task/{UUID}/comment/{entityUUID}/task)
+	PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
+	// (GET /task/{UUID}/comment/{entityUUID}/versions)
+	GetTaskUUIDCommentEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error
 
@@ -437,8 +493,17 @@ func (w *ServerInterfaceWrapper) GetTaskUUID(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDParams
+	// ------------- Optional query parameter "html" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "html", ctx.QueryParams(), &params.Html)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter html: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUID(ctx, uUID)
+	err = w.Handler.GetTaskUUID(ctx, uUID, params)
 	return err
 }
 
@@ -507,8 +572,31 @@ func (w *ServerInterfaceWrapper) GetTaskUUIDComment(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDCommentParams
+	// ------------- Optional query parameter "collapsed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "collapsed", ctx.QueryParams(), &params.Collapsed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter collapsed: %s", err))
+	}
+
+	// ------------- Optional query parameter "html" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "html", ctx.QueryParams(), &params.Html)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter html: %s", err))
+	}
+
+	// ------------- Optional query parameter "thread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "thread", ctx.QueryParams(), &params.Thread)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTaskUUIDComment(ctx, uUID)
+	err = w.Handler.GetTaskUUIDComment(ctx, uUID, params)
 	return err
 }
 
@@ -582,6 +670,48 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUID(ctx echo.Context
 	return err
 }
 
+// GetTaskUUIDCommentEntityUUIDDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDCommentEntityUUIDDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskUUIDCommentEntityUUIDDiffParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDCommentEntityUUIDDiff(ctx, uUID, entityUUID, params)
+	return err
+}
+
 // DeleteTaskUUIDCommentEntityUUIDFileFileUUID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context) error {
 	var err error
@@ -668,6 +798,84 @@ func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Cont
 	return err
 }
 
+// PatchTaskUUIDCommentEntityUUIDReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTaskUUIDCommentEntityUUIDReaction(ctx, uUID, entityUUID)
+	return err
+}
+
+// PostTaskUUIDCommentEntityUUIDTask converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskUUIDCommentEntityUUIDTask(ctx, uUID, entityUUID)
+	return err
+}
+
+// GetTaskUUIDCommentEntityUUIDVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskUUIDCommentEntityUUIDVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "UUID" -------------
+	var uUID Uuid
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "UUID", runtime.ParamLocationPath, ctx.Param("UUID"), &uUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter UUID: %s", err))
+	}
+
+	// ------------- Path parameter "entityUUID" -------------
+	var entityUUID EntityUUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entityUUID", runtime.ParamLocationPath, ctx.Param("entityUUID"), &entityUUID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entityUUID: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskUUIDCommentEntityUUIDVersions(ctx, uUID, entityUUID)
+	return err
+}
+
 // PatchTaskUUIDName converts echo context to params.
 func (w *ServerInterfaceWrapper) PatchTaskUUIDName(ctx echo.Context) error {
 	var err error
@@ -936,9 +1144,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/task/:UUID/comment", wrapper.PostTaskUUIDComment)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.DeleteTaskUUIDCommentEntityUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID", wrapper.PatchTaskUUIDCommentEntityUUID)
+	router.GET(baseURL+"/task/:UUID/comment/:entityUUID/diff", wrapper.GetTaskUUIDCommentEntityUUIDDiff)
 	router.DELETE(baseURL+"/task/:UUID/comment/:entityUUID/file/:fileUUID", wrapper.DeleteTaskUUIDCommentEntityUUIDFileFileUUID)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/like", wrapper.PatchTaskUUIDCommentEntityUUIDLike)
 	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/pin", wrapper.PatchTaskUUIDCommentEntityUUIDPin)
+	router.PATCH(baseURL+"/task/:UUID/comment/:entityUUID/reaction", wrapper.PatchTaskUUIDCommentEntityUUIDReaction)
+	router.POST(baseURL+"/task/:UUID/comment/:entityUUID/task", wrapper.PostTaskUUIDCommentEntityUUIDTask)
+	router.GET(baseURL+"/task/:UUID/comment/:entityUUID/versions", wrapper.GetTaskUUIDCommentEntityUUIDVersions)
 	router.PATCH(baseURL+"/task/:UUID/name", wrapper.PatchTaskUUIDName)
 	router.PATCH(baseURL+"/task/:UUID/parent", wrapper.PatchTaskUUIDParent)
 	router.PATCH(baseURL+"/task/:UUID/project", wrapper.PatchTaskUUIDProject)
@@ -1046,7 +1258,8 @@ func (response DeleteTaskUUID200Response) VisitDeleteTaskUUIDResponse(w http.Res
 }
 
 type GetTaskUUIDRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDParams
 }
 
 type GetTaskUUIDResponseObject interface {
@@ -1110,7 +1323,8 @@ func (response GetTaskUUIDActivity200JSONResponse) VisitGetTaskUUIDActivityRespo
 }
 
 type GetTaskUUIDCommentRequestObject struct {
-	UUID Uuid `json:"UUID"`
+	UUID   Uuid `json:"UUID"`
+	Params GetTaskUUIDCommentParams
 }
 
 type GetTaskUUIDCommentResponseObject interface {
@@ -1195,6 +1409,29 @@ func (response PatchTaskUUIDCommentEntityUUID200JSONResponse) VisitPatchTaskUUID
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetTaskUUIDCommentEntityUUIDDiffRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Params     GetTaskUUIDCommentEntityUUIDDiffParams
+}
+
+type GetTaskUUIDCommentEntityUUIDDiffResponseObject interface {
+	VisitGetTaskUUIDCommentEntityUUIDDiffResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDCommentEntityUUIDDiff200JSONResponse struct {
+	Changes []TextDiffDTO     `json:"changes"`
+	From    CommentVersionDTO `json:"from"`
+	To      CommentVersionDTO `json:"to"`
+}
+
+func (response GetTaskUUIDCommentEntityUUIDDiff200JSONResponse) VisitGetTaskUUIDCommentEntityUUIDDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject struct {
 	UUID       Uuid       `json:"UUID"`
 	EntityUUID EntityUUID `json:"entityUUID"`
@@ -1251,6 +1488,71 @@ func (response PatchTaskUUIDCommentEntityUUIDPin200Response) VisitPatchTaskUUIDC
 	return nil
 }
 
+type PatchTaskUUIDCommentEntityUUIDReactionRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody
+}
+
+type PatchTaskUUIDCommentEntityUUIDReactionResponseObject interface {
+	VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(w http.ResponseWriter) error
+}
+
+type PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse struct {
+	Reacted   bool                 `json:"reacted"`
+	Reactions []CommentReactionDTO `json:"reactions"`
+}
+
+func (response PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse) VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskUUIDCommentEntityUUIDTaskRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+	Body       *PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody
+}
+
+type PostTaskUUIDCommentEntityUUIDTaskResponseObject interface {
+	VisitPostTaskUUIDCommentEntityUUIDTaskResponse(w http.ResponseWriter) error
+}
+
+type PostTaskUUIDCommentEntityUUIDTask200JSONResponse struct {
+	Id   int                `json:"id"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+func (response PostTaskUUIDCommentEntityUUIDTask200JSONResponse) VisitPostTaskUUIDCommentEntityUUIDTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskUUIDCommentEntityUUIDVersionsRequestObject struct {
+	UUID       Uuid       `json:"UUID"`
+	EntityUUID EntityUUID `json:"entityUUID"`
+}
+
+type GetTaskUUIDCommentEntityUUIDVersionsResponseObject interface {
+	VisitGetTaskUUIDCommentEntityUUIDVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetTaskUUIDCommentEntityUUIDVersions200JSONResponse struct {
+	Count int                 `json:"count"`
+	Items []CommentVersionDTO `json:"items"`
+}
+
+func (response GetTaskUUIDCommentEntityUUIDVersions200JSONResponse) VisitGetTaskUUIDCommentEntityUUIDVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchTaskUUIDNameRequestObject struct {
 	UUID Uuid `json:"UUID"`
 	Body *PatchTaskUUIDNameJSONRequestBody
@@ -1509,6 +1811,9 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID})
 	PatchTaskUUIDCommentEntityUUID(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDRequestObject) (PatchTaskUUIDCommentEntityUUIDResponseObject, error)
 
+	// (GET /task/{UUID}/comment/{entityUUID}/diff)
+	GetTaskUUIDCommentEntityUUIDDiff(ctx context.Context, request GetTaskUUIDCommentEntityUUIDDiffRequestObject) (GetTaskUUIDCommentEntityUUIDDiffResponseObject, error)
+
 	// (DELETE /task/{UUID}/comment/{entityUUID}/file/{fileUUID})
 	DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx context.Context, request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject) (DeleteTaskUUIDCommentEntityUUIDFileFileUUIDResponseObject, error)
 
@@ -1518,6 +1823,15 @@ type StrictServerInterface interface {
 	// (PATCH /task/{UUID}/comment/{entityUUID}/pin)
 	PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDPinRequestObject) (PatchTaskUUIDCommentEntityUUIDPinResponseObject, error)
 
+	// (PATCH /task/{UUID}/comment/{entityUUID}/reaction)
+	PatchTaskUUIDCommentEntityUUIDReaction(ctx context.Context, request PatchTaskUUIDCommentEntityUUIDReactionRequestObject) (PatchTaskUUIDCommentEntityUUIDReactionResponseObject, error)
+
+	// (POST /task/{UUID}/comment/{entityUUID}/task)
+	PostTaskUUIDCommentEntityUUIDTask(ctx context.Context, request PostTaskUUIDCommentEntityUUIDTaskRequestObject) (PostTaskUUIDCommentEntityUUIDTaskResponseObject, error)
+
+	// (GET /task/{UUID}/comment/{entityUUID}/versions)
+	GetTaskUUIDCommentEntityUUIDVersions(ctx context.Context, request GetTaskUUIDCommentEntityUUIDVersionsRequestObject) (GetTaskUUIDCommentEntityUUIDVersionsResponseObject, error)
+
 	// (PATCH /task/{UUID}/name)
 	PatchTaskUUIDName(ctx context.Context, request PatchTaskUUIDNameRequestObject) (PatchTaskUUIDNameResponseObject, error)
 
@@ -1644,10 +1958,11 @@ func (sh *strictHandler) DeleteTaskUUID(ctx echo.Context, uUID Uuid) error {
 }
 
 // GetTaskUUID operation middleware
-func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid) error {
+func (sh *strictHandler) GetTaskUUID(ctx echo.Context, uUID Uuid, params GetTaskUUIDParams) error {
 	var request GetTaskUUIDRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetTaskUUID(ctx.Request().Context(), request.(GetTaskUUIDRequestObject))
@@ -1726,10 +2041,11 @@ func (sh *strictHandler) GetTaskUUIDActivity(ctx echo.Context, uUID Uuid, params
 }
 
 // GetTaskUUIDComment operation middleware
-func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid) error {
+func (sh *strictHandler) GetTaskUUIDComment(ctx echo.Context, uUID Uuid, params GetTaskUUIDCommentParams) error {
 	var request GetTaskUUIDCommentRequestObject
 
 	request.UUID = uUID
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetTaskUUIDComment(ctx.Request().Context(), request.(GetTaskUUIDCommentRequestObject))
@@ -1839,6 +2155,33 @@ func (sh *strictHandler) PatchTaskUUIDCommentEntityUUID(ctx echo.Context, uUID U
 	return nil
 }
 
+// GetTaskUUIDCommentEntityUUIDDiff operation middleware
+func (sh *strictHandler) GetTaskUUIDCommentEntityUUIDDiff(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, params GetTaskUUIDCommentEntityUUIDDiffParams) error {
+	var request GetTaskUUIDCommentEntityUUIDDiffRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDCommentEntityUUIDDiff(ctx.Request().Context(), request.(GetTaskUUIDCommentEntityUUIDDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDCommentEntityUUIDDiff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentEntityUUIDDiffResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentEntityUUIDDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteTaskUUIDCommentEntityUUIDFileFileUUID operation middleware
 func (sh *strictHandler) DeleteTaskUUIDCommentEntityUUIDFileFileUUID(ctx echo.Context, uUID Uuid, entityUUID EntityUUID, fileUUID FileUUID) error {
 	var request DeleteTaskUUIDCommentEntityUUIDFileFileUUIDRequestObject
@@ -1918,6 +2261,96 @@ func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDPin(ctx echo.Context, uUI
 	return nil
 }
 
+// PatchTaskUUIDCommentEntityUUIDReaction operation middleware
+func (sh *strictHandler) PatchTaskUUIDCommentEntityUUIDReaction(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PatchTaskUUIDCommentEntityUUIDReactionRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PatchTaskUUIDCommentEntityUUIDReactionJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTaskUUIDCommentEntityUUIDReaction(ctx.Request().Context(), request.(PatchTaskUUIDCommentEntityUUIDReactionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTaskUUIDCommentEntityUUIDReaction")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTaskUUIDCommentEntityUUIDReactionResponseObject); ok {
+		return validResponse.VisitPatchTaskUUIDCommentEntityUUIDReactionResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTaskUUIDCommentEntityUUIDTask operation middleware
+func (sh *strictHandler) PostTaskUUIDCommentEntityUUIDTask(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request PostTaskUUIDCommentEntityUUIDTaskRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	var body PostTaskUUIDCommentEntityUUIDTaskJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTaskUUIDCommentEntityUUIDTask(ctx.Request().Context(), request.(PostTaskUUIDCommentEntityUUIDTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTaskUUIDCommentEntityUUIDTask")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTaskUUIDCommentEntityUUIDTaskResponseObject); ok {
+		return validResponse.VisitPostTaskUUIDCommentEntityUUIDTaskResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskUUIDCommentEntityUUIDVersions operation middleware
+func (sh *strictHandler) GetTaskUUIDCommentEntityUUIDVersions(ctx echo.Context, uUID Uuid, entityUUID EntityUUID) error {
+	var request GetTaskUUIDCommentEntityUUIDVersionsRequestObject
+
+	request.UUID = uUID
+	request.EntityUUID = entityUUID
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskUUIDCommentEntityUUIDVersions(ctx.Request().Context(), request.(GetTaskUUIDCommentEntityUUIDVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskUUIDCommentEntityUUIDVersions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskUUIDCommentEntityUUIDVersionsResponseObject); ok {
+		return validResponse.VisitGetTaskUUIDCommentEntityUUIDVersionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PatchTaskUUIDName operation middleware
 func (sh *strictHandler) PatchTaskUUIDName(ctx echo.Context, uUID Uuid) error {
 	var request PatchTaskUUIDNameRequestObject
@@ -0,0 +1,65 @@
+package web
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetCompanyUUIDCurrencyRates(ctx context.Context, request oapi.GetCompanyUUIDCurrencyRatesRequestObject) (oapi.GetCompanyUUIDCurrencyRatesResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	rates, err := a.app.FederationService.GetCurrencyRates(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDCurrencyRates200JSONResponse{
+		Count: len(rates),
+		Items: lo.Map(rates, func(item domain.CurrencyRate, _ int) dto.CurrencyRateDTO {
+			return dto.CurrencyRateDTO{
+				UUID:      item.UUID,
+				From:      item.From,
+				To:        item.To,
+				Rate:      item.Rate,
+				Date:      item.Date.Format("2006-01-02"),
+				CreatedBy: item.CreatedBy,
+				UpdatedAt: item.UpdatedAt,
+			}
+		}),
+	}, nil
+}
+
+func (a *Web) PostCompanyUUIDCurrencyRates(ctx context.Context, request oapi.PostCompanyUUIDCurrencyRatesRequestObject) (oapi.PostCompanyUUIDCurrencyRatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	rates := []domain.CurrencyRate{}
+	for i, item := range request.Body.Items {
+		rate, err := domain.NewCurrencyRate(request.UUID, item.From, item.To, item.Rate, item.Date.Time, claims.Email)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	err := a.app.FederationService.LoadCurrencyRates(rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDCurrencyRates200JSONResponse{
+		Count: len(rates),
+	}, nil
+}
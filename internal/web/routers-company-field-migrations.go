@@ -0,0 +1,81 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+)
+
+func (a *Web) PostCompanyUUIDFieldMigrationsDryRun(ctx context.Context, request oapi.PostCompanyUUIDFieldMigrationsDryRunRequestObject) (oapi.PostCompanyUUIDFieldMigrationsDryRunResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	m, options := fieldMigration(request.UUID, claims.Email, *request.Body)
+
+	report, err := a.app.AgregateService.DryRunFieldMigration(m, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDFieldMigrationsDryRun200JSONResponse(dto.NewFieldMigrationReportDTO(report)), nil
+}
+
+func (a *Web) PostCompanyUUIDFieldMigrations(ctx context.Context, request oapi.PostCompanyUUIDFieldMigrationsRequestObject) (oapi.PostCompanyUUIDFieldMigrationsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	m, options := fieldMigration(request.UUID, claims.Email, *request.Body)
+
+	m, err := a.app.AgregateService.StartFieldMigration(m, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostCompanyUUIDFieldMigrations200JSONResponse(dto.NewFieldMigrationDTO(m)), nil
+}
+
+func (a *Web) GetCompanyUUIDFieldMigrationsEntityUUID(ctx context.Context, request oapi.GetCompanyUUIDFieldMigrationsEntityUUIDRequestObject) (oapi.GetCompanyUUIDFieldMigrationsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	m, err := a.app.AgregateService.GetFieldMigration(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDFieldMigrationsEntityUUID200JSONResponse(dto.NewFieldMigrationDTO(m)), nil
+}
+
+func fieldMigration(companyUUID oapi.Uuid, createdBy string, body oapi.FieldMigrationRequest) (domain.FieldMigration, domain.CompanyFieldOptions) {
+	m := domain.FieldMigration{
+		CompanyUUID: companyUUID,
+		Target:      body.Target,
+		FieldUUID:   body.FieldUuid,
+		ToType:      body.DataType,
+		CreatedBy:   createdBy,
+	}
+
+	if body.Strategy != nil {
+		m.Strategy = *body.Strategy
+	}
+
+	if body.Default != nil {
+		m.Default = *body.Default
+	}
+
+	var options domain.CompanyFieldOptions
+	if body.Options != nil {
+		options = companyFieldOptions(*body.Options)
+	}
+
+	return m, options
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
 	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
 )
 
 func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompanyUUIDFieldsRequestObject) (oapi.PostCompanyUUIDFieldsResponseObject, error) {
@@ -22,11 +23,24 @@ func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompan
 		Description: request.Body.Description,
 		DataType:    request.Body.DataType,
 		Icon:        request.Body.Icon,
+		Rules:       request.Body.Rules,
+	}
+
+	if request.Body.Relation != nil {
+		pf.Relation = &domain.FieldRelation{
+			Target:      request.Body.Relation.Target,
+			CatalogUUID: request.Body.Relation.CatalogUuid,
+			Multiple:    lo.FromPtr(request.Body.Relation.Multiple),
+		}
+	}
+
+	if request.Body.Options != nil {
+		pf.Options = companyFieldOptions(*request.Body.Options)
 	}
 
 	dt, err := a.app.FederationService.CreateCompanyField(pf)
 	if err != nil {
-		return nil, ErrInvalidAuthHeader
+		return nil, err
 	}
 
 	return oapi.PostCompanyUUIDFields200JSONResponse{
@@ -35,6 +49,9 @@ func (a *Web) PostCompanyUUIDFields(ctx context.Context, request oapi.PostCompan
 		Type:            domain.FieldDataType(dt.DataType),
 		TypeDescription: pf.FieldTypeDesc(),
 		Icon:            dt.Icon,
+		Options:         &dt.Options,
+		Rules:           &dt.Rules,
+		Relation:        dt.Relation,
 	}, nil
 }
 
@@ -51,6 +68,11 @@ func (a *Web) PutCompanyUUIDFieldsEntityUUID(ctx context.Context, request oapi.P
 		Description:        request.Body.Description,
 		Icon:               request.Body.Icon,
 		RequiredOnStatuses: request.Body.RequiredOnStatuses,
+		Rules:              request.Body.Rules,
+	}
+
+	if request.Body.Options != nil {
+		pf.Options = companyFieldOptions(*request.Body.Options)
 	}
 
 	err := a.app.FederationService.PutCompanyField(pf)
@@ -82,6 +104,9 @@ func (a *Web) GetCompanyUUIDFields(ctx context.Context, request oapi.GetCompanyU
 			DataType:     int(item.DataType),
 			DataDesc:     item.FieldTypeDesc(),
 			ProjectsUUID: item.ProjectUUID,
+			Options:      dto.NewCompanyFieldOptionDTOs(item.Options),
+			Rules:        lo.FromPtr(item.Rules),
+			Relation:     item.Relation,
 
 			TasksTotal:        item.TasksTotal,
 			TasksFilled:       item.TasksFilled,
@@ -110,3 +135,25 @@ func (a *Web) DeleteCompanyUUIDFieldsEntityUUID(ctx context.Context, request oap
 
 	return oapi.DeleteCompanyUUIDFieldsEntityUUID200Response{}, nil
 }
+
+func companyFieldOptions(items []oapi.CompanyFieldOptionRequest) domain.CompanyFieldOptions {
+	return helpers.Map(items, func(item oapi.CompanyFieldOptionRequest, index int) domain.CompanyFieldOption {
+		o := domain.CompanyFieldOption{
+			Label: item.Label,
+		}
+
+		if item.Uuid != nil {
+			o.UUID = *item.Uuid
+		}
+
+		if item.Color != nil {
+			o.Color = *item.Color
+		}
+
+		if item.Order != nil {
+			o.Order = *item.Order
+		}
+
+		return o
+	})
+}
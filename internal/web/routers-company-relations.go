@@ -0,0 +1,25 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+)
+
+func (a *Web) GetCompanyUUIDRelationsEntityUUID(ctx context.Context, request oapi.GetCompanyUUIDRelationsEntityUUIDRequestObject) (oapi.GetCompanyUUIDRelationsEntityUUIDResponseObject, error) {
+	_, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	items, err := a.app.TaskService.GetReferencingTasks(request.UUID, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDRelationsEntityUUID200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
@@ -87,6 +87,7 @@ func (a *Web) PostCompanyUUIDSmsSend(ctx context.Context, request oapi.PostCompa
 	}
 
 	s := sms.NewCompanySms(fmt.Sprint(request.Body.Phone), request.Body.Text, smsOptions.From, claims.UUID, claims.Email, cmpny)
+	s.Time = lo.FromPtr(request.Body.Time)
 
 	mp := make(map[string]interface{})
 
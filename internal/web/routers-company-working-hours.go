@@ -0,0 +1,89 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetCompanyUUIDWorkingHours(ctx context.Context, request oapi.GetCompanyUUIDWorkingHoursRequestObject) (oapi.GetCompanyUUIDWorkingHoursResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.CompanyView(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	wh, err := a.app.CompanyService.GetWorkingHours(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDWorkingHours200JSONResponse(dto.WorkingHoursDTO{
+		Enabled:        wh.Enabled,
+		From:           wh.From,
+		To:             wh.To,
+		Days:           helpers.If(wh.Days == nil, []int{}, wh.Days),
+		UrgentPriority: wh.UrgentPriority,
+		MentionsUrgent: wh.MentionsUrgent,
+		Timezone:       wh.Timezone,
+	}), nil
+}
+
+func (a *Web) PutCompanyUUIDWorkingHours(ctx context.Context, request oapi.PutCompanyUUIDWorkingHoursRequestObject) (oapi.PutCompanyUUIDWorkingHoursResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.CompanyPatch(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.CompanyService.UpdateWorkingHours(request.UUID, domain.WorkingHours(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutCompanyUUIDWorkingHours200Response{}, nil
+}
+
+func (a *Web) GetCompanyUUIDNotificationsDeliveries(ctx context.Context, request oapi.GetCompanyUUIDNotificationsDeliveriesRequestObject) (oapi.GetCompanyUUIDNotificationsDeliveriesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.GateService.CompanyView(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos, total, err := a.app.NotificationsService.SearchDeliveries(dto.NotificationDeliverySearchDTO{
+		CompanyUUID: &request.UUID,
+
+		Status:  request.Params.Status,
+		Channel: request.Params.Channel,
+
+		Offset: helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:  helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetCompanyUUIDNotificationsDeliveries200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+// initMailCaptureRoutes - письма транспорта memory:// для тестов: GET /dev/mails?to=, DELETE /dev/mails.
+// С другими транспортами маршруты не регистрируются.
+func initMailCaptureRoutes(a *Web, e *echo.Echo) {
+	capture, ok := a.app.EmailService.Capture()
+	if !ok {
+		return
+	}
+
+	e.GET("/dev/mails", func(c echo.Context) error {
+		to := c.QueryParam("to")
+
+		mails := lo.Filter(capture.Mails(), func(m domain.Mail, _ int) bool {
+			return to == "" || lo.Contains(m.To, to)
+		})
+
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"count": len(mails),
+			"items": lo.Map(mails, func(m domain.Mail, _ int) interface{} {
+				return struct {
+					dto.MailDTO
+					Body string `json:"body"`
+				}{dto.NewMailDTO(m), m.Body}
+			}),
+		})
+	})
+
+	e.DELETE("/dev/mails", func(c echo.Context) error {
+		capture.Reset()
+
+		return c.NoContent(http.StatusOK)
+	})
+}
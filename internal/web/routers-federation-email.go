@@ -0,0 +1,155 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetFederationUUIDEmailTemplates(ctx context.Context, request oapi.GetFederationUUIDEmailTemplatesRequestObject) (oapi.GetFederationUUIDEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, nil, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := a.app.EmailService.Templates(scope.FederationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDEmailTemplates200JSONResponse{
+		Count:     len(templates),
+		Items:     emailTemplateDTOs(templates),
+		Languages: domain.Languages,
+		Types:     domain.EmailTemplateScopedTypes,
+	}, nil
+}
+
+func (a *Web) PutFederationUUIDEmailTemplates(ctx context.Context, request oapi.PutFederationUUIDEmailTemplatesRequestObject) (oapi.PutFederationUUIDEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, request.Body.CompanyUuid, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := a.app.EmailService.SaveTemplate(domain.EmailTemplate{
+		Type:           request.Body.Type,
+		Lang:           request.Body.Lang,
+		FederationUUID: scope.FederationUUID,
+		CompanyUUID:    scope.CompanyUUID,
+		Subject:        request.Body.Subject,
+		Body:           request.Body.Body,
+		CreatedBy:      claims.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDEmailTemplates200JSONResponse(dto.NewEmailTemplateDTO(t)), nil
+}
+
+func (a *Web) DeleteFederationUUIDEmailTemplates(ctx context.Context, request oapi.DeleteFederationUUIDEmailTemplatesRequestObject) (oapi.DeleteFederationUUIDEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, request.Params.CompanyUuid, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailService.DeleteTemplate(request.Params.Type, request.Params.Lang, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteFederationUUIDEmailTemplates200Response{}, nil
+}
+
+func (a *Web) PostFederationUUIDEmailTemplatesPreview(ctx context.Context, request oapi.PostFederationUUIDEmailTemplatesPreviewRequestObject) (oapi.PostFederationUUIDEmailTemplatesPreviewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, request.Body.CompanyUuid, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := a.app.EmailService.PreviewTemplate(domain.EmailTemplate{
+		Type:           request.Body.Type,
+		Lang:           request.Body.Lang,
+		FederationUUID: scope.FederationUUID,
+		CompanyUUID:    scope.CompanyUUID,
+		Subject:        lo.FromPtr(request.Body.Subject),
+		Body:           lo.FromPtr(request.Body.Body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostFederationUUIDEmailTemplatesPreview200JSONResponse{
+		Html:       msg.GetBody(),
+		SenderName: msg.GetSenderName(),
+		Subject:    msg.GetSubject(),
+	}, nil
+}
+
+func (a *Web) GetFederationUUIDEmailBranding(ctx context.Context, request oapi.GetFederationUUIDEmailBrandingRequestObject) (oapi.GetFederationUUIDEmailBrandingResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, nil, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	brand, err := a.app.EmailService.Branding(scope.FederationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetFederationUUIDEmailBranding200JSONResponse(dto.EmailBrandingDTO(brand)), nil
+}
+
+func (a *Web) PutFederationUUIDEmailBranding(ctx context.Context, request oapi.PutFederationUUIDEmailBrandingRequestObject) (oapi.PutFederationUUIDEmailBrandingResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	scope, err := a.app.EmailScope(request.UUID, nil, claims.UUID, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailService.SaveBranding(scope.FederationUUID, claims.UUID, domain.EmailBranding(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutFederationUUIDEmailBranding200Response{}, nil
+}
+
+func emailTemplateDTOs(templates []domain.EmailTemplate) []dto.EmailTemplateDTO {
+	return lo.Map(templates, func(t domain.EmailTemplate, _ int) dto.EmailTemplateDTO {
+		return dto.NewEmailTemplateDTO(t)
+	})
+}
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProfileCalendar(ctx context.Context, _ oapi.GetProfileCalendarRequestObject) (oapi.GetProfileCalendarResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	u, err := a.app.CalendarFeedURL(claims.UUID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileCalendar200JSONResponse{
+		Url: u,
+	}, nil
+}
+
+func (a *Web) PostProfileCalendarReset(ctx context.Context, _ oapi.PostProfileCalendarResetRequestObject) (oapi.PostProfileCalendarResetResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	u, err := a.app.CalendarFeedURL(claims.UUID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileCalendarReset200JSONResponse{
+		Url: u,
+	}, nil
+}
+
+// GetProfileCalendarFeed - без авторизации, доступ по секретной ссылке для приложений календаря.
+func (a *Web) GetProfileCalendarFeed(ctx context.Context, request oapi.GetProfileCalendarFeedRequestObject) (oapi.GetProfileCalendarFeedResponseObject, error) {
+	ics, err := a.app.CalendarFeed(ctx, request.Params.Token, request.Params.ProjectUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileCalendarFeed200TextcalendarResponse{
+		Body:          bytes.NewReader(ics),
+		ContentLength: int64(len(ics)),
+	}, nil
+}
+
+func (a *Web) GetProfileCalendarEvents(ctx context.Context, request oapi.GetProfileCalendarEventsRequestObject) (oapi.GetProfileCalendarEventsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	people := []string{}
+	for _, uid := range lo.FromPtr(request.Params.People) {
+		if user, ok := a.app.DictionaryService.FindUserByUUID(uid); ok {
+			people = append(people, user.Email)
+		}
+	}
+
+	if len(lo.FromPtr(request.Params.People)) > 0 && len(people) == 0 {
+		return nil, dto.NotFoundErr("пользователи не найдены")
+	}
+
+	events, loc, err := a.app.CalendarEvents(ctx, dto.CalendarSearchDTO{
+		UserUUID:    claims.UUID,
+		UserEmail:   claims.Email,
+		ProjectUUID: request.Params.ProjectUuid,
+		From:        request.Params.From,
+		To:          request.Params.To,
+		Types:       lo.FromPtr(request.Params.Type),
+		People:      people,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileCalendarEvents200JSONResponse{
+		Count: len(events),
+		Items: lo.Map(events, func(e domain.CalendarEvent, _ int) dto.CalendarEventDTO {
+			return dto.NewCalendarEventDTO(e)
+		}),
+		Timezone: loc.String(),
+	}, nil
+}
@@ -0,0 +1,160 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProfileMailboxes(ctx context.Context, _ oapi.GetProfileMailboxesRequestObject) (oapi.GetProfileMailboxesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	mailboxes, err := a.app.MailboxService.Mailboxes(claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileMailboxes200JSONResponse{
+		Count: len(mailboxes),
+		Items: lo.Map(mailboxes, func(mb domain.Mailbox, _ int) dto.UserEmailDTO {
+			return dto.NewUserEmailDTO(mb)
+		}),
+	}, nil
+}
+
+func (a *Web) PostProfileMailboxes(ctx context.Context, request oapi.PostProfileMailboxesRequestObject) (oapi.PostProfileMailboxesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	mb, err := domain.NewMailbox(claims.UUID, request.Body.Email, request.Body.Password, lo.FromPtr(request.Body.Domain))
+	if err != nil {
+		return nil, err
+	}
+
+	mb, err = a.app.MailboxService.Connect(ctx, mb)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileMailboxes200JSONResponse(dto.NewUserEmailDTO(mb)), nil
+}
+
+func (a *Web) PatchProfileMailboxesUUID(ctx context.Context, request oapi.PatchProfileMailboxesUUIDRequestObject) (oapi.PatchProfileMailboxesUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	mb, err := a.app.MailboxService.Update(ctx, claims.UUID, request.UUID, dto.UserEmailDTOSettings{
+		Domain: request.Body.Domain,
+		Status: request.Body.Status,
+	}, request.Body.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PatchProfileMailboxesUUID200JSONResponse(dto.NewUserEmailDTO(mb)), nil
+}
+
+func (a *Web) DeleteProfileMailboxesUUID(ctx context.Context, request oapi.DeleteProfileMailboxesUUIDRequestObject) (oapi.DeleteProfileMailboxesUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.MailboxService.Delete(claims.UUID, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProfileMailboxesUUID200Response{}, nil
+}
+
+func (a *Web) PostProfileMailboxesUUIDFetch(ctx context.Context, request oapi.PostProfileMailboxesUUIDFetchRequestObject) (oapi.PostProfileMailboxesUUIDFetchResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.MailboxService.RequestFetch(ctx, claims.UUID, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileMailboxesUUIDFetch200Response{}, nil
+}
+
+func (a *Web) GetProfileMailboxesEmails(ctx context.Context, request oapi.GetProfileMailboxesEmailsRequestObject) (oapi.GetProfileMailboxesEmailsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	items, total, err := a.app.MailboxService.Emails(dto.EmailSearchDTO{
+		UserUUID:    claims.UUID,
+		MailboxUUID: request.Params.MailboxUuid,
+		AgentUUID:   request.Params.AgentUuid,
+		TaskUUID:    request.Params.TaskUuid,
+
+		Offset: helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:  helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileMailboxesEmails200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(m domain.MailboxEmail, _ int) dto.EmailDTO {
+			return dto.NewEmailDTO(m)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostProfileMailboxesUUIDSend(ctx context.Context, request oapi.PostProfileMailboxesUUIDSendRequestObject) (oapi.PostProfileMailboxesUUIDSendResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	sent, err := a.app.MailboxService.Send(ctx, claims.UUID, request.UUID, request.Body.RecipientEmail, request.Body.Subject, request.Body.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileMailboxesUUIDSend200JSONResponse(dto.NewSentEmailDTO(sent)), nil
+}
+
+func (a *Web) GetProfileMailboxesUUIDSent(ctx context.Context, request oapi.GetProfileMailboxesUUIDSentRequestObject) (oapi.GetProfileMailboxesUUIDSentResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	items, total, err := a.app.MailboxService.SentEmails(claims.UUID, request.UUID,
+		helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileMailboxesUUIDSent200JSONResponse{
+		Count: len(items),
+		Items: lo.Map(items, func(m domain.SentEmail, _ int) dto.SentEmailDTO {
+			return dto.NewSentEmailDTO(m)
+		}),
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/internal/app"
+	"github.com/krisch/crm-backend/internal/configs"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/mailbox"
+	"github.com/krisch/crm-backend/pkg/postgres"
+	echo "github.com/labstack/echo/v4"
+	pgdriver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestGetProfileMailboxesAuth(t *testing.T) {
+	// DryRun: запросы не выполняются, ящиков нет
+	db, err := gorm.Open(pgdriver.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mailboxes, err := mailbox.New(&configs.Configs{SOLT: "solt"}, mailbox.NewRepository(&postgres.GDB{DB: db}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j := jwt.New("secret")
+	a := &Web{app: &app.App{JWT: j, MailboxService: mailboxes}}
+
+	e := echo.New()
+	initOpenAPIProfileRouters(a, e)
+
+	var handled error
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		handled = err
+		_ = c.NoContent(http.StatusInternalServerError)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    int
+		wantErr error
+	}{
+		{name: "without token", want: http.StatusInternalServerError, wantErr: ErrUnauthorized},
+		{name: "valid token", token: j.GenerateJWT(uuid.New(), "ivan@mail.ru", "Иван", true, 60), want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+
+			req := httptest.NewRequest(http.MethodGet, "/profile/mailboxes", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want || !errors.Is(handled, tt.wantErr) {
+				t.Errorf("GET /profile/mailboxes = %d, %v, want %d, %v", rec.Code, handled, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
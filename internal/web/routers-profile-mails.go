@@ -0,0 +1,156 @@
+package web
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+	"github.com/samber/lo"
+)
+
+// errNotAdmin - раздел не показывается пользователям не из ADMIN_EMAILS.
+var errNotAdmin = dto.NotFoundErr("раздел не найден")
+
+func (a *Web) GetProfileAdminMails(ctx context.Context, request oapi.GetProfileAdminMailsRequestObject) (oapi.GetProfileAdminMailsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	mails, total, err := a.app.EmailService.SearchMails(dto.MailSearchDTO{
+		Status: request.Params.Status,
+		To:     request.Params.To,
+
+		Offset: helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:  helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileAdminMails200JSONResponse{
+		Count: len(mails),
+		Items: lo.Map(mails, func(m domain.Mail, _ int) dto.MailDTO {
+			return dto.NewMailDTO(m)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostProfileAdminMailsUUIDResend(ctx context.Context, request oapi.PostProfileAdminMailsUUIDResendRequestObject) (oapi.PostProfileAdminMailsUUIDResendResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	err := a.app.EmailService.ResendMail(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileAdminMailsUUIDResend200Response{}, nil
+}
+
+func (a *Web) GetProfileAdminEmailTemplates(ctx context.Context, _ oapi.GetProfileAdminEmailTemplatesRequestObject) (oapi.GetProfileAdminEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	templates, err := a.app.EmailService.Templates(uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileAdminEmailTemplates200JSONResponse{
+		Count:     len(templates),
+		Items:     emailTemplateDTOs(templates),
+		Languages: domain.Languages,
+		Types:     domain.EmailTemplateTypes,
+	}, nil
+}
+
+func (a *Web) PutProfileAdminEmailTemplates(ctx context.Context, request oapi.PutProfileAdminEmailTemplatesRequestObject) (oapi.PutProfileAdminEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	t, err := a.app.EmailService.SaveTemplate(domain.EmailTemplate{
+		Type:      request.Body.Type,
+		Lang:      request.Body.Lang,
+		Subject:   request.Body.Subject,
+		Body:      request.Body.Body,
+		CreatedBy: claims.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProfileAdminEmailTemplates200JSONResponse(dto.NewEmailTemplateDTO(t)), nil
+}
+
+func (a *Web) DeleteProfileAdminEmailTemplates(ctx context.Context, request oapi.DeleteProfileAdminEmailTemplatesRequestObject) (oapi.DeleteProfileAdminEmailTemplatesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	err := a.app.EmailService.DeleteTemplate(request.Params.Type, request.Params.Lang, domain.EmailScope{})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProfileAdminEmailTemplates200Response{}, nil
+}
+
+func (a *Web) PostProfileAdminEmailTemplatesPreview(ctx context.Context, request oapi.PostProfileAdminEmailTemplatesPreviewRequestObject) (oapi.PostProfileAdminEmailTemplatesPreviewResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if !a.app.IsAdmin(claims.Email) {
+		return nil, errNotAdmin
+	}
+
+	msg, err := a.app.EmailService.PreviewTemplate(domain.EmailTemplate{
+		Type:    request.Body.Type,
+		Lang:    request.Body.Lang,
+		Subject: lo.FromPtr(request.Body.Subject),
+		Body:    lo.FromPtr(request.Body.Body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostProfileAdminEmailTemplatesPreview200JSONResponse{
+		Html:       msg.GetBody(),
+		SenderName: msg.GetSenderName(),
+		Subject:    msg.GetSubject(),
+	}, nil
+}
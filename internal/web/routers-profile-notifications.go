@@ -2,12 +2,19 @@ package web
 
 import (
 	"context"
+	"html"
+	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/helpers"
 	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/notifications"
 	oapi "github.com/krisch/crm-backend/internal/web/oprofile"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -89,12 +96,7 @@ func (a *Web) GetProfileNotifications(ctx context.Context, _ oapi.GetProfileNoti
 				logrus.Warnf("GetTaskState: %s", err)
 			}
 
-			count := make(map[string]interface{})
-			count["comment"] = len(state.NewComments)
-			count["upload"] = len(state.NewUploads)
-			count["mensions"] = state.NewMentions
-			count["comment_like"] = state.NewLikes
-			count["reminders"] = len(state.NewReminders)
+			count := notifications.StateCount(state)
 
 			group := 0
 			if star {
@@ -181,3 +183,243 @@ func (a *Web) PostProfileNotificationsTaskUUIDHide(ctx context.Context, request
 
 	return oapi.PostProfileNotificationsTaskUUIDHide200Response{}, nil
 }
+
+func (a *Web) GetProfileMentions(ctx context.Context, request oapi.GetProfileMentionsRequestObject) (oapi.GetProfileMentionsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	offset := helpers.If(request.Params.Offset == nil, 0, *request.Params.Offset)
+	limit := helpers.If(request.Params.Limit == nil, 50, *request.Params.Limit)
+
+	dms, err := a.app.CommentService.GetUserMentions(claims.Email, offset, lo.Clamp(limit, 1, 200))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []dto.MentionDTO{}
+	for _, dm := range dms {
+		// упоминания в задачах, к которым у пользователя больше нет доступа, не показываем
+		if a.app.GateService.TaskView(domain.Task{CompanyUUID: dm.TaskCompanyUUID}, claims.UUID) != nil {
+			continue
+		}
+
+		items = append(items, dto.NewMentionDTO(dm, a.app.DictionaryService, a.app.ProfileService))
+	}
+
+	return oapi.GetProfileMentions200JSONResponse{
+		Count: len(items),
+		Items: items,
+	}, nil
+}
+
+func (a *Web) GetProfileNotificationsSettings(ctx context.Context, _ oapi.GetProfileNotificationsSettingsRequestObject) (oapi.GetProfileNotificationsSettingsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	settings, err := a.app.ProfileService.NotificationSettings(claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileNotificationsSettings200JSONResponse(dto.NewNotificationSettingsDTO(settings)), nil
+}
+
+func (a *Web) PutProfileNotificationsSettings(ctx context.Context, request oapi.PutProfileNotificationsSettingsRequestObject) (oapi.PutProfileNotificationsSettingsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	var quiet *domain.QuietHours
+	if request.Body.QuietHours != nil {
+		quiet = helpers.Ptr(domain.QuietHours(*request.Body.QuietHours))
+	}
+
+	err := a.app.ProfileService.ChangeNotificationSettings(claims.UUID, domain.NotificationMatrix(request.Body.Events), request.Body.Digest, quiet)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProfileNotificationsSettings200Response{}, nil
+}
+
+func (a *Web) PutProfileNotificationsSettingsProjectUUID(ctx context.Context, request oapi.PutProfileNotificationsSettingsProjectUUIDRequestObject) (oapi.PutProfileNotificationsSettingsProjectUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, ok := a.app.DictionaryService.FindProject(request.UUID)
+	if !ok || !a.app.DictionaryService.InFederation(project.FederationUUID, claims.UUID) {
+		return nil, dto.NotFoundErr("проект не найден")
+	}
+
+	settings := domain.ProjectNotificationSettings{
+		Muted: request.Body.Muted,
+	}
+
+	if request.Body.Events != nil {
+		settings.Events = domain.NotificationMatrix(*request.Body.Events)
+	}
+
+	err := a.app.ProfileService.ChangeProjectNotificationSettings(claims.UUID, request.UUID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProfileNotificationsSettingsProjectUUID200Response{}, nil
+}
+
+func (a *Web) DeleteProfileNotificationsSettingsProjectUUID(ctx context.Context, request oapi.DeleteProfileNotificationsSettingsProjectUUIDRequestObject) (oapi.DeleteProfileNotificationsSettingsProjectUUIDResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.ProfileService.DeleteProjectNotificationSettings(claims.UUID, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProfileNotificationsSettingsProjectUUID200Response{}, nil
+}
+
+// GetProfileDigestUnsubscribe - страница с кнопкой отписки. Настройки не меняются:
+// почтовые сканеры открывают ссылки из писем, отписка только по POST.
+func (a *Web) GetProfileDigestUnsubscribe(_ context.Context, request oapi.GetProfileDigestUnsubscribeRequestObject) (oapi.GetProfileDigestUnsubscribeResponseObject, error) {
+	q := url.Values{}
+	q.Set("email", request.Params.Email)
+	q.Set("token", request.Params.Token)
+
+	page := `<html><form method="post" action="?` + html.EscapeString(q.Encode()) + `">` +
+		"<p>Отписаться от рассылки непрочитанных уведомлений на " + html.EscapeString(request.Params.Email) + "?</p>" +
+		`<button type="submit">Отписаться</button></form></html>`
+
+	return oapi.GetProfileDigestUnsubscribe200TexthtmlResponse{
+		Body:          strings.NewReader(page),
+		ContentLength: int64(len(page)),
+	}, nil
+}
+
+// PostProfileDigestUnsubscribe - отписка кнопкой со страницы или в один клик из почтового клиента (RFC 8058).
+func (a *Web) PostProfileDigestUnsubscribe(_ context.Context, request oapi.PostProfileDigestUnsubscribeRequestObject) (oapi.PostProfileDigestUnsubscribeResponseObject, error) {
+	err := a.app.ProfileService.UnsubscribeDigest(request.Params.Email, request.Params.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	page := "<html><p>Вы отписались от рассылки непрочитанных уведомлений. Включить ее снова можно в настройках профиля.</p></html>"
+
+	return oapi.PostProfileDigestUnsubscribe200TexthtmlResponse{
+		Body:          strings.NewReader(page),
+		ContentLength: int64(len(page)),
+	}, nil
+}
+
+func (a *Web) GetProfileNotificationsHistory(ctx context.Context, request oapi.GetProfileNotificationsHistoryRequestObject) (oapi.GetProfileNotificationsHistoryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dtos, total, err := a.app.NotificationsService.SearchNotifications(ctx, dto.NotificationSearchDTO{
+		Email: claims.Email,
+
+		Type:        request.Params.Type,
+		Status:      request.Params.Status,
+		Starred:     request.Params.Starred,
+		Hidden:      request.Params.Hidden,
+		ProjectUUID: request.Params.ProjectUuid,
+		Search:      request.Params.Search,
+
+		Offset: helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:  helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileNotificationsHistory200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+		Total: total,
+	}, nil
+}
+
+func (a *Web) PostProfileNotificationsTaskUUIDRead(ctx context.Context, request oapi.PostProfileNotificationsTaskUUIDReadRequestObject) (oapi.PostProfileNotificationsTaskUUIDReadResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.NotificationsService.RemoveNotification(claims.Email, "task", request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.PublishNotificationsCount(claims.Email)
+
+	return oapi.PostProfileNotificationsTaskUUIDRead200Response{}, nil
+}
+
+func (a *Web) DeleteProfileNotificationsTaskUUIDRead(ctx context.Context, request oapi.DeleteProfileNotificationsTaskUUIDReadRequestObject) (oapi.DeleteProfileNotificationsTaskUUIDReadResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.NotificationsService.MarkNotificationUnread(ctx, "task", claims.Email, request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.PublishNotificationsCount(claims.Email)
+
+	return oapi.DeleteProfileNotificationsTaskUUIDRead200Response{}, nil
+}
+
+func (a *Web) PostProfileNotificationsRebuild(ctx context.Context, _ oapi.PostProfileNotificationsRebuildRequestObject) (oapi.PostProfileNotificationsRebuildResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	err := a.app.NotificationsService.RebuildUserCache(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.PublishNotificationsCount(claims.Email)
+
+	return oapi.PostProfileNotificationsRebuild200Response{}, nil
+}
+
+func (a *Web) GetProfileNotificationsDeliveries(ctx context.Context, request oapi.GetProfileNotificationsDeliveriesRequestObject) (oapi.GetProfileNotificationsDeliveriesResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dtos, total, err := a.app.NotificationsService.SearchDeliveries(dto.NotificationDeliverySearchDTO{
+		Email: &claims.Email,
+
+		Status:  request.Params.Status,
+		Channel: request.Params.Channel,
+
+		Offset: helpers.If(request.Params.Offset == nil, 0, lo.FromPtr(request.Params.Offset)),
+		Limit:  helpers.If(request.Params.Limit == nil, 50, lo.FromPtr(request.Params.Limit)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProfileNotificationsDeliveries200JSONResponse{
+		Count: len(dtos),
+		Items: dtos,
+		Total: total,
+	}, nil
+}
@@ -40,6 +40,7 @@ func initOpenAPIProfileRouters(a *Web, e *echo.Echo) {
 			"PostProfileNotificationsTaskUUIDStar",
 			"DeleteProfileNotificationsTaskUUIDStar",
 			"GetProfileNotifications",
+			"GetProfileMentions",
 			"GetProfileInvite",
 			"PostProfileInviteUUID",
 			"PatchProfileFio",
@@ -49,6 +50,32 @@ func initOpenAPIProfileRouters(a *Web, e *echo.Echo) {
 			"GetProfileLikes",
 			"GetProfileLogout",
 			"PostProfileNotificationsTaskUUIDHide",
+			"GetProfileNotificationsSettings",
+			"PutProfileNotificationsSettings",
+			"PutProfileNotificationsSettingsProjectUUID",
+			"DeleteProfileNotificationsSettingsProjectUUID",
+			"GetProfileNotificationsHistory",
+			"PostProfileNotificationsTaskUUIDRead",
+			"DeleteProfileNotificationsTaskUUIDRead",
+			"PostProfileNotificationsRebuild",
+			"GetProfileNotificationsDeliveries",
+			"GetProfileCalendar",
+			"PostProfileCalendarReset",
+			"GetProfileCalendarEvents",
+			"GetProfileAdminMails",
+			"PostProfileAdminMailsUUIDResend",
+			"GetProfileAdminEmailTemplates",
+			"PutProfileAdminEmailTemplates",
+			"DeleteProfileAdminEmailTemplates",
+			"PostProfileAdminEmailTemplatesPreview",
+			"GetProfileMailboxes",
+			"PostProfileMailboxes",
+			"PatchProfileMailboxesUUID",
+			"DeleteProfileMailboxesUUID",
+			"GetProfileMailboxesEmails",
+			"GetProfileMailboxesUUIDSent",
+			"PostProfileMailboxesUUIDFetch",
+			"PostProfileMailboxesUUIDSend",
 		}),
 	}
 
@@ -73,12 +100,7 @@ func (a *Web) PostProfile(_ context.Context, request oapi.PostProfileRequestObje
 
 	logrus.Debugf("[uuid:%s][code:%s] PostProfileRegister: user created", uid, code)
 
-	message, err := emails.NewConfirmationMessage(code)
-	if err != nil {
-		return nil, err
-	}
-
-	err = a.app.EmailService.SendEmail([]string{request.Body.Email}, message)
+	err = a.app.SendTemplateEmail(request.Body.Email, domain.EmailTemplateConfirmation, domain.EmailScope{}, &emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -165,12 +187,7 @@ func (a *Web) PostProfileValidateSend(_ context.Context, request oapi.PostProfil
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
-	if err != nil {
-		return nil, err
-	}
-
-	err = a.app.EmailService.SendEmail([]string{request.Body.Email}, message)
+	err = a.app.SendTemplateEmail(request.Body.Email, domain.EmailTemplateConfirmation, domain.EmailScope{}, &emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -193,12 +210,7 @@ func (a *Web) PostProfileValidateSimpleSend(ctx context.Context, request oapi.Po
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
-	if err != nil {
-		return nil, err
-	}
-
-	err = a.app.EmailService.SendEmail([]string{request.Body.Email}, message)
+	err = a.app.SendTemplateEmail(request.Body.Email, domain.EmailTemplateConfirmation, domain.EmailScope{}, &emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -613,12 +625,7 @@ func (a *Web) PostProfileResetSend(_ context.Context, request oapi.PostProfileRe
 		return nil, err
 	}
 
-	message, err := emails.NewConfirmationMessage(code)
-	if err != nil {
-		return nil, err
-	}
-
-	err = a.app.EmailService.SendEmail([]string{request.Body.Email}, message)
+	err = a.app.SendTemplateEmail(request.Body.Email, domain.EmailTemplateReset, domain.EmailScope{}, &emails.CodeData{Code: code})
 	if err != nil {
 		return nil, err
 	}
@@ -656,6 +663,7 @@ func (a *Web) PatchProfilePreferences(ctx context.Context, request oapi.PatchPro
 
 	err := a.app.ProfileService.ChangePreferences(claims.UUID, domain.ProfilePreferences{
 		Timezone: request.Body.Timezone,
+		Language: request.Body.Language,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"context"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	oapi "github.com/krisch/crm-backend/internal/web/ofederation"
+	"github.com/samber/lo"
+)
+
+func (a *Web) GetProjectUUIDIntake(ctx context.Context, request oapi.GetProjectUUIDIntakeRequestObject) (oapi.GetProjectUUIDIntakeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.IntakeProject(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	intake, err := a.app.EmailService.IntakeAddress(project.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetProjectUUIDIntake200JSONResponse(dto.NewIntakeAddressDTO(intake)), nil
+}
+
+func (a *Web) PutProjectUUIDIntake(ctx context.Context, request oapi.PutProjectUUIDIntakeRequestObject) (oapi.PutProjectUUIDIntakeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.IntakeProject(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	intake, err := a.app.SaveIntakeAddress(project, domain.IntakeAddress{
+		Email:         request.Body.Email,
+		CreatedBy:     claims.Email,
+		CreatedByUUID: claims.UUID,
+		ResponsibleBy: lo.FromPtr(request.Body.ResponsibleBy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PutProjectUUIDIntake200JSONResponse(dto.NewIntakeAddressDTO(intake)), nil
+}
+
+func (a *Web) DeleteProjectUUIDIntake(ctx context.Context, request oapi.DeleteProjectUUIDIntakeRequestObject) (oapi.DeleteProjectUUIDIntakeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	project, err := a.app.IntakeProject(request.UUID, claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.EmailService.DeleteIntakeAddress(project.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.DeleteProjectUUIDIntake200Response{}, nil
+}
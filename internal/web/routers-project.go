@@ -163,6 +163,9 @@ func (a *Web) GetProjectUUID(ctx context.Context, request oapi.GetProjectUUIDReq
 				RequiredOnStatuses: item.RequiredOnStatuses,
 				Style:              item.Style,
 				DataDesc:           item.FieldTypeDesc(),
+				Options:            dto.NewCompanyFieldOptionDTOs(item.Options),
+				Rules:              lo.FromPtr(item.Rules),
+				Relation:           item.Relation,
 			}
 		}),
 
@@ -378,6 +381,7 @@ func (a *Web) PatchProjectUUIDOptions(ctx context.Context, request oapi.PatchPro
 		RequireDoneComment:        request.Body.RequireDoneComment,
 		StatusEnable:              request.Body.StatusEnable,
 		Color:                     request.Body.Color,
+		CommentEditMinutes:        request.Body.CommentEditMinutes,
 	})
 	if err != nil {
 		return nil, ErrInvalidAuthHeader
@@ -614,7 +618,7 @@ func (a *Web) PostProjectUUIDFieldEntityUUID(ctx context.Context, request oapi.P
 		return nil, dto.NotFoundErr("проект не найден")
 	}
 
-	err := a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style)
+	err := a.app.FederationService.AddProjectField(request.UUID, project.CompanyUUID, request.EntityUUID, request.Body.RequiredOnStatuses, request.Body.Style, request.Body.Rules)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,309 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	echo "github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+
+	"github.com/krisch/crm-backend/domain"
+	"github.com/krisch/crm-backend/dto"
+	"github.com/krisch/crm-backend/internal/jwt"
+	"github.com/krisch/crm-backend/internal/realtime"
+)
+
+const (
+	wsPingPeriod   = 30 * time.Second
+	wsWriteWait    = 10 * time.Second
+	sseHeartbeat   = 25 * time.Second
+	wsReadLimit    = 4096
+	sseTopicsParam = "topics"
+)
+
+type realtimeMessage struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics,omitempty"`
+
+	// search - поиск пользователей федерации
+	FederationUUID uuid.UUID `json:"federation_uuid,omitempty"`
+	Search         string    `json:"search,omitempty"`
+}
+
+type realtimeReply struct {
+	Type   string      `json:"type"`
+	Topics []string    `json:"topics,omitempty"`
+	Error  string      `json:"error,omitempty"`
+	Data   interface{} `json:"data,omitempty"`
+}
+
+func initRealtimeRoutes(a *Web, e *echo.Echo) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			if !a.Options.CORS_ENABLE {
+				return true
+			}
+
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				return true
+			}
+
+			return lo.ContainsBy(strings.Split(a.Options.CORS_ALLOWED_ORIGINS, ","), func(o string) bool {
+				o = strings.TrimSpace(o)
+				return o == "*" || o == origin
+			})
+		},
+	}
+
+	e.GET("/ws", func(c echo.Context) error {
+		claims, err := realtimeClaims(a, c)
+		if err != nil {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"message": err.Error()})
+		}
+
+		ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+		if err != nil {
+			return err
+		}
+		defer ws.Close()
+
+		a.serveWebsocket(c.Request().Context(), ws, claims)
+
+		return nil
+	})
+
+	e.GET("/events", func(c echo.Context) error {
+		claims, err := realtimeClaims(a, c)
+		if err != nil {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"message": err.Error()})
+		}
+
+		return a.serveSSE(c, claims)
+	})
+}
+
+func realtimeClaims(a *Web, c echo.Context) (jwt.Claims, error) {
+	c, err := checkAuth(c, "realtime", a.app.JWT)
+	if err != nil {
+		return jwt.Claims{}, err
+	}
+
+	claims, ok := c.Request().Context().Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return jwt.Claims{}, ErrInvalidAuthHeader
+	}
+
+	return claims, nil
+}
+
+// canSubscribe - пользователь может слушать только свой inbox, а проекты и задачи - с теми же проверками доступа, что и в REST.
+func (a *Web) canSubscribe(ctx context.Context, claims jwt.Claims, topic string) error {
+	kind, id, err := realtime.ParseTopic(topic)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case "user":
+		if id == claims.Email {
+			return nil
+		}
+	case "project":
+		project, err := a.app.FederationService.GetProject(uuid.MustParse(id))
+		if err == nil && a.app.GateService.ProjectView(project, claims.UUID) == nil {
+			return nil
+		}
+	case "task":
+		task, err := a.app.TaskService.GetTask(ctx, uuid.MustParse(id), []string{})
+		if err == nil && a.app.GateService.TaskView(task, claims.UUID) == nil {
+			return nil
+		}
+	}
+
+	return realtime.ErrInvalidTopic
+}
+
+func (a *Web) subscribeTopics(ctx context.Context, client *realtime.Client, claims jwt.Claims, topics []string) (ok []string, err error) {
+	for _, topic := range topics {
+		err = a.canSubscribe(ctx, claims, topic)
+		if err == nil {
+			err = a.app.RealtimeService.Subscribe(client, topic)
+		}
+
+		if err != nil {
+			return ok, fmt.Errorf("%s: %w", topic, err)
+		}
+
+		ok = append(ok, topic)
+	}
+
+	return ok, nil
+}
+
+func (a *Web) serveWebsocket(ctx context.Context, ws *websocket.Conn, claims jwt.Claims) {
+	client := a.app.RealtimeService.Connect(claims.Email)
+	defer a.app.RealtimeService.Disconnect(client)
+
+	replies := make(chan realtimeReply, 8)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(wsPingPeriod)
+		defer ticker.Stop()
+
+		for {
+			var err error
+
+			select {
+			case <-done:
+				return
+			case e, ok := <-client.Events:
+				if !ok {
+					return
+				}
+
+				_ = ws.SetWriteDeadline(time.Now().Add(wsWriteWait))
+				err = ws.WriteJSON(e)
+			case r := <-replies:
+				_ = ws.SetWriteDeadline(time.Now().Add(wsWriteWait))
+				err = ws.WriteJSON(r)
+			case <-ticker.C:
+				err = ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+			}
+
+			if err != nil {
+				logrus.Debug("realtime: ", err)
+				ws.Close()
+
+				return
+			}
+		}
+	}()
+
+	ws.SetReadLimit(wsReadLimit)
+
+	for {
+		msg := realtimeMessage{}
+
+		err := ws.ReadJSON(&msg)
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logrus.Error("realtime: ", err)
+			}
+
+			return
+		}
+
+		reply := a.handleRealtimeMessage(ctx, client, claims, msg)
+
+		select {
+		case replies <- reply:
+		case <-time.After(wsWriteWait):
+			return
+		}
+	}
+}
+
+func (a *Web) handleRealtimeMessage(ctx context.Context, client *realtime.Client, claims jwt.Claims, msg realtimeMessage) realtimeReply {
+	reply := realtimeReply{Type: msg.Action}
+
+	switch msg.Action {
+	case "subscribe":
+		topics, err := a.subscribeTopics(ctx, client, claims, msg.Topics)
+		reply.Topics = topics
+		if err != nil {
+			reply.Error = err.Error()
+		}
+	case "unsubscribe":
+		for _, topic := range msg.Topics {
+			a.app.RealtimeService.Unsubscribe(client, topic)
+		}
+		reply.Topics = msg.Topics
+	case "search":
+		if !a.app.DictionaryService.InFederation(msg.FederationUUID, claims.UUID) {
+			reply.Error = realtime.ErrInvalidTopic.Error()
+			break
+		}
+
+		dmns, err := a.app.FederationService.SearchUserInDictionary(domain.SearchUser{
+			FederationUUID: msg.FederationUUID,
+			Search:         msg.Search,
+		})
+		if err != nil {
+			reply.Error = err.Error()
+			break
+		}
+
+		reply.Data = lo.Map(dmns, func(item domain.User, index int) dto.UserDTO {
+			return dto.NewUserDto(item, a.app.ProfileService)
+		})
+	default:
+		reply.Error = "неизвестное действие"
+	}
+
+	return reply
+}
+
+func (a *Web) serveSSE(c echo.Context, claims jwt.Claims) error {
+	ctx := c.Request().Context()
+
+	client := a.app.RealtimeService.Connect(claims.Email)
+	defer a.app.RealtimeService.Disconnect(client)
+
+	var topics []string
+	if q := c.QueryParam(sseTopicsParam); q != "" {
+		topics = strings.Split(q, ",")
+	}
+
+	if _, err := a.subscribeTopics(ctx, client, claims, topics); err != nil {
+		return c.JSON(http.StatusForbidden, map[string]string{"message": err.Error()})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-cache")
+	c.Response().Header().Set(echo.HeaderConnection, "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+
+	count, err := a.app.NotificationsService.Count(ctx, claims.Email)
+	if err == nil {
+		e, err := realtime.NewEvent(realtime.EventNotifications, map[string]interface{}{"count": count}, realtime.UserTopic(claims.Email))
+		if err == nil {
+			a.app.RealtimeService.Send(client, e)
+		}
+	}
+
+	ticker := time.NewTicker(sseHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			fmt.Fprint(c.Response(), ": ping\n\n")
+		case e, ok := <-client.Events:
+			if !ok {
+				return nil
+			}
+
+			js, err := json.Marshal(e)
+			if err != nil {
+				logrus.Error("realtime: ", err)
+				continue
+			}
+
+			fmt.Fprintf(c.Response(), "event: %s\ndata: %s\n\n", e.Type, js)
+		}
+
+		c.Response().Flush()
+	}
+}
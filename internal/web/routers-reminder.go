@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/krisch/crm-backend/domain"
@@ -56,7 +57,11 @@ func (a *Web) PostReminder(ctx context.Context, request oapi.PostReminderRequest
 		DateTo:        request.Body.DateTo,
 		DateFrom:      request.Body.DateFrom,
 		UserUUID:      request.Body.UserUuid,
+
+		Recurrence:     (*domain.ReminderRecurrence)(request.Body.Recurrence),
+		DeadlineOffset: request.Body.DeadlineOffset,
 	}
+	dm.KeepMonthDay(domain.Reminder{})
 
 	err := a.app.RemindersService.Create(dm)
 	if err != nil {
@@ -80,12 +85,17 @@ func (a *Web) PutReminderUUID(ctx context.Context, request oapi.PutReminderUUIDR
 		return nil, err
 	}
 
+	prev := dm
+
 	dm.Description = request.Body.Description
 	dm.Comment = request.Body.Comment
 	dm.DateFrom = request.Body.DateFrom
 	dm.DateTo = request.Body.DateTo
 	dm.Type = request.Body.Type
 	dm.UserUUID = request.Body.UserUuid
+	dm.Recurrence = (*domain.ReminderRecurrence)(request.Body.Recurrence)
+	dm.DeadlineOffset = request.Body.DeadlineOffset
+	dm.KeepMonthDay(prev)
 
 	err = a.app.RemindersService.Put(claims.Email, dm)
 	if err != nil {
@@ -154,6 +164,10 @@ func (a *Web) GetReminder(ctx context.Context, _ oapi.GetReminderRequestObject)
 			User:        user,
 			CreatedBy:   createdBy,
 			Status:      dm.Status,
+
+			Recurrence:     (*dto.ReminderRecurrenceDTO)(dm.Recurrence),
+			SnoozedUntil:   dm.SnoozedUntil,
+			DeadlineOffset: dm.DeadlineOffset,
 		}
 	})
 
@@ -162,3 +176,93 @@ func (a *Web) GetReminder(ctx context.Context, _ oapi.GetReminderRequestObject)
 		Items: dtos,
 	}, nil
 }
+
+func (a *Web) GetReminderDeadLetters(ctx context.Context, _ oapi.GetReminderDeadLettersRequestObject) (oapi.GetReminderDeadLettersResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dms, err := a.app.RemindersService.DeadDispatches(claims.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetReminderDeadLetters200JSONResponse{
+		Count: len(dms),
+		Items: lo.Map(dms, func(dm domain.ReminderDispatch, _ int) dto.ReminderDispatchDTO {
+			return dto.ReminderDispatchDTO{
+				UUID:         dm.UUID,
+				ReminderUUID: dm.ReminderUUID,
+				FireAt:       dm.FireAt,
+				Status:       dm.Status,
+				Attempts:     dm.Attempts,
+				Error:        dm.Error,
+				UpdatedAt:    dm.UpdatedAt,
+			}
+		}),
+	}, nil
+}
+
+func (a *Web) PostReminderDeadLettersUUIDRetry(ctx context.Context, request oapi.PostReminderDeadLettersUUIDRetryRequestObject) (oapi.PostReminderDeadLettersUUIDRetryResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	d, err := a.app.RemindersService.GetDispatch(request.UUID)
+	if err != nil {
+		return nil, dto.NotFoundErr("недоставленное напоминание не найдено")
+	}
+
+	r, err := a.app.RemindersService.Get(d.ReminderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.CreatedByUUID != claims.UUID && lo.FromPtr(r.UserUUID) != claims.UUID {
+		return nil, dto.NotFoundErr("недоставленное напоминание не найдено")
+	}
+
+	err = a.app.RemindersService.RetryDispatch(d.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostReminderDeadLettersUUIDRetry200Response{}, nil
+}
+
+func (a *Web) PostReminderUUIDSnooze(ctx context.Context, request oapi.PostReminderUUIDSnoozeRequestObject) (oapi.PostReminderUUIDSnoozeResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	dm, err := a.app.RemindersService.Get(request.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dm.UUID == uuid.Nil || (dm.CreatedByUUID != claims.UUID && lo.FromPtr(dm.UserUUID) != claims.UUID) {
+		return nil, dto.NotFoundErr("дело не найдено")
+	}
+
+	prefs, err := a.app.ProfileService.UsersPreferences([]uuid.UUID{claims.UUID})
+	if err != nil {
+		return nil, err
+	}
+
+	until, err := domain.SnoozeUntil(request.Body.Until, time.Now(), prefs[claims.UUID].Location(a.app.Options.TIME_ZONE))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.app.RemindersService.Snooze(claims.Email, dm, until)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.PostReminderUUIDSnooze200JSONResponse{
+		SnoozedUntil: until,
+	}, nil
+}
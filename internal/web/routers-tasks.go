@@ -71,7 +71,7 @@ func (a *Web) PatchTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.P
 	dm := domain.NewComment(claims.Email, request.UUID, replyUUID, emails, comment)
 	dm.UUID = request.EntityUUID
 
-	err = a.app.TaskService.UpdateComment(ctx, request.UUID, *dm)
+	*dm, err = a.app.TaskService.UpdateComment(ctx, request.UUID, *dm)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +119,8 @@ func (a *Web) PatchTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.P
 	}
 
 	var peoplesDto *[]dto.UserDTO
-	if len(emails) > 0 {
-		p, _ := a.app.DictionaryService.FindUsers(emails)
+	if len(dm.People) > 0 {
+		p, _ := a.app.DictionaryService.FindUsers(lo.Keys(dm.People))
 		peoplesDto = &p
 	}
 
@@ -279,13 +279,17 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 		return nil, err
 	}
 
-	if err== nil && dtoFromCache.UUID != uuid.Nil {
+	if err == nil && dtoFromCache.UUID != uuid.Nil {
 		firstOpenDTO := a.patchFirstOpen(&dtoFromCache, claims.UUID)
 
 		dtoFromCache.IsLiked = &isLiked
 		dtoFromCache.FirstOpen = firstOpenDTO
 		dtoFromCache.Views = len(firstOpenDTO)
 
+		if lo.FromPtr(request.Params.Html) {
+			dtoFromCache.WithHTML()
+		}
+
 		err := a.app.TaskService.TaskWasOpen(request.UUID, claims.Email)
 		if err != nil {
 			logrus.Error(err)
@@ -340,6 +344,16 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 		}
 	}
 
+	// relation fields
+	relationsData, err := a.app.TaskService.RelationFieldsData(dm)
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, item := range relationsData {
+		linkedFieldsData[uid] = item
+	}
+
 	taskDto := dto.NewTaskDTO(dm, comments, files, reminders, linkedFieldsData, a.app.DictionaryService, a.app.ProfileService)
 
 	go a.app.CacheService.CacheTask(ctx, &taskDto)
@@ -350,6 +364,10 @@ func (a *Web) GetTaskUUID(ctx context.Context, request oapi.GetTaskUUIDRequestOb
 	taskDto.FirstOpen = firstOpenDTO
 	taskDto.Views = len(firstOpenDTO)
 
+	if lo.FromPtr(request.Params.Html) {
+		taskDto.WithHTML()
+	}
+
 	return oapi.GetTaskUUID200JSONResponse{
 		Body: taskDto,
 		Headers: oapi.GetTaskUUID200ResponseHeaders{
@@ -795,7 +813,7 @@ func (a *Web) PostTaskUUIDComment(ctx context.Context, request oapi.PostTaskUUID
 
 	dm := domain.NewComment(claims.Email, request.UUID, replyUUID, emails, comment)
 
-	err = a.app.TaskService.CreateComment(ctx, request.UUID, *dm)
+	*dm, err = a.app.TaskService.CreateComment(ctx, request.UUID, *dm)
 	if err != nil {
 		return nil, err
 	}
@@ -844,8 +862,8 @@ func (a *Web) PostTaskUUIDComment(ctx context.Context, request oapi.PostTaskUUID
 	}
 
 	var peoplesDto *[]dto.UserDTO
-	if len(emails) > 0 {
-		p, _ := a.app.DictionaryService.FindUsers(emails)
+	if len(dm.People) > 0 {
+		p, _ := a.app.DictionaryService.FindUsers(lo.Keys(dm.People))
 		peoplesDto = &p
 	}
 
@@ -891,6 +909,128 @@ func (a *Web) PatchTaskUUIDCommentEntityUUIDLike(ctx context.Context, request oa
 	}, nil
 }
 
+func (a *Web) PatchTaskUUIDCommentEntityUUIDReaction(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDReactionRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDReactionResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	comment, err := a.app.CommentService.GetComment(ctx, request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.TaskUUID != request.UUID {
+		return nil, dto.NotFoundErr("комментарий не найден")
+	}
+
+	reactions, reacted, err := a.app.CommentService.ReactComment(ctx, request.EntityUUID, claims.Email, request.Body.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	if reacted && comment.CreatedBy != claims.Email {
+		err = a.app.TaskService.TaskWasUpdatedOrCreated(comment.TaskUUID, []string{comment.CreatedBy})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return oapi.PatchTaskUUIDCommentEntityUUIDReaction200JSONResponse{
+		Reacted: reacted,
+		Reactions: lo.Map(reactions, func(r domain.CommentReaction, _ int) dto.CommentReactionDTO {
+			return dto.NewCommentReactionDTO(r, a.app.ProfileService)
+		}),
+	}, nil
+}
+
+func (a *Web) GetTaskUUIDCommentEntityUUIDVersions(ctx context.Context, request oapi.GetTaskUUIDCommentEntityUUIDVersionsRequestObject) (oapi.GetTaskUUIDCommentEntityUUIDVersionsResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if err := a.checkTaskComment(ctx, claims, request.UUID, request.EntityUUID); err != nil {
+		return nil, err
+	}
+
+	versions, err := a.app.CommentService.GetCommentVersions(request.EntityUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDCommentEntityUUIDVersions200JSONResponse{
+		Count: len(versions),
+		Items: lo.Map(versions, func(v domain.CommentVersion, _ int) dto.CommentVersionDTO {
+			return dto.NewCommentVersionDTO(v, a.app.DictionaryService)
+		}),
+	}, nil
+}
+
+func (a *Web) GetTaskUUIDCommentEntityUUIDDiff(ctx context.Context, request oapi.GetTaskUUIDCommentEntityUUIDDiffRequestObject) (oapi.GetTaskUUIDCommentEntityUUIDDiffResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	if err := a.checkTaskComment(ctx, claims, request.UUID, request.EntityUUID); err != nil {
+		return nil, err
+	}
+
+	from, to, changes, err := a.app.CommentService.DiffCommentVersions(request.EntityUUID, request.Params.From, request.Params.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return oapi.GetTaskUUIDCommentEntityUUIDDiff200JSONResponse{
+		From: dto.NewCommentVersionDTO(from, a.app.DictionaryService),
+		To:   dto.NewCommentVersionDTO(to, a.app.DictionaryService),
+		Changes: lo.Map(changes, func(d domain.TextDiff, _ int) dto.TextDiffDTO {
+			return dto.NewTextDiffDTO(d)
+		}),
+	}, nil
+}
+
+// checkTaskComment - комментарий принадлежит задаче из пути, а пользователь имеет доступ к задаче.
+func (a *Web) checkTaskComment(ctx context.Context, claims jwt.Claims, taskUUID, commentUUID uuid.UUID) error {
+	comment, err := a.app.CommentService.GetComment(ctx, commentUUID)
+	if err != nil {
+		return err
+	}
+
+	if comment.TaskUUID != taskUUID {
+		return dto.NotFoundErr("комментарий не найден")
+	}
+
+	task, err := a.app.TaskService.GetTask(ctx, taskUUID, []string{})
+	if err != nil {
+		return err
+	}
+
+	return a.app.GateService.TaskView(task, claims.UUID)
+}
+
+func (a *Web) PostTaskUUIDCommentEntityUUIDTask(ctx context.Context, request oapi.PostTaskUUIDCommentEntityUUIDTaskRequestObject) (oapi.PostTaskUUIDCommentEntityUUIDTaskResponseObject, error) {
+	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
+	if !ok {
+		return nil, ErrInvalidAuthHeader
+	}
+
+	task, id, err := a.app.TaskService.ConvertCommentToTask(ctx, domain.NewCreatorFromUser(&claims), request.UUID, request.EntityUUID, request.Body.Mode, request.Body.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	a.app.TaskService.ResetCache(request.UUID)
+
+	return oapi.PostTaskUUIDCommentEntityUUIDTask200JSONResponse{
+		Uuid: task.UUID,
+		Id:   id,
+	}, nil
+}
+
 func (a *Web) PatchTaskUUIDCommentEntityUUIDPin(ctx context.Context, request oapi.PatchTaskUUIDCommentEntityUUIDPinRequestObject) (oapi.PatchTaskUUIDCommentEntityUUIDPinResponseObject, error) {
 	_, ok := ctx.Value(claimsKey).(jwt.Claims)
 	if !ok {
@@ -924,14 +1064,19 @@ func (a *Web) DeleteTaskUUIDCommentEntityUUID(ctx context.Context, request oapi.
 
 // Web struct should implement the missing method from otask.StrictServerInterface.
 func (a *Web) GetTaskUUIDComment(_ context.Context, request oapi.GetTaskUUIDCommentRequestObject) (oapi.GetTaskUUIDCommentResponseObject, error) {
-	dms, err := a.app.CommentService.GetTaskComments(request.UUID, true, true)
+	dms, err := a.app.CommentService.GetTaskCommentThreads(request.UUID, request.Params.Thread, lo.FromPtr(request.Params.Collapsed))
 	if err != nil {
 		return nil, err
 	}
 
 	dtos := []dto.CommentDTO{}
 	for _, dm := range dms {
-		dtos = append(dtos, dto.NewCommentDTO(dm, a.app.DictionaryService, a.app.ProfileService))
+		d := dto.NewCommentDTO(dm, a.app.DictionaryService, a.app.ProfileService)
+		if lo.FromPtr(request.Params.Html) {
+			d.WithHTML()
+		}
+
+		dtos = append(dtos, d)
 	}
 
 	return oapi.GetTaskUUIDComment200JSONResponse{
@@ -2,7 +2,6 @@ package web
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,15 +13,12 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/uuid"
-	"github.com/gorilla/websocket"
-	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/time/rate"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
-	"github.com/krisch/crm-backend/domain"
 	"github.com/krisch/crm-backend/dto"
 	"github.com/krisch/crm-backend/internal/app"
 	"github.com/krisch/crm-backend/internal/configs"
@@ -90,62 +86,6 @@ func (a *Web) Work(ctx context.Context, rds *redis.RDS) {
 	a.app.Subscribe(ctx)
 }
 
-var upgrader = websocket.Upgrader{}
-
-func hello(a *Web, _ *echo.Echo) func(c echo.Context) error {
-	return func(c echo.Context) error {
-		ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
-		if err != nil {
-			return err
-		}
-		defer ws.Close()
-
-		for {
-			// Read
-			_, msg, err := ws.ReadMessage()
-			if err != nil {
-				logrus.Error(err)
-				continue
-			}
-
-			fmt.Printf("%s\n", msg)
-
-			arr := strings.Split(string(msg), " ")
-
-			if len(arr) < 2 {
-				continue
-			}
-
-			search := domain.SearchUser{
-				FederationUUID: uuid.MustParse(arr[0]),
-				Search:         arr[1],
-			}
-
-			dmns, err := a.app.FederationService.SearchUserInDictionary(search)
-			if err != nil {
-				logrus.Error(err)
-				continue
-			}
-
-			dtos := lo.Map(dmns, func(item domain.User, index int) dto.UserDTO {
-				return dto.NewUserDto(item, a.app.ProfileService)
-			})
-
-			jsn, err := json.Marshal(dtos)
-			if err != nil {
-				logrus.Error(err)
-				continue
-			}
-
-			err = ws.WriteMessage(websocket.TextMessage, jsn)
-			if err != nil {
-				logrus.Error(err)
-				continue
-			}
-		}
-	}
-}
-
 func (a *Web) Init() *echo.Echo {
 	e := echo.New()
 
@@ -218,6 +158,17 @@ func (a *Web) Init() *echo.Echo {
 			return
 		}
 
+		var fieldsErr dto.FieldValidationError
+		if errors.As(err, &fieldsErr) {
+			//nolint
+			c.JSON(http.StatusBadRequest, ValidationError{
+				StatusCode: http.StatusBadRequest,
+				Errors:     fieldsErr.Messages(),
+				Fields:     fieldsErr.Fields,
+			})
+			return
+		}
+
 		// check if error is known type to be handled differently
 		var myErr *ValidationError
 		if errors.As(err, &myErr) {
@@ -332,7 +283,8 @@ func (a *Web) Init() *echo.Echo {
 		return c.JSON(http.StatusOK, "pong")
 	})
 
-	e.GET("/ws", hello(a, e))
+	initRealtimeRoutes(a, e)
+	initMailCaptureRoutes(a, e)
 
 	e.GET("/seed", func(c echo.Context) error {
 		c.Response().Header().Set(echo.HeaderContentType, "text/event-stream")
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."company_fields"
+ADD
+    COLUMN "options" jsonb NOT NULL DEFAULT '[]';
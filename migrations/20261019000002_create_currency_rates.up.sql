@@ -0,0 +1,13 @@
+CREATE TABLE currency_rates (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    company_uuid uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    currency_from character varying(3) NOT NULL,
+    currency_to character varying(3) NOT NULL,
+    rate numeric(24, 10) NOT NULL,
+    date date NOT NULL,
+    created_by character varying(255) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX currency_rates_company_pair_date_idx ON currency_rates (company_uuid, currency_from, currency_to, date);
@@ -0,0 +1,5 @@
+ALTER TABLE
+    "public"."project_fields" DROP COLUMN "rules";
+
+ALTER TABLE
+    "public"."company_fields" DROP COLUMN "rules";
@@ -0,0 +1,9 @@
+ALTER TABLE
+    "public"."company_fields"
+ADD
+    COLUMN "rules" jsonb NOT NULL DEFAULT '{}';
+
+ALTER TABLE
+    "public"."project_fields"
+ADD
+    COLUMN "rules" jsonb NOT NULL DEFAULT '{}';
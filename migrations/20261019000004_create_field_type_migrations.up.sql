@@ -0,0 +1,24 @@
+CREATE TABLE field_type_migrations (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    company_uuid uuid NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    target character varying(20) NOT NULL,
+    field_uuid uuid NOT NULL,
+    hash character varying(15) NOT NULL,
+    from_type integer NOT NULL,
+    to_type integer NOT NULL,
+    strategy character varying(20) NOT NULL DEFAULT 'fail',
+    default_value jsonb,
+    status character varying(20) NOT NULL DEFAULT 'running',
+    total integer NOT NULL DEFAULT 0,
+    processed integer NOT NULL DEFAULT 0,
+    converted integer NOT NULL DEFAULT 0,
+    cleared integer NOT NULL DEFAULT 0,
+    defaulted integer NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    created_by character varying(255) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    finished_at timestamp with time zone
+);
+
+CREATE INDEX field_type_migrations_field_idx ON field_type_migrations (field_uuid, status);
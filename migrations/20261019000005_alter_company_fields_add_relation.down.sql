@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS tasks_fields_path_ops_idx;
+
+ALTER TABLE
+    "public"."company_fields" DROP COLUMN "relation";
@@ -0,0 +1,6 @@
+ALTER TABLE
+    "public"."company_fields"
+ADD
+    COLUMN "relation" jsonb;
+
+CREATE INDEX IF NOT EXISTS tasks_fields_path_ops_idx ON tasks USING gin (fields jsonb_path_ops);
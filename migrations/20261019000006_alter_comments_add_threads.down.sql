@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS comments_reply_uuid;
+
+ALTER TABLE
+    "public"."comments" DROP COLUMN IF EXISTS "thread_uuid",
+    DROP COLUMN IF EXISTS "depth",
+    DROP COLUMN IF EXISTS "reactions";
@@ -0,0 +1,42 @@
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "thread_uuid" uuid,
+ADD
+    COLUMN "depth" int4 NOT NULL DEFAULT 0,
+ADD
+    COLUMN "reactions" jsonb NOT NULL DEFAULT '{}';
+
+WITH RECURSIVE tree AS (
+    SELECT
+        "uuid",
+        "uuid" AS "root_uuid",
+        0 AS "depth"
+    FROM
+        "public"."comments"
+    WHERE
+        "reply_uuid" IS NULL
+        OR "reply_uuid" = '00000000-0000-0000-0000-000000000000'
+    UNION ALL
+    SELECT
+        c."uuid",
+        tree."root_uuid",
+        tree."depth" + 1
+    FROM
+        "public"."comments" c
+        JOIN tree ON c."reply_uuid" = tree."uuid"
+)
+UPDATE
+    "public"."comments"
+SET
+    "thread_uuid" = tree."root_uuid",
+    "depth" = tree."depth"
+FROM
+    tree
+WHERE
+    "comments"."uuid" = tree."uuid"
+    AND tree."depth" > 0;
+
+CREATE INDEX comments_reply_uuid ON comments (reply_uuid)
+where
+    deleted_at is null;
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."comments" DROP COLUMN IF EXISTS "edited_at";
+
+DROP TABLE IF EXISTS comment_versions;
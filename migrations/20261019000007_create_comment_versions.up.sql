@@ -0,0 +1,16 @@
+CREATE TABLE comment_versions (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    comment_uuid uuid NOT NULL REFERENCES comments(uuid) ON DELETE CASCADE,
+    task_uuid uuid NOT NULL,
+    version integer NOT NULL,
+    comment text NOT NULL DEFAULT '',
+    edited_by character varying(100) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX comment_versions_comment_version_idx ON comment_versions (comment_uuid, version);
+
+ALTER TABLE
+    "public"."comments"
+ADD
+    COLUMN "edited_at" timestamptz;
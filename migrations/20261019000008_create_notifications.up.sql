@@ -0,0 +1,18 @@
+CREATE TABLE notifications (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_email character varying(100) NOT NULL,
+    type character varying(20) NOT NULL,
+    entity_uuid uuid NOT NULL,
+    project_uuid uuid,
+    events jsonb NOT NULL DEFAULT '[]',
+    state jsonb NOT NULL DEFAULT '{}',
+    starred boolean NOT NULL DEFAULT false,
+    read_at timestamp with time zone,
+    hidden_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX notifications_user_entity_idx ON notifications (user_email, type, entity_uuid);
+
+CREATE INDEX notifications_user_updated_idx ON notifications (user_email, updated_at DESC);
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."companies" DROP COLUMN "working_hours";
+
+DROP TABLE IF EXISTS notification_deliveries;
@@ -0,0 +1,31 @@
+CREATE TABLE notification_deliveries (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_email character varying(100) NOT NULL,
+    channel character varying(20) NOT NULL,
+    type character varying(20) NOT NULL,
+    entity_uuid uuid NOT NULL,
+    project_uuid uuid,
+    company_uuid uuid,
+    events jsonb NOT NULL DEFAULT '[]',
+    subject text NOT NULL DEFAULT '',
+    url text NOT NULL DEFAULT '',
+    urgent boolean NOT NULL DEFAULT false,
+    status character varying(20) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    deliver_at timestamp with time zone NOT NULL DEFAULT now(),
+    sent_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX notification_deliveries_pending_idx ON notification_deliveries (user_email, channel, type, entity_uuid) WHERE status = 'pending';
+
+CREATE INDEX notification_deliveries_due_idx ON notification_deliveries (status, deliver_at);
+
+CREATE INDEX notification_deliveries_user_idx ON notification_deliveries (user_email, created_at DESC);
+
+ALTER TABLE
+    "public"."companies"
+ADD
+    COLUMN "working_hours" jsonb NOT NULL DEFAULT '{}';
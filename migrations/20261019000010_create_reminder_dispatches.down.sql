@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS reminders_fire_idx;
+
+DROP TABLE IF EXISTS reminder_dispatches;
@@ -0,0 +1,18 @@
+CREATE TABLE reminder_dispatches (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    reminder_uuid uuid NOT NULL,
+    fire_at timestamp with time zone NOT NULL,
+    status character varying(20) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    sent_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX reminder_dispatches_reminder_fire_idx ON reminder_dispatches (reminder_uuid, fire_at);
+
+CREATE INDEX reminder_dispatches_due_idx ON reminder_dispatches (status, next_attempt_at);
+
+CREATE INDEX reminders_fire_idx ON reminders ((coalesce(date_from, date_to))) WHERE deleted_at IS NULL;
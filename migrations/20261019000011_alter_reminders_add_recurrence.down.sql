@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS reminders_fire_idx;
+
+CREATE INDEX reminders_fire_idx ON reminders ((coalesce(date_from, date_to))) WHERE deleted_at IS NULL;
+
+ALTER TABLE
+    "public"."reminders" DROP COLUMN "recurrence",
+    DROP COLUMN "snoozed_until",
+    DROP COLUMN "deadline_offset";
@@ -0,0 +1,12 @@
+ALTER TABLE
+    "public"."reminders"
+ADD
+    COLUMN "recurrence" jsonb,
+ADD
+    COLUMN "snoozed_until" timestamptz,
+ADD
+    COLUMN "deadline_offset" integer;
+
+DROP INDEX IF EXISTS reminders_fire_idx;
+
+CREATE INDEX reminders_fire_idx ON reminders ((coalesce(snoozed_until, date_from, date_to))) WHERE deleted_at IS NULL;
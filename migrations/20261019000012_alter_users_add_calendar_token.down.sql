@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_calendar_token_idx;
+
+ALTER TABLE
+    "public"."users" DROP COLUMN "calendar_token";
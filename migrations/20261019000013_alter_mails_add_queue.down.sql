@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS mails_status_idx;
+
+DROP INDEX IF EXISTS mails_queue_idx;
+
+ALTER TABLE
+    "public"."mails" DROP COLUMN "status",
+    DROP COLUMN "attempts",
+    DROP COLUMN "error",
+    DROP COLUMN "next_attempt_at",
+    DROP COLUMN "sent_at",
+    DROP COLUMN "updated_at",
+ALTER COLUMN
+    "to" TYPE varchar(100) USING left("to", 100);
@@ -0,0 +1,23 @@
+ALTER TABLE
+    "public"."mails"
+ALTER COLUMN
+    "to" TYPE text,
+ADD
+    COLUMN "status" character varying(20) NOT NULL DEFAULT 'sent',
+ADD
+    COLUMN "attempts" integer NOT NULL DEFAULT 0,
+ADD
+    COLUMN "error" text NOT NULL DEFAULT '',
+ADD
+    COLUMN "next_attempt_at" timestamp with time zone NOT NULL DEFAULT now(),
+ADD
+    COLUMN "sent_at" timestamp with time zone,
+ADD
+    COLUMN "updated_at" timestamp with time zone NOT NULL DEFAULT now();
+
+-- письма до очереди уже отправлены, новые ставятся в очередь
+ALTER TABLE "public"."mails" ALTER COLUMN "status" SET DEFAULT 'pending';
+
+CREATE INDEX mails_queue_idx ON mails (next_attempt_at) WHERE status IN ('pending', 'sending');
+
+CREATE INDEX mails_status_idx ON mails (status, created_at DESC);
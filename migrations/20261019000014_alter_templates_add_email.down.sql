@@ -0,0 +1,10 @@
+ALTER TABLE
+    "public"."mails"
+ALTER COLUMN
+    "from" TYPE varchar(100) USING left("from", 100);
+
+DROP INDEX IF EXISTS templates_email_uniq;
+
+ALTER TABLE
+    "public"."templates" DROP COLUMN "lang",
+    DROP COLUMN "subject";
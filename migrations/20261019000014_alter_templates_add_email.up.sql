@@ -0,0 +1,19 @@
+ALTER TABLE
+    "public"."templates"
+ADD
+    COLUMN "lang" character varying(10) NOT NULL DEFAULT '',
+ADD
+    COLUMN "subject" text NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX templates_email_uniq ON templates (
+    type,
+    lang,
+    coalesce(federation_uuid, '00000000-0000-0000-0000-000000000000'),
+    coalesce(company_uuid, '00000000-0000-0000-0000-000000000000')
+) WHERE deleted_at IS NULL AND type LIKE 'email\_%';
+
+-- отправитель с именем из оформления федерации
+ALTER TABLE
+    "public"."mails"
+ALTER COLUMN
+    "from" TYPE text;
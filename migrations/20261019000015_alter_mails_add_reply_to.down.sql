@@ -0,0 +1,2 @@
+ALTER TABLE
+    "public"."mails" DROP COLUMN "reply_to";
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."mails"
+ADD
+    COLUMN "reply_to" text NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS intake_messages;
+
+DROP TABLE IF EXISTS intake_addresses;
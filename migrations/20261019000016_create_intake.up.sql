@@ -0,0 +1,24 @@
+CREATE TABLE intake_addresses (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_uuid uuid NOT NULL,
+    email character varying(100) NOT NULL,
+    created_by character varying(100) NOT NULL,
+    created_by_uuid uuid NOT NULL,
+    responsible_by character varying(100) NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE UNIQUE INDEX intake_addresses_project_uniq ON intake_addresses (project_uuid) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX intake_addresses_email_uniq ON intake_addresses (lower(email)) WHERE deleted_at IS NULL;
+
+CREATE TABLE intake_messages (
+    message_id text PRIMARY KEY,
+    task_uuid uuid NOT NULL,
+    agent_uuid uuid,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX intake_messages_task_idx ON intake_messages (task_uuid);
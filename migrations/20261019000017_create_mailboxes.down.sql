@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS mailbox_sent_emails;
+
+DROP TABLE IF EXISTS mailbox_emails;
+
+DROP TABLE IF EXISTS mailboxes;
@@ -0,0 +1,58 @@
+CREATE TABLE mailboxes (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_uuid uuid NOT NULL,
+    email character varying(100) NOT NULL,
+    password text NOT NULL,
+    domain character varying(100) NOT NULL,
+    status integer NOT NULL DEFAULT 1,
+    uid_validity bigint NOT NULL DEFAULT 0,
+    last_uid bigint NOT NULL DEFAULT 0,
+    last_fetched_at timestamp with time zone,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    deleted_at timestamp with time zone
+);
+
+CREATE UNIQUE INDEX mailboxes_user_email_uniq ON mailboxes (user_uuid, lower(email)) WHERE deleted_at IS NULL;
+
+CREATE TABLE mailbox_emails (
+    id bigserial PRIMARY KEY,
+    mailbox_uuid uuid NOT NULL,
+    user_uuid uuid NOT NULL,
+    email character varying(100) NOT NULL,
+    sender text NOT NULL DEFAULT '',
+    recipients text[] NOT NULL DEFAULT '{}',
+    subject text NOT NULL DEFAULT '',
+    body_text text NOT NULL DEFAULT '',
+    message_id text NOT NULL DEFAULT '',
+    body_hash character varying(64) NOT NULL,
+    agent_uuids uuid[] NOT NULL DEFAULT '{}',
+    task_uuids uuid[] NOT NULL DEFAULT '{}',
+    received_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX mailbox_emails_hash_uniq ON mailbox_emails (mailbox_uuid, body_hash);
+
+CREATE INDEX mailbox_emails_user_idx ON mailbox_emails (user_uuid, received_at DESC);
+
+CREATE INDEX mailbox_emails_agents_idx ON mailbox_emails USING gin (agent_uuids);
+
+CREATE INDEX mailbox_emails_tasks_idx ON mailbox_emails USING gin (task_uuids);
+
+CREATE TABLE mailbox_sent_emails (
+    uuid uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    mailbox_uuid uuid NOT NULL,
+    user_uuid uuid NOT NULL,
+    sender_email character varying(100) NOT NULL,
+    recipient_email character varying(100) NOT NULL,
+    subject character varying(200) NOT NULL,
+    body text NOT NULL,
+    status character varying(20) NOT NULL DEFAULT 'queued',
+    error text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    sent_at timestamp with time zone
+);
+
+CREATE INDEX mailbox_sent_emails_mailbox_idx ON mailbox_sent_emails (mailbox_uuid, created_at DESC);
@@ -0,0 +1,3 @@
+CREATE INDEX comments_thread_uuid ON comments (thread_uuid)
+where
+    deleted_at is null;
@@ -0,0 +1,3 @@
+CREATE INDEX comments_people ON comments USING gin (people)
+where
+    deleted_at is null;
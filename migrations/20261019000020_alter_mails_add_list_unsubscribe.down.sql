@@ -0,0 +1,2 @@
+ALTER TABLE
+    "public"."mails" DROP COLUMN "list_unsubscribe";
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "public"."mails"
+ADD
+    COLUMN "list_unsubscribe" text NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+UPDATE
+    "public"."reminders"
+SET
+    "status" = 10
+WHERE
+    "status" = 100;
@@ -0,0 +1,15 @@
+UPDATE
+    "public"."reminders"
+SET
+    "status" = 100
+WHERE
+    "status" = 10
+    AND EXISTS (
+        SELECT
+            1
+        FROM
+            "public"."reminder_dispatches"
+        WHERE
+            "reminder_dispatches"."reminder_uuid" = "reminders"."uuid"
+            AND "reminder_dispatches"."status" = 'sent'
+    );